@@ -1,9 +1,15 @@
 package api
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/config"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 )
 
@@ -49,6 +55,61 @@ func (a *API) PostGrants(w http.ResponseWriter, r *http.Request) {
 	apio.JSON(ctx, w, res, http.StatusCreated)
 }
 
+// Validate Grant
+// (POST /api/v1/grants/validate)
+func (a *API) ValidateGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.ValidateGrantJSONRequestBody
+
+	err := func() error {
+		err := apio.DecodeJSONBody(w, r, &b)
+		if err != nil {
+			return err
+		}
+
+		_, err = b.Validate(ctx, a.Clock.Now())
+		if err != nil {
+			return &apio.APIError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+			}
+		}
+
+		prov, ok := config.Providers[b.Provider]
+		if !ok {
+			return apio.NewRequestError(&providers.ProviderNotFoundError{Provider: b.Provider}, http.StatusNotFound)
+		}
+
+		v, ok := prov.Provider.(providers.Validator)
+		if !ok {
+			logger.Get(ctx).Infow("provider does not support validation", "provider.id", b.Provider)
+			return nil
+		}
+
+		args, err := json.Marshal(b.With)
+		if err != nil {
+			return err
+		}
+
+		err = v.Validate(ctx, string(b.Subject), args)
+		if err != nil {
+			// the provider rejected the subject or arguments, so the grant would fail if it was created.
+			return &apio.APIError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+			}
+		}
+		return nil
+	}()
+
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
 // Revoke grant
 // (POST /api/v1/grants/{grantId}/revoke)
 func (a *API) PostGrantsRevoke(w http.ResponseWriter, r *http.Request, grantId string) {
@@ -74,3 +135,96 @@ func (a *API) PostGrantsRevoke(w http.ResponseWriter, r *http.Request, grantId s
 
 	apio.JSON(ctx, w, res, http.StatusOK)
 }
+
+// Extend grant
+// (POST /api/v1/grants/{grantId}/extend)
+func (a *API) PostGrantsExtend(w http.ResponseWriter, r *http.Request, grantId string) {
+	ctx := r.Context()
+	var b types.PostGrantsExtendJSONRequestBody
+
+	grant, err := func() (*types.Grant, error) {
+		err := apio.DecodeJSONBody(w, r, &b)
+		if err != nil {
+			return nil, err
+		}
+
+		if b.End.Before(a.Clock.Now()) {
+			return nil, &apio.APIError{
+				Err:    types.ErrInvalidGrantTime{Msg: "grant finish time is in the past"},
+				Status: http.StatusBadRequest,
+			}
+		}
+
+		g, err := a.runtime.ExtendGrant(ctx, grantId, b.End.Time)
+		var invalidTime types.ErrInvalidGrantTime
+		if errors.As(err, &invalidTime) || errors.Is(err, types.ErrGrantNotActive) {
+			return nil, &apio.APIError{Err: err, Status: http.StatusBadRequest}
+		}
+		if errors.Is(err, types.ErrGrantNotFound) {
+			return nil, &apio.APIError{Err: err, Status: http.StatusNotFound}
+		}
+		return g, err
+	}()
+
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	res := types.GrantResponse{
+		Grant: grant,
+	}
+
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Reconcile Grants
+// (POST /api/v1/grants/reconcile)
+func (a *API) ReconcileGrants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.ReconcileGrantsJSONRequestBody
+
+	results, err := func() ([]types.GrantReconciliation, error) {
+		err := apio.DecodeJSONBody(w, r, &b)
+		if err != nil {
+			return nil, err
+		}
+		var fields []apio.FieldError
+		for i, g := range b.Grants {
+			if g.Status != types.ACTIVE && g.Status != types.EXPIRED {
+				fields = append(fields, apio.FieldError{
+					Field: fmt.Sprintf("grants[%d].status", i),
+					Error: "only ACTIVE and EXPIRED grants can be reconciled",
+				})
+			}
+		}
+		if len(fields) > 0 {
+			return nil, &apio.APIError{
+				Err:    errors.New("invalid grants"),
+				Status: http.StatusBadRequest,
+				Fields: fields,
+			}
+		}
+
+		remediate := b.Remediate != nil && *b.Remediate
+		revoke := b.Revoke != nil && *b.Revoke
+		var active []types.Grant
+		for _, g := range b.Grants {
+			if g.Status == types.ACTIVE {
+				active = append(active, g)
+			}
+		}
+		results := []types.GrantReconciliation{}
+		for _, g := range b.Grants {
+			results = append(results, reconcileGrant(ctx, g, active, remediate, revoke))
+		}
+		return results, nil
+	}()
+
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	apio.JSON(ctx, w, types.GrantReconciliationsResponse{Results: results}, http.StatusOK)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,10 @@ import (
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/config"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers/testgroups"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/iso8601"
 
 	"github.com/stretchr/testify/assert"
@@ -125,3 +130,268 @@ func TestRevokeGrant(t *testing.T) {
 		})
 	}
 }
+
+func TestExtendGrant(t *testing.T) {
+	type testcase struct {
+		name       string
+		grantID    string
+		extendBody string
+		wantCode   int
+		wantErr    string
+	}
+
+	TenAM := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	TenAMISO8601 := iso8601.New(TenAM)
+	TenThirtyAMISO8601 := iso8601.New(time.Date(2022, 1, 1, 10, 30, 0, 0, time.UTC))
+	ElevenAMISO8601 := iso8601.New(time.Date(2022, 1, 1, 11, 0, 0, 0, time.UTC))
+	TenFifteenAMISO8601 := iso8601.New(time.Date(2022, 1, 1, 10, 15, 0, 0, time.UTC))
+
+	clk := clock.NewMock()
+	clk.Set(TenAM)
+
+	createBody := fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"okta","with":{"group":"Admins"},"start":"%s","end":"%s"}`, TenAMISO8601, TenThirtyAMISO8601)
+
+	testcases := []testcase{
+		{name: "ok", grantID: "abcd", extendBody: fmt.Sprintf(`{"end":"%s"}`, ElevenAMISO8601), wantCode: http.StatusOK},
+		{name: "end before current end", grantID: "abcd", extendBody: fmt.Sprintf(`{"end":"%s"}`, TenFifteenAMISO8601), wantCode: http.StatusBadRequest, wantErr: "extended grant end time must be after the current end time"},
+		{name: "end in the past", grantID: "abcd", extendBody: `{"end":"2000-06-14T14:13:42.905Z"}`, wantCode: http.StatusBadRequest, wantErr: "grant finish time is in the past"},
+		{name: "grant not found", grantID: "other", extendBody: fmt.Sprintf(`{"end":"%s"}`, ElevenAMISO8601), wantCode: http.StatusNotFound, wantErr: "grant not found"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t, withClock(clk))
+
+			//create grant
+			req, err := http.NewRequest("POST", "/api/v1/grants", strings.NewReader(createBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusCreated, rr.Code)
+
+			//extend grant
+			req, err = http.NewRequest("POST", "/api/v1/grants/"+tc.grantID+"/extend", strings.NewReader(tc.extendBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr = httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			var apiErr apio.ErrorResponse
+
+			_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+			assert.Equal(t, tc.wantErr, apiErr.Error)
+		})
+	}
+}
+
+func TestValidateGrant(t *testing.T) {
+	type testcase struct {
+		name     string
+		body     string
+		wantCode int
+		wantErr  string
+	}
+
+	TenAM := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	TenAMISO8601 := iso8601.New(TenAM)
+	TenThirtyAMISO8601 := iso8601.New(time.Date(2022, 1, 1, 10, 30, 0, 0, time.UTC))
+
+	clk := clock.NewMock()
+	clk.Set(TenAM)
+
+	config.ConfigureTestProviders([]config.Provider{
+		{
+			ID:       "test",
+			Type:     "testgroups",
+			Provider: &testgroups.Provider{Groups: []string{"Admins"}},
+		},
+	})
+
+	notFoundErr := &providers.ProviderNotFoundError{Provider: "okta"}
+	groupErr := &testgroups.GroupNotFoundError{Group: "Developers"}
+
+	testcases := []testcase{
+		{name: "ok", body: fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"test","with":{"group":"Admins"},"start":"%s","end":"%s"}`, TenAMISO8601, TenThirtyAMISO8601), wantCode: http.StatusOK},
+		{name: "provider rejects args", body: fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"test","with":{"group":"Developers"},"start":"%s","end":"%s"}`, TenAMISO8601, TenThirtyAMISO8601), wantCode: http.StatusBadRequest, wantErr: groupErr.Error()},
+		{name: "provider not found", body: fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"okta","with":{"group":"Admins"},"start":"%s","end":"%s"}`, TenAMISO8601, TenThirtyAMISO8601), wantCode: http.StatusNotFound, wantErr: notFoundErr.Error()},
+		{name: "invalid grant time: start after end", body: fmt.Sprintf(`{"id":"abcd","subject":"chris@commonfate.io","provider":"test","with":{"group":"Admins"},"start":"%v","end":"%v"}`, TenThirtyAMISO8601, TenAMISO8601), wantCode: http.StatusBadRequest, wantErr: "grant start time must be earlier than end time"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t, withClock(clk))
+
+			req, err := http.NewRequest("POST", "/api/v1/grants/validate", strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			var apiErr apio.ErrorResponse
+
+			_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+			assert.Equal(t, tc.wantErr, apiErr.Error)
+		})
+	}
+}
+
+// isActiveProvider is a testgroups provider which can check whether the subject is in a group.
+type isActiveProvider struct {
+	testgroups.Provider
+	// active are the groups which the subject is in.
+	active map[string]bool
+}
+
+func (p *isActiveProvider) IsActive(ctx context.Context, subject string, args []byte) (bool, error) {
+	var a testgroups.Args
+	err := json.Unmarshal(args, &a)
+	if err != nil {
+		return false, err
+	}
+	return p.active[a.Group], nil
+}
+
+func TestReconcileGrants(t *testing.T) {
+	type testcase struct {
+		name        string
+		body        string
+		wantCode    int
+		wantErr     string
+		wantResults []types.GrantReconciliation
+	}
+
+	config.ConfigureTestProviders([]config.Provider{
+		{
+			ID:   "test",
+			Type: "testgroups",
+			Provider: &isActiveProvider{
+				Provider: testgroups.Provider{Groups: []string{"Admins", "Developers", "Operators"}},
+				active:   map[string]bool{"Admins": true, "Operators": true},
+			},
+		},
+		{
+			ID:       "nocheck",
+			Type:     "testgroups",
+			Provider: &testgroups.Provider{Groups: []string{"Admins"}},
+		},
+	})
+
+	grant := func(id string, status types.GrantStatus, provider string, group string) string {
+		return fmt.Sprintf(`{"id":"%s","status":"%s","subject":"chris@commonfate.io","provider":"%s","with":{"group":"%s"},"start":"2022-01-01T10:00:00Z","end":"2022-01-01T10:30:00Z"}`, id, status, provider, group)
+	}
+	grants := strings.Join([]string{
+		grant("in_sync", types.ACTIVE, "test", "Admins"),
+		grant("missing", types.ACTIVE, "test", "Developers"),
+		grant("not_removed", types.EXPIRED, "test", "Operators"),
+		grant("removed", types.EXPIRED, "test", "Developers"),
+		grant("no_provider", types.ACTIVE, "okta", "Admins"),
+		grant("no_check", types.ACTIVE, "nocheck", "Admins"),
+		// the user requested access to the Admins group again after this grant expired, so in_sync is the active grant for it.
+		grant("re_requested", types.EXPIRED, "test", "Admins"),
+	}, ",")
+	str := func(s string) *string { return &s }
+	notFoundErr := &providers.ProviderNotFoundError{Provider: "okta"}
+
+	testcases := []testcase{
+		{
+			name:     "report only",
+			body:     fmt.Sprintf(`{"grants":[%s]}`, grants),
+			wantCode: http.StatusOK,
+			wantResults: []types.GrantReconciliation{
+				{GrantId: "in_sync", Drift: types.INSYNC},
+				{GrantId: "missing", Drift: types.MISSING},
+				{GrantId: "not_removed", Drift: types.NOTREMOVED},
+				{GrantId: "removed", Drift: types.INSYNC},
+				{GrantId: "no_provider", Drift: types.UNCHECKED, Error: str(notFoundErr.Error())},
+				{GrantId: "no_check", Drift: types.UNCHECKED, Error: str("the provider can't check whether access is active")},
+				{GrantId: "re_requested", Drift: types.INSYNC},
+			},
+		},
+		{
+			name:     "remediate",
+			body:     fmt.Sprintf(`{"grants":[%s,%s],"remediate":true}`, grants, grant("remediate_fails", types.ACTIVE, "test", "Finance")),
+			wantCode: http.StatusOK,
+			wantResults: []types.GrantReconciliation{
+				{GrantId: "in_sync", Drift: types.INSYNC},
+				{GrantId: "missing", Drift: types.MISSING, Remediated: true},
+				// access which is still provisioned isn't removed, as it may not have been provisioned by Granted.
+				{GrantId: "not_removed", Drift: types.NOTREMOVED},
+				{GrantId: "removed", Drift: types.INSYNC},
+				{GrantId: "no_provider", Drift: types.UNCHECKED, Error: str(notFoundErr.Error())},
+				{GrantId: "no_check", Drift: types.UNCHECKED, Error: str("the provider can't check whether access is active")},
+				{GrantId: "re_requested", Drift: types.INSYNC},
+				{GrantId: "remediate_fails", Drift: types.MISSING, Error: str((&testgroups.GroupNotFoundError{Group: "Finance"}).Error())},
+			},
+		},
+		{
+			name:     "revoke",
+			body:     fmt.Sprintf(`{"grants":[%s],"revoke":true}`, grants),
+			wantCode: http.StatusOK,
+			wantResults: []types.GrantReconciliation{
+				{GrantId: "in_sync", Drift: types.INSYNC},
+				// missing access is only provisioned again with remediate.
+				{GrantId: "missing", Drift: types.MISSING},
+				{GrantId: "not_removed", Drift: types.NOTREMOVED, Remediated: true},
+				{GrantId: "removed", Drift: types.INSYNC},
+				{GrantId: "no_provider", Drift: types.UNCHECKED, Error: str(notFoundErr.Error())},
+				{GrantId: "no_check", Drift: types.UNCHECKED, Error: str("the provider can't check whether access is active")},
+				// the expired grant isn't revoked, as the same access has been granted again.
+				{GrantId: "re_requested", Drift: types.INSYNC},
+			},
+		},
+		{
+			name:     "pending grant",
+			body:     fmt.Sprintf(`{"grants":[%s]}`, grant("pending", types.PENDING, "test", "Admins")),
+			wantCode: http.StatusBadRequest,
+			wantErr:  "invalid grants",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t)
+
+			req, err := http.NewRequest("POST", "/api/v1/grants/reconcile", strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantErr != "" {
+				var apiErr apio.ErrorResponse
+				_ = json.NewDecoder(rr.Body).Decode(&apiErr)
+				assert.Equal(t, tc.wantErr, apiErr.Error)
+				return
+			}
+			var got types.GrantReconciliationsResponse
+			err = json.NewDecoder(rr.Body).Decode(&got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantResults, got.Results)
+		})
+	}
+}
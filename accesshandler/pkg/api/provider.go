@@ -87,3 +87,33 @@ func (a *API) ListProviderArgOptions(w http.ResponseWriter, r *http.Request, pro
 
 	apio.JSON(ctx, w, res, http.StatusOK)
 }
+
+func (a *API) ValidateProviderArgs(w http.ResponseWriter, r *http.Request, providerId string) {
+	ctx := r.Context()
+	prov, ok := config.Providers[providerId]
+	if !ok {
+		apio.Error(ctx, w, apio.NewRequestError(&providers.ProviderNotFoundError{Provider: providerId}, http.StatusNotFound))
+		return
+	}
+	var b types.ValidateProviderArgsJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	fields, err := validateArgs(ctx, prov.Provider, b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if len(fields) > 0 {
+		apio.Error(ctx, w, &apio.APIError{
+			Err:    errors.New("the arguments are not valid for the provider"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		})
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
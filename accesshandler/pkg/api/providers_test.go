@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/common-fate/apikit/apio"
@@ -232,3 +233,84 @@ func TestListProviderArgOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateProviderArgs(t *testing.T) {
+	type testcase struct {
+		name           string
+		giveProviderId string
+		give           string
+		wantCode       int
+		wantErr        string
+		wantFields     []apio.FieldError
+	}
+
+	notFoundErr := &providers.ProviderNotFoundError{Provider: "badid"}
+
+	config.ConfigureTestProviders([]config.Provider{
+		{
+			ID:       "test",
+			Type:     "testgroups",
+			Provider: &testgroups.Provider{Groups: []string{"group1", "group2"}},
+		},
+	})
+	testcases := []testcase{
+		{name: "ok", giveProviderId: "test", give: `{"with":{"group":"group1"}}`, wantCode: http.StatusOK},
+		{name: "selectable ok", giveProviderId: "test", give: `{"with":{},"selectable":{"group":["group1","group2"]}}`, wantCode: http.StatusOK},
+		{name: "any option", giveProviderId: "test", give: `{"with":{},"selectable":{"group":[]}}`, wantCode: http.StatusOK},
+		{name: "provider not found", giveProviderId: "badid", give: `{"with":{}}`, wantCode: http.StatusNotFound, wantErr: notFoundErr.Error()},
+		{
+			name:           "not an option",
+			giveProviderId: "test",
+			give:           `{"with":{"group":"grup1"}}`,
+			wantCode:       http.StatusBadRequest,
+			wantErr:        "the arguments are not valid for the provider",
+			wantFields:     []apio.FieldError{{Field: "with.group", Error: "grup1 isn't one of the provider's options for the argument"}},
+		},
+		{
+			name:           "selectable value not an option",
+			giveProviderId: "test",
+			give:           `{"with":{},"selectable":{"group":["group1","group3"]}}`,
+			wantCode:       http.StatusBadRequest,
+			wantErr:        "the arguments are not valid for the provider",
+			wantFields:     []apio.FieldError{{Field: "selectable.group", Error: "group3 isn't one of the provider's options for the argument"}},
+		},
+		{
+			name:           "missing and unknown args",
+			giveProviderId: "test",
+			give:           `{"with":{"groupId":"group1"}}`,
+			wantCode:       http.StatusBadRequest,
+			wantErr:        "the arguments are not valid for the provider",
+			wantFields: []apio.FieldError{
+				{Field: "with.group", Error: "the argument is required"},
+				{Field: "with.groupId", Error: "the provider doesn't have this argument"},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newTestServer(t)
+
+			req, err := http.NewRequest("POST", "/api/v1/providers/"+tc.giveProviderId+"/args/validate", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantErr != "" {
+				var apiErr apio.ErrorResponse
+				err = json.NewDecoder(rr.Body).Decode(&apiErr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, tc.wantErr, apiErr.Error)
+				assert.Equal(t, tc.wantFields, apiErr.Fields)
+			}
+		})
+	}
+}
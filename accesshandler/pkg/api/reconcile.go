@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/config"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"go.uber.org/zap"
+)
+
+// reconcileGrant checks whether a grant is provisioned in its provider as its status expects.
+// If remediate is true, access is provisioned again for ACTIVE grants which are missing.
+// EXPIRED grants which are still provisioned are only reported unless revoke is true, as the access may have been provisioned
+// outside of Granted, such as a user who was already in the group before they requested it, and removing it could lock them out.
+//
+// EXPIRED grants for the same access as one of the activeGrants aren't checked, as the access was requested again after they expired.
+//
+// Grants are reconciled independently, so problems with a grant are returned in its result rather than as an error.
+func reconcileGrant(ctx context.Context, grant types.Grant, activeGrants []types.Grant, remediate bool, revoke bool) types.GrantReconciliation {
+	log := logger.Get(ctx).With("grant.id", grant.ID, "grant.status", grant.Status)
+	res := types.GrantReconciliation{GrantId: grant.ID, Drift: types.UNCHECKED}
+	unchecked := func(msg string) types.GrantReconciliation {
+		res.Error = &msg
+		return res
+	}
+
+	if grant.Status == types.EXPIRED {
+		for _, a := range activeGrants {
+			if a.SameAccess(grant) {
+				log.Infow("skipping expired grant, as the access has been granted again", "activeGrant.id", a.ID)
+				res.Drift = types.INSYNC
+				return res
+			}
+		}
+	}
+
+	prov, ok := config.Providers[grant.Provider]
+	if !ok {
+		return unchecked((&providers.ProviderNotFoundError{Provider: grant.Provider}).Error())
+	}
+	checker, ok := prov.Provider.(providers.IsActiver)
+	if !ok {
+		return unchecked("the provider can't check whether access is active")
+	}
+	args, err := json.Marshal(grant.With)
+	if err != nil {
+		return unchecked(err.Error())
+	}
+	active, err := checker.IsActive(ctx, string(grant.Subject), args)
+	if err != nil {
+		log.Errorw("failed to check whether grant is active", zap.Error(err))
+		return unchecked(err.Error())
+	}
+
+	wantActive := grant.Status == types.ACTIVE
+	switch {
+	case active == wantActive:
+		res.Drift = types.INSYNC
+		return res
+	case wantActive:
+		res.Drift = types.MISSING
+	default:
+		res.Drift = types.NOTREMOVED
+	}
+	log.Infow("grant has drifted from its provider", "drift", res.Drift, "remediate", remediate, "revoke", revoke)
+	if wantActive && !remediate || !wantActive && !revoke {
+		return res
+	}
+
+	if wantActive {
+		err = prov.Provider.Grant(ctx, string(grant.Subject), args)
+	} else {
+		err = prov.Provider.Revoke(ctx, string(grant.Subject), args)
+	}
+	if err != nil {
+		log.Errorw("failed to remediate grant drift", "drift", res.Drift, zap.Error(err))
+		msg := err.Error()
+		res.Error = &msg
+		return res
+	}
+	res.Remediated = true
+	return res
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/runtime/lambda"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/runtime/local"
@@ -25,6 +26,10 @@ type Runtime interface {
 	// initiating an AWS Step Functions workflow.
 	// Revokes a grant and terminates the previous create grant workflow
 	RevokeGrant(ctx context.Context, grantID string, revoker string) (*types.Grant, error)
+
+	// ExtendGrant moves the end time of an active grant to a later time.
+	// Access is kept provisioned until the new end time.
+	ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error)
 }
 
 // runtimes is a map of the supported runtime environments
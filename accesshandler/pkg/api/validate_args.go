@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/providers"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/invopop/jsonschema"
+)
+
+// validateArgs checks arguments against the provider's argument schema and options.
+// The problems with the arguments are returned as field errors, so that they can be shown next to the argument.
+// An error is only returned if the provider couldn't be queried for its options.
+func validateArgs(ctx context.Context, prov providers.Accessor, in types.ValidateArgs) ([]apio.FieldError, error) {
+	with := in.With.AdditionalProperties
+	selectable := map[string][]string{}
+	if in.Selectable != nil {
+		selectable = in.Selectable.AdditionalProperties
+	}
+
+	var fields []apio.FieldError
+	if as, ok := prov.(providers.ArgSchemarer); ok {
+		fields = checkArgSchema(as.ArgSchema(), with, selectable)
+	}
+	ao, ok := prov.(providers.ArgOptioner)
+	if !ok {
+		return fields, nil
+	}
+
+	// arguments which already have an error aren't checked against the options.
+	invalid := map[string]bool{}
+	for _, f := range fields {
+		invalid[f.Field] = true
+	}
+	opts := argOptions{optioner: ao, cache: map[string]map[string]bool{}}
+
+	for _, k := range sortedKeys(with) {
+		field := "with." + k
+		if invalid[field] {
+			continue
+		}
+		ok, err := opts.isOption(ctx, k, with[k])
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			fields = append(fields, apio.FieldError{Field: field, Error: fmt.Sprintf("%s isn't one of the provider's options for the argument", with[k])})
+		}
+	}
+	for _, k := range sortedSelectableKeys(selectable) {
+		field := "selectable." + k
+		if invalid[field] {
+			continue
+		}
+		for _, v := range selectable[k] {
+			ok, err := opts.isOption(ctx, k, v)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				fields = append(fields, apio.FieldError{Field: field, Error: fmt.Sprintf("%s isn't one of the provider's options for the argument", v)})
+			}
+		}
+	}
+	return fields, nil
+}
+
+// checkArgSchema checks that the required arguments are given and that the provider accepts each argument.
+// The schemas of providers are generated from their argument structs, so the argument types aren't checked as they are always strings.
+func checkArgSchema(schema *jsonschema.Schema, with map[string]string, selectable map[string][]string) []apio.FieldError {
+	if name := strings.TrimPrefix(schema.Ref, "#/$defs/"); name != schema.Ref {
+		if def, ok := schema.Definitions[name]; ok {
+			schema = def
+		}
+	}
+
+	var fields []apio.FieldError
+	for _, k := range schema.Required {
+		_, isSelectable := selectable[k]
+		if with[k] == "" && !isSelectable {
+			fields = append(fields, apio.FieldError{Field: "with." + k, Error: "the argument is required"})
+		}
+	}
+
+	// providers which allow additional properties accept any argument.
+	if schema.Properties == nil || schema.AdditionalProperties != jsonschema.FalseSchema {
+		return fields
+	}
+	for _, k := range sortedKeys(with) {
+		if _, ok := schema.Properties.Get(k); !ok {
+			fields = append(fields, apio.FieldError{Field: "with." + k, Error: "the provider doesn't have this argument"})
+		}
+	}
+	for _, k := range sortedSelectableKeys(selectable) {
+		if _, ok := schema.Properties.Get(k); !ok {
+			fields = append(fields, apio.FieldError{Field: "selectable." + k, Error: "the provider doesn't have this argument"})
+		}
+	}
+	return fields
+}
+
+// argOptions caches the options of a provider, as listing them can call external APIs.
+type argOptions struct {
+	optioner providers.ArgOptioner
+	// cache is the option values for each argument. It is nil for arguments which the provider doesn't have options for.
+	cache map[string]map[string]bool
+}
+
+// isOption returns true if the value is one of the options for the argument, or if the provider doesn't have options for the argument.
+func (o *argOptions) isOption(ctx context.Context, arg, value string) (bool, error) {
+	values, ok := o.cache[arg]
+	if !ok {
+		options, err := o.optioner.Options(ctx, arg)
+		badArg := &providers.InvalidArgumentError{}
+		if err != nil && !errors.As(err, &badArg) {
+			return false, err
+		}
+		if err == nil {
+			values = map[string]bool{}
+			for _, opt := range options {
+				values[opt.Value] = true
+			}
+		}
+		o.cache[arg] = values
+	}
+	return values == nil || values[value], nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedSelectableKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
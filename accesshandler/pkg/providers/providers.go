@@ -43,6 +43,13 @@ type Validator interface {
 	Validate(ctx context.Context, subject string, args []byte) error
 }
 
+// IsActivers know how to check whether access has been provisioned,
+// so that grants which have drifted from their provider can be found.
+type IsActiver interface {
+	// IsActive returns true if the access is currently provisioned for the subject.
+	IsActive(ctx context.Context, subject string, args []byte) (bool, error)
+}
+
 // ArgSchemarers provide a JSON Schema for the arguments they accept.
 type ArgSchemarer interface {
 	ArgSchema() *jsonschema.Schema
@@ -0,0 +1,131 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_config "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	sfnTypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+)
+
+// extensionExecutionSeparator separates the grant ID from the new end time in the names of executions started by ExtendGrant.
+const extensionExecutionSeparator = "-extended-"
+
+// ExtendGrant extends an active grant.
+//
+// The input of a running Step Functions execution can't be changed, so a new execution is started
+// with the updated end time and the previous execution is stopped. The state machine skips activation
+// for grants which are already active, so the user keeps their access throughout.
+func (r *Runtime) ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error) {
+	logger.Get(ctx).Infow("extending grant", "grant", grantID, "end", end)
+
+	c, err := aws_config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sfnClient := sfn.NewFromConfig(c)
+
+	exeARN, err := currentExecutionARN(ctx, sfnClient, r.StateMachineARN, grantID)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := sfnClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(exeARN)})
+	if err != nil {
+		return nil, err
+	}
+
+	var grantInput WorkflowInput
+	err = json.Unmarshal([]byte(*out.Input), &grantInput)
+	if err != nil {
+		return nil, err
+	}
+	grant := grantInput.Grant
+
+	if !end.After(grant.End.Time) {
+		return nil, types.ErrInvalidGrantTime{Msg: "extended grant end time must be after the current end time"}
+	}
+
+	statefn, err := sfnClient.GetExecutionHistory(ctx, &sfn.GetExecutionHistoryInput{ExecutionArn: &exeARN, ReverseOrder: true, MaxResults: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(statefn.Events) == 0 {
+		return nil, types.ErrGrantNotActive
+	}
+	lastState := statefn.Events[0]
+	if lastState.Type != sfnTypes.HistoryEventTypeWaitStateEntered || *lastState.StateEnteredEventDetails.Name != "Wait for Window End" {
+		return nil, types.ErrGrantNotActive
+	}
+
+	grant.Status = types.ACTIVE
+	grant.End = iso8601.New(end)
+
+	inJson, err := json.Marshal(WorkflowInput{Grant: grant})
+	if err != nil {
+		return nil, err
+	}
+
+	// start the new execution before stopping the previous one, so that the grant always has an execution which will expire it.
+	_, err = sfnClient.StartExecution(ctx, &sfn.StartExecutionInput{
+		StateMachineArn: aws.String(r.StateMachineARN),
+		Input:           aws.String(string(inJson)),
+		Name:            aws.String(ExtensionExecutionName(grantID, end)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = sfnClient.StopExecution(ctx, &sfn.StopExecutionInput{ExecutionArn: &exeARN, Cause: aws.String("grant extended")})
+	if err != nil {
+		return nil, err
+	}
+
+	return &grant, nil
+}
+
+// ExtensionExecutionName returns the name of the Step Functions execution for an extended grant.
+// Execution names can't be reused, so the new end time is included in the name.
+func ExtensionExecutionName(grantID string, end time.Time) string {
+	return fmt.Sprintf("%s%s%d", grantID, extensionExecutionSeparator, end.Unix())
+}
+
+// currentExecutionARN returns the ARN of the execution which is managing the grant.
+// If the grant has been extended, this is the running execution started by ExtendGrant
+// rather than the execution named after the grant ID.
+func currentExecutionARN(ctx context.Context, sfnClient *sfn.Client, stateMachineARN string, grantID string) (string, error) {
+	exeARN := BuildExecutionARN(stateMachineARN, grantID)
+	out, err := sfnClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(exeARN)})
+	if err != nil {
+		return "", err
+	}
+	if out.Status != sfnTypes.ExecutionStatusAborted {
+		return exeARN, nil
+	}
+
+	p := sfn.NewListExecutionsPaginator(sfnClient, &sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+		StatusFilter:    sfnTypes.ExecutionStatusRunning,
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, e := range page.Executions {
+			if strings.HasPrefix(aws.ToString(e.Name), grantID+extensionExecutionSeparator) {
+				return aws.ToString(e.ExecutionArn), nil
+			}
+		}
+	}
+	// the grant has not been extended, it was stopped some other way such as being revoked.
+	return exeARN, nil
+}
@@ -29,8 +29,11 @@ func (r *Runtime) RevokeGrant(ctx context.Context, grantID string, revoker strin
 	}
 	sfnClient := sfn.NewFromConfig(c)
 
-	//build the execution ARN
-	exeARN := BuildExecutionARN(r.GranterStateMachineARN, grantID)
+	//find the execution ARN, this may be an extension of the original execution
+	exeARN, err := currentExecutionARN(ctx, sfnClient, r.GranterStateMachineARN, grantID)
+	if err != nil {
+		return nil, err
+	}
 
 	out, err := sfnClient.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: aws.String(exeARN)})
 	if err != nil {
@@ -27,8 +27,13 @@ func (r *Runtime) CreateGrant(ctx context.Context, vcg types.ValidCreateGrant) (
 
 		logger.Get(ctx).Infow("activating grant", "grant", grant)
 
-		dur := grant.End.Sub(grant.Start.Time)
-		time.Sleep(dur)
+		// the grant may be extended while it is active, so check the stored end time
+		// each time we wake up rather than sleeping for the original duration.
+		end := grant.End.Time
+		for time.Now().Before(end) {
+			time.Sleep(time.Until(end))
+			end = r.grantEnd(grant.ID, end)
+		}
 
 		logger.Get(ctx).Infow("deactivating grant", "grant", grant)
 	}()
@@ -0,0 +1,53 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/iso8601"
+)
+
+// ExtendGrant updates the end time of the grant in memory.
+// The goroutine started by CreateGrant picks up the new end time before deactivating the grant.
+func (r *Runtime) ExtendGrant(ctx context.Context, grantID string, end time.Time) (*types.Grant, error) {
+	logger.Get(ctx).Infow("extending grant", "grant", grantID, "end", end)
+	tx := r.db.Txn(true)
+	defer tx.Commit()
+
+	raw, err := tx.First("grants", "id", grantID)
+	if err != nil {
+		tx.Abort()
+		return nil, err
+	}
+	if raw == nil {
+		tx.Abort()
+		return nil, types.ErrGrantNotFound
+	}
+	// copy the grant rather than modifying the stored object, memdb objects must not be mutated in place.
+	grant := *raw.(*types.Grant)
+	if !end.After(grant.End.Time) {
+		tx.Abort()
+		return nil, types.ErrInvalidGrantTime{Msg: "extended grant end time must be after the current end time"}
+	}
+	grant.End = iso8601.New(end)
+
+	err = tx.Insert("grants", &grant)
+	if err != nil {
+		tx.Abort()
+		return nil, err
+	}
+	return &grant, nil
+}
+
+// grantEnd returns the current end time of the grant, or fallback if the grant can't be found.
+func (r *Runtime) grantEnd(grantID string, fallback time.Time) time.Time {
+	tx := r.db.Txn(false)
+	defer tx.Abort()
+	raw, err := tx.First("grants", "id", grantID)
+	if err != nil || raw == nil {
+		return fallback
+	}
+	return raw.(*types.Grant).End.Time
+}
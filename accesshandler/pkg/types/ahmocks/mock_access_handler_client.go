@@ -176,6 +176,46 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) ListProvidersWithRespons
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProvidersWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ListProvidersWithResponse), varargs...)
 }
 
+// PostGrantsExtendWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) PostGrantsExtendWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.PostGrantsExtendResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostGrantsExtendWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.PostGrantsExtendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrantsExtendWithBodyWithResponse indicates an expected call of PostGrantsExtendWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) PostGrantsExtendWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsExtendWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).PostGrantsExtendWithBodyWithResponse), varargs...)
+}
+
+// PostGrantsExtendWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) PostGrantsExtendWithResponse(arg0 context.Context, arg1 string, arg2 types.PostGrantsExtendJSONRequestBody, arg3 ...types.RequestEditorFn) (*types.PostGrantsExtendResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostGrantsExtendWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.PostGrantsExtendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrantsExtendWithResponse indicates an expected call of PostGrantsExtendWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) PostGrantsExtendWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsExtendWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).PostGrantsExtendWithResponse), varargs...)
+}
+
 // PostGrantsRevokeWithBodyWithResponse mocks base method.
 func (m *MockClientWithResponsesInterface) PostGrantsRevokeWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.PostGrantsRevokeResponse, error) {
 	m.ctrl.T.Helper()
@@ -255,3 +295,123 @@ func (mr *MockClientWithResponsesInterfaceMockRecorder) PostGrantsWithResponse(a
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).PostGrantsWithResponse), varargs...)
 }
+
+// ReconcileGrantsWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ReconcileGrantsWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...types.RequestEditorFn) (*types.ReconcileGrantsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReconcileGrantsWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ReconcileGrantsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileGrantsWithBodyWithResponse indicates an expected call of ReconcileGrantsWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ReconcileGrantsWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileGrantsWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ReconcileGrantsWithBodyWithResponse), varargs...)
+}
+
+// ReconcileGrantsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ReconcileGrantsWithResponse(arg0 context.Context, arg1 types.ReconcileGrantsJSONRequestBody, arg2 ...types.RequestEditorFn) (*types.ReconcileGrantsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReconcileGrantsWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ReconcileGrantsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileGrantsWithResponse indicates an expected call of ReconcileGrantsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ReconcileGrantsWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileGrantsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ReconcileGrantsWithResponse), varargs...)
+}
+
+// ValidateGrantWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ValidateGrantWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...types.RequestEditorFn) (*types.ValidateGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateGrantWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ValidateGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateGrantWithBodyWithResponse indicates an expected call of ValidateGrantWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ValidateGrantWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGrantWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ValidateGrantWithBodyWithResponse), varargs...)
+}
+
+// ValidateGrantWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ValidateGrantWithResponse(arg0 context.Context, arg1 types.CreateGrant, arg2 ...types.RequestEditorFn) (*types.ValidateGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateGrantWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ValidateGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateGrantWithResponse indicates an expected call of ValidateGrantWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ValidateGrantWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGrantWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ValidateGrantWithResponse), varargs...)
+}
+
+// ValidateProviderArgsWithBodyWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ValidateProviderArgsWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.ValidateProviderArgsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateProviderArgsWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ValidateProviderArgsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateProviderArgsWithBodyWithResponse indicates an expected call of ValidateProviderArgsWithBodyWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ValidateProviderArgsWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateProviderArgsWithBodyWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ValidateProviderArgsWithBodyWithResponse), varargs...)
+}
+
+// ValidateProviderArgsWithResponse mocks base method.
+func (m *MockClientWithResponsesInterface) ValidateProviderArgsWithResponse(arg0 context.Context, arg1 string, arg2 types.ValidateArgs, arg3 ...types.RequestEditorFn) (*types.ValidateProviderArgsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateProviderArgsWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ValidateProviderArgsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateProviderArgsWithResponse indicates an expected call of ValidateProviderArgsWithResponse.
+func (mr *MockClientWithResponsesInterfaceMockRecorder) ValidateProviderArgsWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateProviderArgsWithResponse", reflect.TypeOf((*MockClientWithResponsesInterface)(nil).ValidateProviderArgsWithResponse), varargs...)
+}
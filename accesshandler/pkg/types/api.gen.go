@@ -33,6 +33,14 @@ const (
 	REVOKED GrantStatus = "REVOKED"
 )
 
+// Defines values for GrantReconciliationDrift.
+const (
+	INSYNC     GrantReconciliationDrift = "IN_SYNC"
+	MISSING    GrantReconciliationDrift = "MISSING"
+	NOTREMOVED GrantReconciliationDrift = "NOT_REMOVED"
+	UNCHECKED  GrantReconciliationDrift = "UNCHECKED"
+)
+
 // Instructions on how to access the requested resource.
 //
 // The `instructions` field will be null if no instructions are available.
@@ -67,6 +75,12 @@ type CreateGrant_With struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// An error with a single field of a request.
+type FieldError struct {
+	Error string `json:"error"`
+	Field string `json:"field"`
+}
+
 // A temporary assignment of a user to a principal.
 type Grant struct {
 	// The end time of the grant in ISO8601 format.
@@ -97,6 +111,32 @@ type Grant_With struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// The result of checking a grant with its provider.
+type GrantReconciliation struct {
+	// How the grant differs from its provider.
+	//
+	// IN_SYNC: the grant matches its status, or it is EXPIRED and the same access has been granted again by an ACTIVE grant.
+	// MISSING: the grant is ACTIVE but isn't provisioned in the provider.
+	// NOT_REMOVED: the grant is EXPIRED but is still provisioned in the provider.
+	// UNCHECKED: the grant couldn't be checked, see the error for details.
+	Drift GrantReconciliationDrift `json:"drift"`
+
+	// Why the grant couldn't be checked or remediated.
+	Error   *string `json:"error,omitempty"`
+	GrantId string  `json:"grantId"`
+
+	// Whether the drift was remediated, by provisioning a MISSING grant again or by revoking a NOT_REMOVED grant.
+	Remediated bool `json:"remediated"`
+}
+
+// How the grant differs from its provider.
+//
+// IN_SYNC: the grant matches its status, or it is EXPIRED and the same access has been granted again by an ACTIVE grant.
+// MISSING: the grant is ACTIVE but isn't provisioned in the provider.
+// NOT_REMOVED: the grant is EXPIRED but is still provisioned in the provider.
+// UNCHECKED: the grant couldn't be checked, see the error for details.
+type GrantReconciliationDrift string
+
 // Option defines model for Option.
 type Option struct {
 	Label string `json:"label"`
@@ -121,6 +161,25 @@ type ProviderHealth struct {
 	ID string `json:"id"`
 }
 
+// Arguments for a provider to be validated.
+type ValidateArgs struct {
+	// The arguments which the requestor chooses a value for, with the values they can choose from. An argument with no values can be any of the provider's options.
+	Selectable *ValidateArgs_Selectable `json:"selectable,omitempty"`
+
+	// The arguments which have a fixed value.
+	With ValidateArgs_With `json:"with"`
+}
+
+// The arguments which the requestor chooses a value for, with the values they can choose from. An argument with no values can be any of the provider's options.
+type ValidateArgs_Selectable struct {
+	AdditionalProperties map[string][]string `json:"-"`
+}
+
+// The arguments which have a fixed value.
+type ValidateArgs_With struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // ArgOptionsResponse defines model for ArgOptionsResponse.
 type ArgOptionsResponse struct {
 	// Whether any options have been suggested for the argument.
@@ -135,6 +194,11 @@ type ErrorResponse struct {
 	Error *string `json:"error,omitempty"`
 }
 
+// GrantReconciliationsResponse defines model for GrantReconciliationsResponse.
+type GrantReconciliationsResponse struct {
+	Results []GrantReconciliation `json:"results"`
+}
+
 // GrantResponse defines model for GrantResponse.
 type GrantResponse struct {
 	// A temporary assignment of a user to a principal.
@@ -146,9 +210,36 @@ type HealthResponse struct {
 	Health *ProviderHealth `json:"health,omitempty"`
 }
 
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	Error  string        `json:"error"`
+	Fields *[]FieldError `json:"fields,omitempty"`
+}
+
 // PostGrantsJSONBody defines parameters for PostGrants.
 type PostGrantsJSONBody = CreateGrant
 
+// ReconcileGrantsJSONBody defines parameters for ReconcileGrants.
+type ReconcileGrantsJSONBody struct {
+	// The grants to check. Each grant must be ACTIVE or EXPIRED.
+	Grants []Grant `json:"grants"`
+
+	// Whether ACTIVE grants which are missing are provisioned again. It doesn't remove access for EXPIRED grants which are still provisioned, see `revoke`.
+	Remediate *bool `json:"remediate,omitempty"`
+
+	// Whether access is removed for EXPIRED grants which are still provisioned. This is separate from `remediate`, as the access may have been provisioned outside of Granted and removing it could lock the user out.
+	Revoke *bool `json:"revoke,omitempty"`
+}
+
+// ValidateGrantJSONBody defines parameters for ValidateGrant.
+type ValidateGrantJSONBody = CreateGrant
+
+// PostGrantsExtendJSONBody defines parameters for PostGrantsExtend.
+type PostGrantsExtendJSONBody struct {
+	// The new end time of the grant in ISO8601 format. Must be after the current end time.
+	End iso8601.Time `json:"end"`
+}
+
 // PostGrantsRevokeJSONBody defines parameters for PostGrantsRevoke.
 type PostGrantsRevokeJSONBody struct {
 	// An id representiing the user calling this API will be included in the GrantRevoked event
@@ -164,12 +255,27 @@ type GetAccessInstructionsParams struct {
 	Args string `form:"args" json:"args"`
 }
 
+// ValidateProviderArgsJSONBody defines parameters for ValidateProviderArgs.
+type ValidateProviderArgsJSONBody = ValidateArgs
+
 // PostGrantsJSONRequestBody defines body for PostGrants for application/json ContentType.
 type PostGrantsJSONRequestBody = PostGrantsJSONBody
 
+// ReconcileGrantsJSONRequestBody defines body for ReconcileGrants for application/json ContentType.
+type ReconcileGrantsJSONRequestBody ReconcileGrantsJSONBody
+
+// ValidateGrantJSONRequestBody defines body for ValidateGrant for application/json ContentType.
+type ValidateGrantJSONRequestBody = ValidateGrantJSONBody
+
+// PostGrantsExtendJSONRequestBody defines body for PostGrantsExtend for application/json ContentType.
+type PostGrantsExtendJSONRequestBody PostGrantsExtendJSONBody
+
 // PostGrantsRevokeJSONRequestBody defines body for PostGrantsRevoke for application/json ContentType.
 type PostGrantsRevokeJSONRequestBody PostGrantsRevokeJSONBody
 
+// ValidateProviderArgsJSONRequestBody defines body for ValidateProviderArgs for application/json ContentType.
+type ValidateProviderArgsJSONRequestBody = ValidateProviderArgsJSONBody
+
 // Getter for additional properties for CreateGrant_With. Returns the specified
 // element and whether it was found
 func (a CreateGrant_With) Get(fieldName string) (value string, found bool) {
@@ -276,6 +382,112 @@ func (a Grant_With) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for ValidateArgs_Selectable. Returns the specified
+// element and whether it was found
+func (a ValidateArgs_Selectable) Get(fieldName string) (value []string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ValidateArgs_Selectable
+func (a *ValidateArgs_Selectable) Set(fieldName string, value []string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string][]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ValidateArgs_Selectable to handle AdditionalProperties
+func (a *ValidateArgs_Selectable) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string][]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal []string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ValidateArgs_Selectable to handle AdditionalProperties
+func (a ValidateArgs_Selectable) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for ValidateArgs_With. Returns the specified
+// element and whether it was found
+func (a ValidateArgs_With) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ValidateArgs_With
+func (a *ValidateArgs_With) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ValidateArgs_With to handle AdditionalProperties
+func (a *ValidateArgs_With) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ValidateArgs_With to handle AdditionalProperties
+func (a ValidateArgs_With) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -357,6 +569,21 @@ type ClientInterface interface {
 
 	PostGrants(ctx context.Context, body PostGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ReconcileGrants request with any body
+	ReconcileGrantsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReconcileGrants(ctx context.Context, body ReconcileGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateGrant request with any body
+	ValidateGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateGrant(ctx context.Context, body ValidateGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostGrantsExtend request with any body
+	PostGrantsExtendWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostGrantsExtend(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostGrantsRevoke request with any body
 	PostGrantsRevokeWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -377,6 +604,11 @@ type ClientInterface interface {
 	// GetProviderArgs request
 	GetProviderArgs(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ValidateProviderArgs request with any body
+	ValidateProviderArgsWithBody(ctx context.Context, providerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateProviderArgs(ctx context.Context, providerId string, body ValidateProviderArgsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListProviderArgOptions request
 	ListProviderArgOptions(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -417,6 +649,78 @@ func (c *Client) PostGrants(ctx context.Context, body PostGrantsJSONRequestBody,
 	return c.Client.Do(req)
 }
 
+func (c *Client) ReconcileGrantsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReconcileGrantsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReconcileGrants(ctx context.Context, body ReconcileGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReconcileGrantsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateGrantWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateGrantRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateGrant(ctx context.Context, body ValidateGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateGrantRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGrantsExtendWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGrantsExtendRequestWithBody(c.Server, grantId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGrantsExtend(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGrantsExtendRequest(c.Server, grantId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostGrantsRevokeWithBody(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostGrantsRevokeRequestWithBody(c.Server, grantId, contentType, body)
 	if err != nil {
@@ -501,6 +805,30 @@ func (c *Client) GetProviderArgs(ctx context.Context, providerId string, reqEdit
 	return c.Client.Do(req)
 }
 
+func (c *Client) ValidateProviderArgsWithBody(ctx context.Context, providerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateProviderArgsRequestWithBody(c.Server, providerId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateProviderArgs(ctx context.Context, providerId string, body ValidateProviderArgsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateProviderArgsRequest(c.Server, providerId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListProviderArgOptions(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListProviderArgOptionsRequest(c.Server, providerId, argId)
 	if err != nil {
@@ -580,34 +908,27 @@ func NewPostGrantsRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewPostGrantsRevokeRequest calls the generic PostGrantsRevoke builder with application/json body
-func NewPostGrantsRevokeRequest(server string, grantId string, body PostGrantsRevokeJSONRequestBody) (*http.Request, error) {
+// NewReconcileGrantsRequest calls the generic ReconcileGrants builder with application/json body
+func NewReconcileGrantsRequest(server string, body ReconcileGrantsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostGrantsRevokeRequestWithBody(server, grantId, "application/json", bodyReader)
+	return NewReconcileGrantsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostGrantsRevokeRequestWithBody generates requests for PostGrantsRevoke with any type of body
-func NewPostGrantsRevokeRequestWithBody(server string, grantId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewReconcileGrantsRequestWithBody generates requests for ReconcileGrants with any type of body
+func NewReconcileGrantsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "grantId", runtime.ParamLocationPath, grantId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/grants/%s/revoke", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/grants/reconcile")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -627,8 +948,19 @@ func NewPostGrantsRevokeRequestWithBody(server string, grantId string, contentTy
 	return req, nil
 }
 
-// NewGetHealthRequest generates requests for GetHealth
-func NewGetHealthRequest(server string) (*http.Request, error) {
+// NewValidateGrantRequest calls the generic ValidateGrant builder with application/json body
+func NewValidateGrantRequest(server string, body ValidateGrantJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateGrantRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewValidateGrantRequestWithBody generates requests for ValidateGrant with any type of body
+func NewValidateGrantRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -636,7 +968,7 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/health")
+	operationPath := fmt.Sprintf("/api/v1/grants/validate")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -646,16 +978,139 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListProvidersRequest generates requests for ListProviders
-func NewListProvidersRequest(server string) (*http.Request, error) {
+// NewPostGrantsExtendRequest calls the generic PostGrantsExtend builder with application/json body
+func NewPostGrantsExtendRequest(server string, grantId string, body PostGrantsExtendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGrantsExtendRequestWithBody(server, grantId, "application/json", bodyReader)
+}
+
+// NewPostGrantsExtendRequestWithBody generates requests for PostGrantsExtend with any type of body
+func NewPostGrantsExtendRequestWithBody(server string, grantId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "grantId", runtime.ParamLocationPath, grantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/grants/%s/extend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostGrantsRevokeRequest calls the generic PostGrantsRevoke builder with application/json body
+func NewPostGrantsRevokeRequest(server string, grantId string, body PostGrantsRevokeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGrantsRevokeRequestWithBody(server, grantId, "application/json", bodyReader)
+}
+
+// NewPostGrantsRevokeRequestWithBody generates requests for PostGrantsRevoke with any type of body
+func NewPostGrantsRevokeRequestWithBody(server string, grantId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "grantId", runtime.ParamLocationPath, grantId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/grants/%s/revoke", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProvidersRequest generates requests for ListProviders
+func NewListProvidersRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -811,6 +1266,53 @@ func NewGetProviderArgsRequest(server string, providerId string) (*http.Request,
 	return req, nil
 }
 
+// NewValidateProviderArgsRequest calls the generic ValidateProviderArgs builder with application/json body
+func NewValidateProviderArgsRequest(server string, providerId string, body ValidateProviderArgsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateProviderArgsRequestWithBody(server, providerId, "application/json", bodyReader)
+}
+
+// NewValidateProviderArgsRequestWithBody generates requests for ValidateProviderArgs with any type of body
+func NewValidateProviderArgsRequestWithBody(server string, providerId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "providerId", runtime.ParamLocationPath, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/%s/args/validate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewListProviderArgOptionsRequest generates requests for ListProviderArgOptions
 func NewListProviderArgOptionsRequest(server string, providerId string, argId string) (*http.Request, error) {
 	var err error
@@ -903,6 +1405,21 @@ type ClientWithResponsesInterface interface {
 
 	PostGrantsWithResponse(ctx context.Context, body PostGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsResponse, error)
 
+	// ReconcileGrants request with any body
+	ReconcileGrantsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReconcileGrantsResponse, error)
+
+	ReconcileGrantsWithResponse(ctx context.Context, body ReconcileGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReconcileGrantsResponse, error)
+
+	// ValidateGrant request with any body
+	ValidateGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error)
+
+	ValidateGrantWithResponse(ctx context.Context, body ValidateGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error)
+
+	// PostGrantsExtend request with any body
+	PostGrantsExtendWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error)
+
+	PostGrantsExtendWithResponse(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error)
+
 	// PostGrantsRevoke request with any body
 	PostGrantsRevokeWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsRevokeResponse, error)
 
@@ -923,6 +1440,11 @@ type ClientWithResponsesInterface interface {
 	// GetProviderArgs request
 	GetProviderArgsWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*GetProviderArgsResponse, error)
 
+	// ValidateProviderArgs request with any body
+	ValidateProviderArgsWithBodyWithResponse(ctx context.Context, providerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateProviderArgsResponse, error)
+
+	ValidateProviderArgsWithResponse(ctx context.Context, providerId string, body ValidateProviderArgsJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateProviderArgsResponse, error)
+
 	// ListProviderArgOptions request
 	ListProviderArgOptionsWithResponse(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*ListProviderArgOptionsResponse, error)
 }
@@ -985,6 +1507,100 @@ func (r PostGrantsResponse) StatusCode() int {
 	return 0
 }
 
+type ReconcileGrantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Results []GrantReconciliation `json:"results"`
+	}
+	JSON400 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ReconcileGrantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReconcileGrantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateGrantResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON404 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGrantsExtendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// A temporary assignment of a user to a principal.
+		Grant *Grant `json:"grant,omitempty"`
+	}
+	JSON400 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON404 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGrantsExtendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGrantsExtendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostGrantsRevokeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1149,6 +1765,37 @@ func (r GetProviderArgsResponse) StatusCode() int {
 	return 0
 }
 
+type ValidateProviderArgsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Error  string        `json:"error"`
+		Fields *[]FieldError `json:"fields,omitempty"`
+	}
+	JSON404 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+	JSON500 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateProviderArgsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateProviderArgsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ListProviderArgOptionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1209,6 +1856,57 @@ func (c *ClientWithResponses) PostGrantsWithResponse(ctx context.Context, body P
 	return ParsePostGrantsResponse(rsp)
 }
 
+// ReconcileGrantsWithBodyWithResponse request with arbitrary body returning *ReconcileGrantsResponse
+func (c *ClientWithResponses) ReconcileGrantsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReconcileGrantsResponse, error) {
+	rsp, err := c.ReconcileGrantsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReconcileGrantsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReconcileGrantsWithResponse(ctx context.Context, body ReconcileGrantsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReconcileGrantsResponse, error) {
+	rsp, err := c.ReconcileGrants(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReconcileGrantsResponse(rsp)
+}
+
+// ValidateGrantWithBodyWithResponse request with arbitrary body returning *ValidateGrantResponse
+func (c *ClientWithResponses) ValidateGrantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error) {
+	rsp, err := c.ValidateGrantWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateGrantWithResponse(ctx context.Context, body ValidateGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateGrantResponse, error) {
+	rsp, err := c.ValidateGrant(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateGrantResponse(rsp)
+}
+
+// PostGrantsExtendWithBodyWithResponse request with arbitrary body returning *PostGrantsExtendResponse
+func (c *ClientWithResponses) PostGrantsExtendWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error) {
+	rsp, err := c.PostGrantsExtendWithBody(ctx, grantId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGrantsExtendResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostGrantsExtendWithResponse(ctx context.Context, grantId string, body PostGrantsExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGrantsExtendResponse, error) {
+	rsp, err := c.PostGrantsExtend(ctx, grantId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGrantsExtendResponse(rsp)
+}
+
 // PostGrantsRevokeWithBodyWithResponse request with arbitrary body returning *PostGrantsRevokeResponse
 func (c *ClientWithResponses) PostGrantsRevokeWithBodyWithResponse(ctx context.Context, grantId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGrantsRevokeResponse, error) {
 	rsp, err := c.PostGrantsRevokeWithBody(ctx, grantId, contentType, body, reqEditors...)
@@ -1235,73 +1933,229 @@ func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEdit
 	return ParseGetHealthResponse(rsp)
 }
 
-// ListProvidersWithResponse request returning *ListProvidersResponse
-func (c *ClientWithResponses) ListProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProvidersResponse, error) {
-	rsp, err := c.ListProviders(ctx, reqEditors...)
+// ListProvidersWithResponse request returning *ListProvidersResponse
+func (c *ClientWithResponses) ListProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProvidersResponse, error) {
+	rsp, err := c.ListProviders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProvidersResponse(rsp)
+}
+
+// GetProviderWithResponse request returning *GetProviderResponse
+func (c *ClientWithResponses) GetProviderWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*GetProviderResponse, error) {
+	rsp, err := c.GetProvider(ctx, providerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProviderResponse(rsp)
+}
+
+// GetAccessInstructionsWithResponse request returning *GetAccessInstructionsResponse
+func (c *ClientWithResponses) GetAccessInstructionsWithResponse(ctx context.Context, providerId string, params *GetAccessInstructionsParams, reqEditors ...RequestEditorFn) (*GetAccessInstructionsResponse, error) {
+	rsp, err := c.GetAccessInstructions(ctx, providerId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccessInstructionsResponse(rsp)
+}
+
+// GetProviderArgsWithResponse request returning *GetProviderArgsResponse
+func (c *ClientWithResponses) GetProviderArgsWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*GetProviderArgsResponse, error) {
+	rsp, err := c.GetProviderArgs(ctx, providerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProviderArgsResponse(rsp)
+}
+
+// ValidateProviderArgsWithBodyWithResponse request with arbitrary body returning *ValidateProviderArgsResponse
+func (c *ClientWithResponses) ValidateProviderArgsWithBodyWithResponse(ctx context.Context, providerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateProviderArgsResponse, error) {
+	rsp, err := c.ValidateProviderArgsWithBody(ctx, providerId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateProviderArgsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateProviderArgsWithResponse(ctx context.Context, providerId string, body ValidateProviderArgsJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateProviderArgsResponse, error) {
+	rsp, err := c.ValidateProviderArgs(ctx, providerId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateProviderArgsResponse(rsp)
+}
+
+// ListProviderArgOptionsWithResponse request returning *ListProviderArgOptionsResponse
+func (c *ClientWithResponses) ListProviderArgOptionsWithResponse(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*ListProviderArgOptionsResponse, error) {
+	rsp, err := c.ListProviderArgOptions(ctx, providerId, argId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProviderArgOptionsResponse(rsp)
+}
+
+// ParseGetGrantsResponse parses an HTTP response from a GetGrantsWithResponse call
+func ParseGetGrantsResponse(rsp *http.Response) (*GetGrantsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGrantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Grants *[]Grant `json:"grants,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostGrantsResponse parses an HTTP response from a PostGrantsWithResponse call
+func ParsePostGrantsResponse(rsp *http.Response) (*PostGrantsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProvidersResponse(rsp)
-}
 
-// GetProviderWithResponse request returning *GetProviderResponse
-func (c *ClientWithResponses) GetProviderWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*GetProviderResponse, error) {
-	rsp, err := c.GetProvider(ctx, providerId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostGrantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetProviderResponse(rsp)
-}
 
-// GetAccessInstructionsWithResponse request returning *GetAccessInstructionsResponse
-func (c *ClientWithResponses) GetAccessInstructionsWithResponse(ctx context.Context, providerId string, params *GetAccessInstructionsParams, reqEditors ...RequestEditorFn) (*GetAccessInstructionsResponse, error) {
-	rsp, err := c.GetAccessInstructions(ctx, providerId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			// A temporary assignment of a user to a principal.
+			Grant *Grant `json:"grant,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetAccessInstructionsResponse(rsp)
+
+	return response, nil
 }
 
-// GetProviderArgsWithResponse request returning *GetProviderArgsResponse
-func (c *ClientWithResponses) GetProviderArgsWithResponse(ctx context.Context, providerId string, reqEditors ...RequestEditorFn) (*GetProviderArgsResponse, error) {
-	rsp, err := c.GetProviderArgs(ctx, providerId, reqEditors...)
+// ParseReconcileGrantsResponse parses an HTTP response from a ReconcileGrantsWithResponse call
+func ParseReconcileGrantsResponse(rsp *http.Response) (*ReconcileGrantsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProviderArgsResponse(rsp)
-}
 
-// ListProviderArgOptionsWithResponse request returning *ListProviderArgOptionsResponse
-func (c *ClientWithResponses) ListProviderArgOptionsWithResponse(ctx context.Context, providerId string, argId string, reqEditors ...RequestEditorFn) (*ListProviderArgOptionsResponse, error) {
-	rsp, err := c.ListProviderArgOptions(ctx, providerId, argId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ReconcileGrantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListProviderArgOptionsResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Results []GrantReconciliation `json:"results"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetGrantsResponse parses an HTTP response from a GetGrantsWithResponse call
-func ParseGetGrantsResponse(rsp *http.Response) (*GetGrantsResponse, error) {
+// ParseValidateGrantResponse parses an HTTP response from a ValidateGrantWithResponse call
+func ParseValidateGrantResponse(rsp *http.Response) (*ValidateGrantResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetGrantsResponse{
+	response := &ValidateGrantResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
-			Grants *[]Grant `json:"grants,omitempty"`
+			Error *string `json:"error,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
@@ -1317,21 +2171,21 @@ func ParseGetGrantsResponse(rsp *http.Response) (*GetGrantsResponse, error) {
 	return response, nil
 }
 
-// ParsePostGrantsResponse parses an HTTP response from a PostGrantsWithResponse call
-func ParsePostGrantsResponse(rsp *http.Response) (*PostGrantsResponse, error) {
+// ParsePostGrantsExtendResponse parses an HTTP response from a PostGrantsExtendWithResponse call
+func ParsePostGrantsExtendResponse(rsp *http.Response) (*PostGrantsExtendResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostGrantsResponse{
+	response := &PostGrantsExtendResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			// A temporary assignment of a user to a principal.
 			Grant *Grant `json:"grant,omitempty"`
@@ -1339,7 +2193,7 @@ func ParsePostGrantsResponse(rsp *http.Response) (*PostGrantsResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
@@ -1350,6 +2204,15 @@ func ParsePostGrantsResponse(rsp *http.Response) (*PostGrantsResponse, error) {
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest struct {
 			Error *string `json:"error,omitempty"`
@@ -1606,6 +2469,53 @@ func ParseGetProviderArgsResponse(rsp *http.Response) (*GetProviderArgsResponse,
 	return response, nil
 }
 
+// ParseValidateProviderArgsResponse parses an HTTP response from a ValidateProviderArgsWithResponse call
+func ParseValidateProviderArgsResponse(rsp *http.Response) (*ValidateProviderArgsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateProviderArgsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error  string        `json:"error"`
+			Fields *[]FieldError `json:"fields,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest struct {
+			Error *string `json:"error,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListProviderArgOptionsResponse parses an HTTP response from a ListProviderArgOptionsWithResponse call
 func ParseListProviderArgOptionsResponse(rsp *http.Response) (*ListProviderArgOptionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -1664,6 +2574,15 @@ type ServerInterface interface {
 	// Create Grant
 	// (POST /api/v1/grants)
 	PostGrants(w http.ResponseWriter, r *http.Request)
+	// Reconcile Grants
+	// (POST /api/v1/grants/reconcile)
+	ReconcileGrants(w http.ResponseWriter, r *http.Request)
+	// Validate Grant
+	// (POST /api/v1/grants/validate)
+	ValidateGrant(w http.ResponseWriter, r *http.Request)
+	// Extend grant
+	// (POST /api/v1/grants/{grantId}/extend)
+	PostGrantsExtend(w http.ResponseWriter, r *http.Request, grantId string)
 	// Revoke grant
 	// (POST /api/v1/grants/{grantId}/revoke)
 	PostGrantsRevoke(w http.ResponseWriter, r *http.Request, grantId string)
@@ -1682,6 +2601,9 @@ type ServerInterface interface {
 	// Get provider arg schema
 	// (GET /api/v1/providers/{providerId}/args)
 	GetProviderArgs(w http.ResponseWriter, r *http.Request, providerId string)
+	// Validate provider args
+	// (POST /api/v1/providers/{providerId}/args/validate)
+	ValidateProviderArgs(w http.ResponseWriter, r *http.Request, providerId string)
 	// List provider arg options
 	// (GET /api/v1/providers/{providerId}/args/{argId}/options)
 	ListProviderArgOptions(w http.ResponseWriter, r *http.Request, providerId string, argId string)
@@ -1726,6 +2648,62 @@ func (siw *ServerInterfaceWrapper) PostGrants(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
+// ReconcileGrants operation middleware
+func (siw *ServerInterfaceWrapper) ReconcileGrants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReconcileGrants(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ValidateGrant operation middleware
+func (siw *ServerInterfaceWrapper) ValidateGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateGrant(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostGrantsExtend operation middleware
+func (siw *ServerInterfaceWrapper) PostGrantsExtend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "grantId" -------------
+	var grantId string
+
+	err = runtime.BindStyledParameter("simple", false, "grantId", chi.URLParam(r, "grantId"), &grantId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grantId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGrantsExtend(w, r, grantId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // PostGrantsRevoke operation middleware
 func (siw *ServerInterfaceWrapper) PostGrantsRevoke(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1891,6 +2869,32 @@ func (siw *ServerInterfaceWrapper) GetProviderArgs(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
+// ValidateProviderArgs operation middleware
+func (siw *ServerInterfaceWrapper) ValidateProviderArgs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "providerId" -------------
+	var providerId string
+
+	err = runtime.BindStyledParameter("simple", false, "providerId", chi.URLParam(r, "providerId"), &providerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateProviderArgs(w, r, providerId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // ListProviderArgOptions operation middleware
 func (siw *ServerInterfaceWrapper) ListProviderArgOptions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2045,6 +3049,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants", wrapper.PostGrants)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/grants/reconcile", wrapper.ReconcileGrants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/grants/validate", wrapper.ValidateGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/grants/{grantId}/extend", wrapper.PostGrantsExtend)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/grants/{grantId}/revoke", wrapper.PostGrantsRevoke)
 	})
@@ -2063,6 +3076,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/providers/{providerId}/args", wrapper.GetProviderArgs)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/providers/{providerId}/args/validate", wrapper.ValidateProviderArgs)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/providers/{providerId}/args/{argId}/options", wrapper.ListProviderArgOptions)
 	})
@@ -2073,43 +3089,65 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa627bOBZ+FYK7QHcB+Zo2mPjXZJtM6m23CZJgutidYkpLxxanEqmSlFMj8LsPDkld",
-	"LcVuk6IzwPyKrfByLt/5zkW+p6FMMylAGE1n91SBzqTQYL+cqtVlZrgU+to/xqehFAaEwY8syxIeMlwy",
-	"+k1Lgc90GEPK8FOmZAbKcHdYzLQ/DL9FoEPF7Xc6o+9iMDEowsSGSLeIxGwNZAEgiM5XK9AGIrKUipgY",
-	"CFOrPAVhhjSgZpMBndGFlAkwQbcBlX3X3MZQO8wvwzO4gdSu/7uCJZ3Rv40qq4ycQnrkpMcL/JVMKbah",
-	"221AFXzKuYKIzv5f17MS5X25SS5+g9DQLW5rSud3WSWZIBeKCVPTdBvQc6WkegJXAJ6DH7xM2iguVlaT",
-	"vVKeCmK3EwUmVwKdomRqvXIahqA1ecVElICyElslnkDiFZ6zz0H2sgO1IJqLVQLOylbUV8ASEz8F0O1B",
-	"+4S9UnLNI1Du2sOkdmvDGMKPpAhUspDRZmj3+6Nt5FpXzIU2Kg97oqH+XyIFieUdMZIw50V0KMLaxYoC",
-	"LXMVwvAX8YvAMPrAa7s/kCWHJCJ3PEnIAojIk4TwJRGS1JcRpoCwNeMJWySAcdc0HH+suDIBYpHpha3Y",
-	"oY5wbhJ81GGitgsC+nmgjcwSvootDHhEZ/TF8er4093dOMoW68/2yJcKmIGLAqNtqFnwoqwLIKFdGu0q",
-	"DyLqJiwQETE8BSKXVkt3GhdkfnP5w/F4gnyRMkuF8JmlmVVuOp5OB+PjweTodjKZHZ3MjsbDk+nkfzSg",
-	"bjmd0YgZGODJO2ZCvVdy4B9yLfGe4S0u3QbWCPe7rMAj6w6t+UrgJxNzTQTcOYF3XWENYEOgW+/5WaFx",
-	"sQ5PddoXTpdNreVHw2hAUy7egFhhDE46rtWGKdOTHPBfj7L2+OiJra1zh8VubKSMJ4RFkUJzeJFz3Wuq",
-	"Uhq7cb+p7rgjMhZFHK9lyVUDtDsbmiIWHDfQGYR8yUMvU8QMG5L/5NqQlJkwbnj5mSaOyIZ0lxKbqbaw",
-	"TQ1KXubCy4GNK4vZ91Xo1+O1K+a9Z62Kl4ipIj4fiqsKzQUOPdAegkfpXYpe+9FfPAxlSivrr5TMMwyy",
-	"KOVCY2IoMmsX2xhIM6mY2vhYxOoBocFKYDCSKS5CnrHkz89D1V1swcZRuGCDMfshHDw/OjkasOhkOjg+",
-	"eTEZH02PF9MT1neFYCk+nJ/9xUuH8pJhJu+pscNcKUQdrmlKbOUTeYrRe3X+9mz+9oIG9PTl7fzncxrQ",
-	"6/OfL1+fn9GAnv/3an7tPl1fX17XCuiawf6ixl5q5BEtfRQcTJQ1jnxidrSV04ER+nVM6vFYg9XXk6tv",
-	"9XZK+4QtIOn07polOXS3VHW3uAOK5TVz+xt33BqUSNmF+VXly1YhHXXK6B7sE9Eixy6piVe7qlfAV2Xf",
-	"09NttjNV+X0NvqdMQWu2ggCbhwbDci2eGeI6q02T5E6v5r/eXr4+f0s0hAoMiZkmQho3O/AnoE7YlWDj",
-	"QWdG5dAR0v74/vFES6S6PLtTCN6TR8sD5medWWJffupyVyF5h8e8V/YEsoM9F0tZNL7MEau/+KVMUynI",
-	"T8xgnshVQmc0NibTsxE2t6kUS2ZgyOUu01kigag1HSCnV3PabsaKf2KAgNJu/2Q4dkMdECzjdEaPhuPh",
-	"GDHPTGwBNmIZH60nI8ugblwAHVnhDdfGsayd+SBEbUM/R2q6AHPhtgfNGdh0PH7s3MLF5CEjJj/B6Jgw",
-	"7Z8evcZ9L5y0XXeUWo2aYyQ7OcjTlKlNYaTSEoatNILMq/EeiyOpO2zrKmrCfJYvpgTljMglt/mZGxFE",
-	"kGE1KUXH2OiZJioXWJAMybsYBH4TXKxw9em7G/KGpYuIEaRvcmMgIz/lwrXvgWs552cYmngwF2vp/FQr",
-	"2pp7yJ1UH5eJvMNrdlFxJXUdFnYa8i8ZbQ5ARDtLkjqPl9nisLyp4NOvk+nR8xfHj+8zwlhx/WMzZvdk",
-	"wwrcD2G33lR14PO2LFpbg5DtTsBN9kO4OVfcBvT5VwD/CcLF474sltrxsg1a7DS6t3/n0XakYC0/WhRk",
-	"TLEUDCjcfN9ruDmWwxyfIfVhRnPk7E+k9cTgUlzluXbOf98XyddWKsIEYaHNzGXd3hcabscXB0gfZTqz",
-	"qHnvlElBpkCDMBxpoSzrQ5Yk7gHXmF3KaSQXYZJHEGG3hKs9dvCWiMAauoZTrSRbydQ1yN92p4w/B4K9",
-	"v1cHILgabHfm12tL9ppgFYG9FPKup3i3s6Bgz++EiaishvSQzJf2/U9cDbk1WWIT50bRftwdyghK174Y",
-	"j8k/5sKAEiwhN6DWoIjV9p+dKb4shr7cX63XA4eavr2tYfvaPL9mem+epu1LOz1c3lTL2trjv69q/31U",
-	"kXNQMVMmvN165ptWL5UNOg04ui8+zqNtrzUvwNg5ma/VFxvCo05I1TqjR5n0MEv2We75+Pn3IA+0UlZz",
-	"cyuRdeSqyvZflq72enLkpjmD9kukfu+6srPxgsq+ei01smVswWqvbm+vyHQ8JpevXfHJyAfsKIt3X7i1",
-	"9VKs3cRGEmwb6x90SdAJsc43VQ/WDEVSfKabc7CifviUg9pUTqnGQ4d7JOi6s3hbTTK2SSSzKfffN5dv",
-	"/XSy53qmVvpLi5dvFmsdxn4g6p4gfnz707zxO4cSOmRfmkd/W9/e2KuIq4f8UGdRlGYFIrT/7QbXVXg9",
-	"RKenBSYe4eYDe+Y/AHeimfxo94/g+9E9Uyv8UvslTX/NgW6WWReBNn6n01+OVD8z+qrKrONXSt/Pq40K",
-	"xLq1sOG39GvQeZh14hfiA5WxZbQTsZr0zUajRIYsiaU2s5PxyZQiDfua9b7RiqKu5ZOimt2+3/4eAAD/",
-	"/8sx3p9sJgAA",
+	"H4sIAAAAAAAC/+xbe2/buJb/KgR3ge4CiuMkbTHxX5NtM6m3M0mQZNu99zZoaenY5lQiXZJKahT+7heH",
+	"D4mSpdh59LYXmP9siY/D8z6/Q32jqSwWUoAwmo6+UQV6IYUG++dIzc4WhkuhL/xjfJpKYUAY/MkWi5yn",
+	"DIfs/qmlwGc6nUPB8NdCyQUow91ic6b9YvgvA50qbv/TEX0/BzMHRZhYEukGkTm7ATIBEESXsxloAxmZ",
+	"SkXMHAhTs7IAYQY0oWa5ADqiEylzYIKuEir7trmaQ7SYH4ZrcAOFHf+fCqZ0RP9jt+bKrjuQ3nXU01W1",
+	"JVOKLelqlVAFX0quIKOjf8TnrEm5ribJyZ+QGrrCaU3q/Cx7SCbIiWLCRCddJfRYKameQBSA6+APT5M2",
+	"iouZPclGKo8EsdOJAlMqgUJRsrBSOUpT0Jq8YSLLQVmK7SEuIJUi5TlnT6VLCnSZO4XdSnQdZGyUY9hj",
+	"G9GhYrnxRE5JOof0MxczAiydk5mV4y03c8KNJgslb3jWZM+j+WH32IoJWwqZaC5mOTgltKS+AZab+VP4",
+	"AbvQJmLPPZvctttR7cZa7pPgx8hEZkt7gHcs55kl8XubUUKnHPJse/X8DYdbqjZqpdvzeisZ3lQn3tJk",
+	"E6em+MKdgNzOeTonTAHhwi43sBR5wm2MsCuMhTaqTHv8bvyWSEHm8pYYSZjb3Fjj+VI6r6xAy1KlMPgg",
+	"Pgi0q088mv3JEUZueZ6TCRBR5jnhUyIkiYdZitkN4zmb5IAevik5/lhyZQ5EqppYmrSVAAXJTY6POljU",
+	"ll9Cv+5oIxc5n82tHvKMjuiLl7OXX25vh9licvPVLvlKATNwEsy9LXHna4xE1qR2aLZ+eBBZd2gEkRHD",
+	"C0Afhqd0q3FBxpdnv7wc7mFkKpgNuvCVFQt7uP3h/v7O8OXO3sHV3t7o4HB0MBwc7u/9nSbUDacjmjED",
+	"O7jyGpvw3DO54x9yLXGfwRUOXSWUdxB6JAjPrDi05jOBv8ycayLg1hG8LgrLAOtNus89fh1OHMbhqu70",
+	"QeiyeWr52TCa0IKL30HM0J3tdWyrDVOme0/76lHcHh48Mbd16XSxWzcKxnPCskwhOzzJpe5lVUWNnbiZ",
+	"Veh4rP/NMo7bsvy8obRrE5okhnCxoxeQ8ilPPU0ZM2xA/ii1IQUz6bwh5WeaOEc2oOv+tOl2A28iVfI0",
+	"Bykn1q6szl7Xph/ba5fNe8naI56hTgX7vMuuam0OeugV7S71qKRLUWq/+o0HqSxozf2ZkuUCjSwruNAY",
+	"VVYJjaJTlzW60IIrEBbSBuek5ZSw4Nk73NDd0dMOqfQelx9Y6sZZp6+NheXmJ1Gs9NKITrImcJ+MdXlV",
+	"A8VCKqaW3udgPu4OFwyAkYXiIuULlv/7+9t6LzZhwyydsJ0h+yXdeX5weLDDssP9nZeHL/aGB/svJ/uH",
+	"rG8LwQp8OH79l//d1v8aZsqeqjUtlUKtwzFNii19oixQ8c+PT1+PT09oQo9eXY3fHdOEXhy/O3t7/Jom",
+	"9Pj/z8cX7tfFxdkFvW5T91cIuDME8IxWMkq2DgiR93niKMCz7S30YRHD62OkVg8PIl0V+OjbdmU066+h",
+	"2742U3zaob5v5G1tMSTj0yko7aqgxnpYc4xPP17+7fTVKJpg9Qa0Heu4khCpCDeEa+LtijD05uhZWAHB",
+	"EOZMOwjLrgMZYTPGBZksCRPE2ai34g/ij/Hl5fj0JN6X6zBoUuI/8cw4WjWXAjJ0VrEyDz6I07OrjxfH",
+	"f5y9O37dWiiQ6VYi2mAFdfdi/3f66s3xq7fNpVJZ5hkSMgEnIcgSogHsEJcLIICVgWE817F38oylCfVH",
+	"pQmN6KUJrTbsdE7QnYK8ny/vJs8VagVkPNRDa0vbueOs08vUU/txS9zfqh65ZTraLEFRV0x2uuwPHzym",
+	"VQipcKCCG+kVPuJL7ebbSGfLQYVDJN4KGqS3/VDLFDvSIQ93rqEeOZtA3smpG5aX0A0rxnS6BcLwiLCz",
+	"RR8t5735w3ntfVslfrc03YNNJPIqyYzIi7bqJfBNBW71JLvt3LL6fxOspwCt2QwShDUaOZGzfwefLZtp",
+	"ydH5+OPV2dvjU6IhVWCs4xHSOOfjV8AzIV6CkAgdGVVChyX45e9W9YikmJ51JJ73ZL7VAuPXnXndpoyy",
+	"S1yB8g6JealsCL2rGiSEIzXryMSOPBjvMfpGujqBgLd1YS4ackiN43x/hlOBhT1qGzDBLviZVbQ5zC5C",
+	"1aQi6VxKDZowYq0O6Y/APvvMIltLkjLhR9v4OCBHolrbzRAyTMCxE3Bdm2k7r4raK2vm8shUr+u8tl3E",
+	"yJR/hczRtzmls2RE+tKQfifEysVUBsSYuUTZq+UrWRRSkN+YAZrQUuV0ROfGLPRoFyHfQoopMzDgcv04",
+	"Jz45aIKx5Oh8TNsgYniJ7hOUdvP3BkPX9gLBFpyO6MFgOBiiDjIzt/zcZQu+e7O3a0OEfTKDjjTpd66N",
+	"CzdWbCgRGx4wMtITMCduetLsEu4Ph49tXdyzk9OJkm/ur73FeS+Gw749qlPtNjsEK1sZFQVTy8CkihOG",
+	"zXQVfDW9xmJX6g7eOiQo5LIVul1B8vYxlsIW2s5gASIjUnSg9M80UaXAAnNA3s9B4D+bW0hBjt5fkt9Z",
+	"McmYs9VLAwvyWykc7Jw4qHT8Gh03LszFjXRyiorw5hxyK9XnaS5vcZt1rTiXOlYL62/+R2bLLTSiXfWQ",
+	"OMpXucR2dZCCLx/39g+ev3j5eHwsnSuuf23a7Ibqplbuu3Q3BgN7moidAP5qzeD2Nqtws7W4SujzByj+",
+	"E5iL1/uq+G3byyppeadd5VNTK/4eY7I9vlufkrh5vkvlajALk5g585YKGYGvC0iNVfvCM5gLwgw+4KpZ",
+	"AsaVmSZ6jjUFTrijXLLlX6ixmhPriwwKCnkT2m/cDEjo2LgQlkmwKZ6DKepi02ZzNqe3tVaJHTkMeyAC",
+	"BIlVR8FEyfJ82dwF/Y0sFwMynpJPVT3wyToAzABJ86x1v6/gWttaRDXPbQuWQWMtATeg/Lbal76Wjy1+",
+	"1IuvV5/4VApL/UIqFFgpctAaN7qRn2OKmfNdbh9SsGXE4XhJWRrNM4uWeSWomRf4NpeWdyxXwLKl1QjH",
+	"MDKBqVTgcqK6O4lCa50q3EmxdX9Qh4R4f2L1os5VmG5X/kGR/B7ICNSBqrRuntaVl4EaVzyyqXF5+RKV",
+	"HHObdTcdyj14oK/eHL17fJlGW7OHGZDj+jpEgXDcBAInpApc3fo2Tk8iEBW9/TXMA3V+bCoTdcoepDKt",
+	"6d9C1x1cEvS6u3ByL/tP4Dfmurb2e9EwIFeYCXBNNCyYQm9p3UVk1o8xNKv1ljJkJ/ewDMll+rlGjWW5",
+	"Naqx/S2cWukmaFNe6zuD6HDrINp9fenHxdTKmu/IQ9fjaihP+8NqqH8aiKssjUtFnCirzLVCFgPMxkXt",
+	"B2/ZsopRDib12UxS4aRV5Ax0OW3r9pt8isvocoHBQUc3W9ZdXThEyDge6uieMJOLgIEeNeybz/1ZLTvS",
+	"kIfEmeHDVPD58PmPUNxKwe6RDn7zuOZqF74a30tFl1WAAYWT+7k3RlCZ4zOshGkSavUaKa09jcPDavG3",
+	"AcLrvsLu2FKFYZ2lFsZze0+WpHJ/um715sz4JLMhZCEN1nxxzLEetBE2LML+lWtriVErmmvyGRaGYFGY",
+	"21F4GSZseVfN5mh/smygt9Md07Op3+padROo85qq+xkf6V/REW/fvfP9vHYcekxoeWws+UGG7JV+9hAz",
+	"rnObn8mMLyxVbTO+y3jcjCczHscWNe6984beADQIw9H+qzQqZXnuHmC78Hxc3Y3kIs3LrA7MXuNwl4zA",
+	"DXRdlVu7Ax1o+tn0/glyKCvvbTS4vrHciZpeWAhPEy6cu+FSBODOzQwez6N21rWH7EfbyhwR9Hl9e1mT",
+	"KfOePByGpDKDSrQvhkPyX2NhQAmWk0tQWIDb0/53J3BbNUDuL6/Wve9tWd+e1uB9dFE7Yr1nT5P3FZ/u",
+	"Bq3rYe3T4+vz6O2joOutKtOwWwdK/V0x6ZoHnQzc/RZ+jrNVLzdPwMSNrcmS8KxTpaJu6KNYuh0n+zj3",
+	"g8IfcmkRibkVyDpiVc37+4WrjZLcdeX5TvtKe790PXQQjW+1M216Grzam6urc7I/HJKzt+GS5yfsIoeb",
+	"+Di1dUW/3bgOoIl/0EVBp4p13pu/M2cIQfGZbt5WC/nDlxLUshZKfYlre4kkXXtWDdIFW+aS2ZD7v5dn",
+	"pz6n7dmeuTbjvZKX72ZrHcy+w+qewH7chqS54w82JTXrt51gEChvK9tLuxVx+ZC/yDEJqRlrXBWwuVl8",
+	"a63XnR4FnXiEmLfshP4EvhPZ5C9g/gyyb0Jk342YZCP81nfPJG5KMGV9nfU1tmqx5qTKvP50ql4HBwec",
+	"zqLZ2rTva4TBXh4OqLNfMLorIwGylwL6b3usfaG7Fgxyrk1zuO2p4D7+E7Norq7747Zp40KOvSfVD/yt",
+	"mdLT43+NiyI9AGDNfCMrBPAeAGBTeI4zgbuNj0i38sZ9Xz/+BFhg7Ay2yV2dqX5jaoZ/oo+9+8sD5Fms",
+	"cqyxafUpeX/lUH8J/6AiquND+h/H+EaxYD1w4OH3dMFJ52JWiPd05XgYW/E6EuurVqPd3VymLJ9LbUaH",
+	"w8N9urquystvDdQIz1o9CYXn6nr1zwEA8j/T3w9BAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
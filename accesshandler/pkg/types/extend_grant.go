@@ -0,0 +1,10 @@
+package types
+
+import "errors"
+
+var (
+	// ErrGrantNotFound is returned when extending a grant which the runtime has no record of.
+	ErrGrantNotFound = errors.New("grant not found")
+	// ErrGrantNotActive is returned when extending a grant which is not currently active.
+	ErrGrantNotActive = errors.New("only active grants can be extended")
+)
@@ -15,3 +15,18 @@ func NewGrant(vcg ValidCreateGrant) Grant {
 		},
 	}
 }
+
+// SameAccess returns true if the grants are for the same access,
+// with the same provider, subject and arguments.
+// This is the case when a user requests access again after an earlier grant for it has expired.
+func (g Grant) SameAccess(other Grant) bool {
+	if g.Provider != other.Provider || g.Subject != other.Subject || len(g.With.AdditionalProperties) != len(other.With.AdditionalProperties) {
+		return false
+	}
+	for k, v := range g.With.AdditionalProperties {
+		if ov, ok := other.With.AdditionalProperties[k]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
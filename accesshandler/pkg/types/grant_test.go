@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantSameAccess(t *testing.T) {
+	type testcase struct {
+		name  string
+		other Grant
+		want  bool
+	}
+
+	grant := Grant{ID: "req_1", Status: EXPIRED, Provider: "okta", Subject: "chris@commonfate.io", With: Grant_With{AdditionalProperties: map[string]string{"groupId": "admins"}}}
+
+	testcases := []testcase{
+		{
+			name:  "requested again",
+			other: Grant{ID: "req_2", Status: ACTIVE, Provider: "okta", Subject: "chris@commonfate.io", With: Grant_With{AdditionalProperties: map[string]string{"groupId": "admins"}}},
+			want:  true,
+		},
+		{
+			name:  "different provider",
+			other: Grant{ID: "req_2", Status: ACTIVE, Provider: "azure", Subject: "chris@commonfate.io", With: Grant_With{AdditionalProperties: map[string]string{"groupId": "admins"}}},
+		},
+		{
+			name:  "different subject",
+			other: Grant{ID: "req_2", Status: ACTIVE, Provider: "okta", Subject: "josh@commonfate.io", With: Grant_With{AdditionalProperties: map[string]string{"groupId": "admins"}}},
+		},
+		{
+			name:  "different args",
+			other: Grant{ID: "req_2", Status: ACTIVE, Provider: "okta", Subject: "chris@commonfate.io", With: Grant_With{AdditionalProperties: map[string]string{"groupId": "developers"}}},
+		},
+		{
+			name:  "extra args",
+			other: Grant{ID: "req_2", Status: ACTIVE, Provider: "okta", Subject: "chris@commonfate.io", With: Grant_With{AdditionalProperties: map[string]string{"groupId": "admins", "region": "us-east-1"}}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, grant.SameAccess(tc.other))
+		})
+	}
+}
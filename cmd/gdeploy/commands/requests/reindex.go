@@ -0,0 +1,62 @@
+package requests
+
+import (
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/clio"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/urfave/cli/v2"
+)
+
+var reindexCommand = cli.Command{
+	Name:        "reindex",
+	Description: "Save the request index items for requests which were created before they were added, so that requests can be listed by access rule",
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		dc, err := deploy.ConfigFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		o, err := dc.LoadOutput(ctx)
+		if err != nil {
+			return err
+		}
+		db, err := storage.New(ctx, o.DynamoDBTable)
+		if err != nil {
+			return err
+		}
+
+		var count int
+		hasMore := true
+		var next string
+		for hasMore {
+			q := storage.ListRequests{}
+			var opts []func(*ddb.QueryOpts)
+			if next != "" {
+				opts = append(opts, ddb.Page(next))
+			}
+			res, err := db.Query(ctx, &q, opts...)
+			if err != nil && err != ddb.ErrNoItems {
+				return err
+			}
+			next = ""
+			if res != nil {
+				next = res.NextPage
+			}
+			hasMore = next != ""
+
+			items := make([]ddb.Keyer, len(q.Result))
+			for i, r := range q.Result {
+				items[i] = &access.RequestIndex{Request: r}
+			}
+			err = db.PutBatch(ctx, items...)
+			if err != nil {
+				return err
+			}
+			count += len(items)
+		}
+		clio.Success("reindexed %d requests", count)
+		return nil
+	},
+}
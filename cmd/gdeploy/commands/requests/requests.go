@@ -0,0 +1,10 @@
+package requests
+
+import "github.com/urfave/cli/v2"
+
+var Command = cli.Command{
+	Name:        "requests",
+	Description: "Manage access requests",
+	Subcommands: []*cli.Command{&reindexCommand},
+	Action:      cli.ShowSubcommandHelp,
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/common-fate/granted-approvals/pkg/clio"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/urfave/cli/v2"
+)
+
+// applyActorID is recorded as the creator or updater of rules changed by apply.
+const applyActorID = "gdeploy"
+
+var applyCommand = cli.Command{
+	Name:        "apply",
+	Description: "Create, update and archive the deployed access rules to match the YAML files. Running apply again makes no further changes, so it is safe to run in CI",
+	Flags: []cli.Flag{
+		dirFlag,
+		&cli.StringFlag{Name: "message", Aliases: []string{"m"}, Usage: "the update message to record on changed rules, defaults to the subject of the latest commit"},
+		&cli.BoolFlag{Name: "confirm", Usage: "if provided, will apply the changes without asking for confirmation"},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		dir := c.Path("dir")
+
+		specs, err := loadSpecs(dir)
+		if err != nil {
+			return err
+		}
+		s, err := loadService(c, true)
+		if err != nil {
+			return err
+		}
+		changes, err := s.PlanRules(ctx, specs)
+		if err != nil {
+			return err
+		}
+		printChanges(changes)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		confirm := c.Bool("confirm")
+		if !confirm {
+			cp := &survey.Confirm{Message: "Do you wish to apply these changes?", Default: true}
+			err = survey.AskOne(cp, &confirm)
+			if err != nil {
+				return err
+			}
+		}
+		if !confirm {
+			return errors.New("user cancelled apply")
+		}
+
+		opts := applyOpts(dir)
+		if m := c.String("message"); m != "" {
+			opts.UpdateMessage = &m
+		}
+		for _, ch := range changes {
+			err = s.ApplyRuleChange(ctx, ch, opts)
+			if err != nil {
+				return err
+			}
+			clio.Success("%sd access rule %s", ch.Type, ch.Name())
+		}
+		return nil
+	},
+}
+
+// applyOpts records the latest commit to the rule files as the reason for the changes.
+// If the directory isn't in a git repository the changes are applied without a message.
+func applyOpts(dir string) rulesvc.ApplyRuleOpts {
+	opts := rulesvc.ApplyRuleOpts{ActorID: applyActorID}
+	cmd := exec.Command("git", "log", "-1", "--format=%H%n%an <%ae>%n%s", "--", ".")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		clio.Debug("could not read the latest commit: %s", err)
+		return opts
+	}
+	parts := strings.SplitN(strings.TrimSpace(string(out)), "\n", 3)
+	if len(parts) != 3 {
+		return opts
+	}
+	opts.UpdateMessage = &parts[2]
+	opts.UpdateMetadata = &map[string]interface{}{
+		"commit": parts[0],
+		"author": parts[1],
+	}
+	return opts
+}
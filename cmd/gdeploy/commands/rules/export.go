@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/common-fate/granted-approvals/pkg/clio"
+	"github.com/urfave/cli/v2"
+)
+
+var exportCommand = cli.Command{
+	Name:        "export",
+	Description: "Export the active access rules to YAML files, one file per rule",
+	Flags: []cli.Flag{
+		dirFlag,
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+		dir := c.Path("dir")
+
+		s, err := loadService(c, false)
+		if err != nil {
+			return err
+		}
+		specs, err := s.ExportRules(ctx)
+		if err != nil {
+			return err
+		}
+		err = os.MkdirAll(dir, 0755)
+		if err != nil {
+			return err
+		}
+		for _, spec := range specs {
+			path := filepath.Join(dir, spec.ID+".yml")
+			err = writeSpec(path, spec)
+			if err != nil {
+				return err
+			}
+			clio.Log("exported %s to %s", spec.Name, path)
+		}
+		clio.Success("Exported %d access rules to %s", len(specs), dir)
+		return nil
+	},
+}
@@ -0,0 +1,31 @@
+package rules
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var planCommand = cli.Command{
+	Name:        "plan",
+	Description: "Show the changes which would be made to the deployed access rules to match the YAML files",
+	Flags: []cli.Flag{
+		dirFlag,
+	},
+	Action: func(c *cli.Context) error {
+		ctx := c.Context
+
+		specs, err := loadSpecs(c.Path("dir"))
+		if err != nil {
+			return err
+		}
+		s, err := loadService(c, false)
+		if err != nil {
+			return err
+		}
+		changes, err := s.PlanRules(ctx, specs)
+		if err != nil {
+			return err
+		}
+		printChanges(changes)
+		return nil
+	},
+}
@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/granted-approvals/internal"
+	"github.com/common-fate/granted-approvals/pkg/clio"
+	"github.com/common-fate/granted-approvals/pkg/config"
+	"github.com/common-fate/granted-approvals/pkg/deploy"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+var Command = cli.Command{
+	Name:        "rules",
+	Description: "Manage access rules as code",
+	Subcommands: []*cli.Command{
+		&exportCommand,
+		&planCommand,
+		&applyCommand,
+	},
+	Action: cli.ShowSubcommandHelp,
+}
+
+var dirFlag = &cli.PathFlag{Name: "dir", Aliases: []string{"d"}, Value: "access-rules", Usage: "the directory containing the access rule files"}
+
+// loadService connects to the deployment. The Access Handler client is only needed when rules are created.
+func loadService(c *cli.Context, withAccessHandler bool) (*rulesvc.Service, error) {
+	ctx := c.Context
+	dc, err := deploy.ConfigFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := dc.LoadOutput(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := storage.New(ctx, o.DynamoDBTable)
+	if err != nil {
+		return nil, err
+	}
+	// pending requests are cancelled when rules are archived, which sends events for them.
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{EventBusARN: o.EventBusArn})
+	if err != nil {
+		return nil, err
+	}
+	s := rulesvc.Service{Clock: clock.New(), DB: db, EventPutter: eventBus}
+	if withAccessHandler {
+		if o.AccessHandlerURL == "" {
+			return nil, clio.NewCLIError("The Access Handler URL is not yet available. You may need to update your deployment to use this feature.")
+		}
+		s.AHClient, err = internal.BuildAccessHandlerClient(ctx, config.Config{AccessHandlerURL: o.AccessHandlerURL, Region: o.Region})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &s, nil
+}
+
+// loadSpecs reads the access rules from the .yml and .yaml files in dir.
+func loadSpecs(dir string) ([]rulesvc.RuleSpec, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if !e.IsDir() && (ext == ".yml" || ext == ".yaml") {
+			files = append(files, filepath.Join(dir, e.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	var specs []rulesvc.RuleSpec
+	for _, f := range files {
+		spec, err := readSpec(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f, err)
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// readSpec parses an access rule file.
+// The YAML is converted to JSON so that the spec is decoded using the same field names as the API.
+func readSpec(path string) (rulesvc.RuleSpec, error) {
+	var spec rulesvc.RuleSpec
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return spec, err
+	}
+	var v interface{}
+	err = yaml.Unmarshal(b, &v)
+	if err != nil {
+		return spec, err
+	}
+	j, err := json.Marshal(v)
+	if err != nil {
+		return spec, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&spec)
+	if err != nil {
+		return spec, err
+	}
+	spec.Source = path
+	return spec, nil
+}
+
+// writeSpec saves an access rule to a YAML file.
+func writeSpec(path string, spec rulesvc.RuleSpec) error {
+	j, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	var v interface{}
+	err = json.Unmarshal(j, &v)
+	if err != nil {
+		return err
+	}
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// printChanges prints a summary of the changes in a plan.
+func printChanges(changes []rulesvc.RuleChange) {
+	if len(changes) == 0 {
+		clio.Success("No changes, the access rules match the deployment")
+		return
+	}
+	for _, ch := range changes {
+		switch ch.Type {
+		case rulesvc.RuleCreate:
+			clio.Log("+ create %s (%s)", ch.Name(), ch.Spec.Source)
+		case rulesvc.RuleUpdate:
+			clio.Log("~ update %s [%s] (%s)", ch.Name(), ch.Current.ID, ch.Spec.Source)
+			clio.Log("    changed: %s", strings.Join(ch.Fields, ", "))
+		case rulesvc.RuleArchive:
+			clio.Log("- archive %s [%s]", ch.Name(), ch.Current.ID)
+		}
+	}
+	clio.Info("%d access rule change(s)", len(changes))
+}
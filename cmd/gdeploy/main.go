@@ -19,7 +19,9 @@ import (
 	"github.com/common-fate/granted-approvals/cmd/gdeploy/commands/notifications"
 	"github.com/common-fate/granted-approvals/cmd/gdeploy/commands/provider"
 	"github.com/common-fate/granted-approvals/cmd/gdeploy/commands/release"
+	"github.com/common-fate/granted-approvals/cmd/gdeploy/commands/requests"
 	"github.com/common-fate/granted-approvals/cmd/gdeploy/commands/restore"
+	"github.com/common-fate/granted-approvals/cmd/gdeploy/commands/rules"
 	"github.com/common-fate/granted-approvals/cmd/gdeploy/commands/sso"
 	"github.com/common-fate/granted-approvals/cmd/gdeploy/commands/users"
 	"github.com/common-fate/granted-approvals/internal/build"
@@ -65,6 +67,8 @@ func main() {
 			WithBeforeFuncs(&restore.Command, RequireDeploymentConfig(), RequireAWSCredentials()),
 			WithBeforeFuncs(&provider.Command, RequireDeploymentConfig(), RequireAWSCredentials()),
 			WithBeforeFuncs(&notifications.Command, RequireDeploymentConfig(), RequireAWSCredentials()),
+			WithBeforeFuncs(&rules.Command, RequireDeploymentConfig(), RequireAWSCredentials()),
+			WithBeforeFuncs(&requests.Command, RequireDeploymentConfig(), RequireAWSCredentials()),
 			WithBeforeFuncs(&dashboard.Command, RequireDeploymentConfig(), RequireAWSCredentials()),
 			WithBeforeFuncs(&commands.InitCommand, RequireAWSCredentials()),
 			WithBeforeFuncs(&release.Command, RequireDeploymentConfig()),
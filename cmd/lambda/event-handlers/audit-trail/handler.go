@@ -9,7 +9,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/config"
 	"github.com/common-fate/granted-approvals/pkg/eventhandler"
 
-	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
 	"go.uber.org/zap"
@@ -23,7 +23,7 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	db, err := ddb.New(ctx, cfg.DynamoTable)
+	db, err := storage.New(ctx, cfg.DynamoTable)
 	if err != nil {
 		panic(err)
 	}
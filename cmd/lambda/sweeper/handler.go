@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/granted-approvals/pkg/config"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/joho/godotenv"
+	"github.com/sethvargo/go-envconfig"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var cfg config.SweeperConfig
+	ctx := context.Background()
+	_ = godotenv.Load()
+
+	err := envconfig.Process(ctx, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	log, err := logger.Build(cfg.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+	zap.ReplaceGlobals(log.Desugar())
+
+	db, err := storage.New(ctx, cfg.DynamoTable)
+	if err != nil {
+		panic(err)
+	}
+	eventBus, err := gevent.NewSender(ctx, gevent.SenderOpts{
+		EventBusARN: cfg.EventBusArn,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// the sweeper doesn't create grants, so the Granter isn't needed.
+	svc := accesssvc.Service{
+		Clock:       clock.New(),
+		DB:          db,
+		EventPutter: eventBus,
+	}
+	lambda.Start(svc.ExpirePendingRequests)
+}
@@ -18,6 +18,8 @@ const adminGroupId = app.node.tryGetContext("adminGroupId");
 const providerConfig = app.node.tryGetContext("providerConfiguration");
 const identityConfig = app.node.tryGetContext("identityConfiguration");
 const slackConfig = app.node.tryGetContext("slackConfiguration");
+const remediateGrantDrift = app.node.tryGetContext("remediateGrantDrift");
+const revokeGrantDrift = app.node.tryGetContext("revokeGrantDrift");
 const productionReleasesBucket = app.node.tryGetContext(
   "productionReleasesBucket"
 );
@@ -59,6 +61,8 @@ if (stackTarget === "dev") {
     samlMetadata: samlMetadata || "",
     slackConfiguration: slackConfig || "{}",
     identityProviderSyncConfiguration: identityConfig || "{}",
+    remediateGrantDrift: remediateGrantDrift || "false",
+    revokeGrantDrift: revokeGrantDrift || "false",
   });
 } else if (stackTarget === "prod") {
   new CustomerGrantedStack(app, "Granted", {
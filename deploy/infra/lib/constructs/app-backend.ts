@@ -10,8 +10,10 @@ import { Construct } from "constructs";
 import * as path from "path";
 import { WebUserPool } from "./app-user-pool";
 import { EventHandler } from "./event-handler";
+import { GrantReconciler } from "./grant-reconciler";
 import { IdpSync } from "./idp-sync";
 import { Notifiers } from "./notifiers";
+import { RequestSweeper } from "./request-sweeper";
 
 interface Props {
   appName: string;
@@ -23,6 +25,8 @@ interface Props {
   adminGroupId: string;
   slackConfiguration: string;
   identityProviderSyncConfiguration: string;
+  remediateGrantDrift: string;
+  revokeGrantDrift: string;
 }
 
 export class AppBackend extends Construct {
@@ -33,6 +37,8 @@ export class AppBackend extends Construct {
   private _notifiers: Notifiers;
   private _eventHandler: EventHandler;
   private _idpSync: IdpSync;
+  private _requestSweeper: RequestSweeper;
+  private _grantReconciler: GrantReconciler;
 
   constructor(scope: Construct, id: string, props: Props) {
     super(scope, id);
@@ -221,6 +227,19 @@ export class AppBackend extends Construct {
       identityProviderSyncConfiguration:
         props.identityProviderSyncConfiguration,
     });
+
+    this._requestSweeper = new RequestSweeper(this, "RequestSweeper", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+    });
+
+    this._grantReconciler = new GrantReconciler(this, "GrantReconciler", {
+      dynamoTable: this._dynamoTable,
+      eventBus: props.eventBus,
+      accessHandlerApi: props.accessHandlerApi,
+      remediateGrantDrift: props.remediateGrantDrift,
+      revokeGrantDrift: props.revokeGrantDrift,
+    });
   }
 
   // Be sure to also grant access in the readwrite function to any aditional tables added
@@ -310,4 +329,10 @@ export class AppBackend extends Construct {
   getIdpSync(): IdpSync {
     return this._idpSync;
   }
+  getRequestSweeper(): RequestSweeper {
+    return this._requestSweeper;
+  }
+  getGrantReconciler(): GrantReconciler {
+    return this._grantReconciler;
+  }
 }
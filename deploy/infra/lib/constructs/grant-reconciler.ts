@@ -0,0 +1,73 @@
+import { Duration } from "aws-cdk-lib";
+import * as apigateway from "aws-cdk-lib/aws-apigateway";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+import * as events from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import { EventBus } from "aws-cdk-lib/aws-events";
+import { PolicyStatement } from "aws-cdk-lib/aws-iam";
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+  accessHandlerApi: apigateway.RestApi;
+  // remediateGrantDrift is "true" if active grants which are missing should be provisioned again, rather than only reported.
+  remediateGrantDrift: string;
+  // revokeGrantDrift is "true" if expired grants which are still provisioned should be removed, rather than only reported.
+  revokeGrantDrift: string;
+}
+
+// GrantReconciler checks that active and expired grants match their providers, and emits an event for grants which have drifted.
+export class GrantReconciler extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "reconciler.zip")
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.minutes(5),
+      environment: {
+        APPROVALS_TABLE_NAME: props.dynamoTable.tableName,
+        EVENT_BUS_ARN: props.eventBus.eventBusArn,
+        ACCESS_HANDLER_URL: props.accessHandlerApi.url,
+        GRANT_DRIFT_REMEDIATE: props.remediateGrantDrift,
+        GRANT_DRIFT_REVOKE: props.revokeGrantDrift,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "reconciler",
+    });
+
+    props.dynamoTable.grantReadData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+    this._lambda.addToRolePolicy(
+      new PolicyStatement({
+        resources: [props.accessHandlerApi.arnForExecuteApi()],
+        actions: ["execute-api:Invoke"],
+      })
+    );
+
+    //add event bridge trigger to lambda
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0" }),
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
@@ -33,8 +33,21 @@ export class Granter extends Construct {
     });
 
     const definition = {
-      StartAt: "Validate End is in the Future",
+      StartAt: "Check if Grant is Already Active",
       States: {
+        "Check if Grant is Already Active": {
+          Type: "Choice",
+          Choices: [
+            {
+              Variable: "$.grant.status",
+              StringEquals: "ACTIVE",
+              Next: "Wait for Window End",
+            },
+          ],
+          Default: "Validate End is in the Future",
+          Comment:
+            "Extended grants are started with an active grant, access has already been provisioned so we only need to wait for the new end time",
+        },
         "Validate End is in the Future": {
           Type: "Choice",
           Choices: [
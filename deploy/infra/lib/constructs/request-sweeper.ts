@@ -0,0 +1,57 @@
+import { Duration } from "aws-cdk-lib";
+import * as lambda from "aws-cdk-lib/aws-lambda";
+import { Construct } from "constructs";
+import * as path from "path";
+import * as events from "aws-cdk-lib/aws-events";
+import * as targets from "aws-cdk-lib/aws-events-targets";
+import { Table } from "aws-cdk-lib/aws-dynamodb";
+import { EventBus } from "aws-cdk-lib/aws-events";
+
+interface Props {
+  dynamoTable: Table;
+  eventBus: EventBus;
+}
+
+// RequestSweeper expires pending requests which haven't been reviewed before the pending timeout of their Access Rule.
+export class RequestSweeper extends Construct {
+  private _lambda: lambda.Function;
+  private eventRule: events.Rule;
+
+  constructor(scope: Construct, id: string, props: Props) {
+    super(scope, id);
+    const code = lambda.Code.fromAsset(
+      path.join(__dirname, "..", "..", "..", "..", "bin", "sweeper.zip")
+    );
+
+    this._lambda = new lambda.Function(this, "HandlerFunction", {
+      code,
+      timeout: Duration.seconds(60),
+      environment: {
+        APPROVALS_TABLE_NAME: props.dynamoTable.tableName,
+        EVENT_BUS_ARN: props.eventBus.eventBusArn,
+      },
+      runtime: lambda.Runtime.GO_1_X,
+      handler: "sweeper",
+    });
+
+    props.dynamoTable.grantReadWriteData(this._lambda);
+    props.eventBus.grantPutEventsTo(this._lambda);
+
+    //add event bridge trigger to lambda
+    this.eventRule = new events.Rule(this, "EventBridgeCronRule", {
+      schedule: events.Schedule.cron({ minute: "0/5" }),
+    });
+
+    // add the Lambda function as a target for the Event Rule
+    this.eventRule.addTarget(new targets.LambdaFunction(this._lambda));
+
+    // allow the Event Rule to invoke the Lambda function
+    targets.addLambdaPermission(this.eventRule, this._lambda);
+  }
+  getLogGroupName(): string {
+    return this._lambda.logGroup.logGroupName;
+  }
+  getFunctionName(): string {
+    return this._lambda.functionName;
+  }
+}
@@ -94,6 +94,20 @@ export class CustomerGrantedStack extends cdk.Stack {
       description: "The Identity Provider Sync configuration in JSON format",
       default: "{}",
     });
+    const remediateGrantDrift = new CfnParameter(this, "RemediateGrantDrift", {
+      type: "String",
+      description:
+        "Set to 'true' to provision access again for active grants which are missing from their provider. By default, drift is only reported.",
+      default: "false",
+      allowedValues: ["true", "false"],
+    });
+    const revokeGrantDrift = new CfnParameter(this, "RevokeGrantDrift", {
+      type: "String",
+      description:
+        "Set to 'true' to remove access for expired grants which are still provisioned in their provider. By default, drift is only reported, as the access may have been provisioned outside of Granted.",
+      default: "false",
+      allowedValues: ["true", "false"],
+    });
 
     const appName = this.stackName + suffix.valueAsString;
 
@@ -138,6 +152,8 @@ export class CustomerGrantedStack extends cdk.Stack {
       adminGroupId: grantedAdminGroupId.valueAsString,
       identityProviderSyncConfiguration: identityConfig.valueAsString,
       slackConfiguration: slackConfig.valueAsString,
+      remediateGrantDrift: remediateGrantDrift.valueAsString,
+      revokeGrantDrift: revokeGrantDrift.valueAsString,
     });
 
     new ProductionFrontendDeployer(this, "FrontendDeployer", {
@@ -177,6 +193,7 @@ export class CustomerGrantedStack extends cdk.Stack {
       EventBusArn: events.getEventBus().eventBusArn,
       EventBusSource: events.getEventBusSourceName(),
       IdpSyncFunctionName: appBackend.getIdpSync().getFunctionName(),
+      AccessHandlerURL: accessHandler.getApiUrl(),
       Region: this.region,
     });
   }
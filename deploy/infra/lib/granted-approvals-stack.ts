@@ -20,6 +20,8 @@ interface Props extends cdk.StackProps {
   slackConfiguration: string;
   identityProviderSyncConfiguration: string;
   adminGroupId: string;
+  remediateGrantDrift: string;
+  revokeGrantDrift: string;
 }
 export class DevGrantedStack extends cdk.Stack {
   constructor(scope: Construct, id: string, props: Props) {
@@ -35,6 +37,8 @@ export class DevGrantedStack extends cdk.Stack {
       adminGroupId,
       slackConfiguration,
       identityProviderSyncConfiguration,
+      remediateGrantDrift,
+      revokeGrantDrift,
     } = props;
     const appName = `granted-approvals-${stage}`;
 
@@ -75,6 +79,8 @@ export class DevGrantedStack extends cdk.Stack {
       adminGroupId,
       identityProviderSyncConfiguration: identityProviderSyncConfiguration,
       slackConfiguration: slackConfiguration,
+      remediateGrantDrift: remediateGrantDrift,
+      revokeGrantDrift: revokeGrantDrift,
     });
     /* Outputs */
     generateOutputs(this, {
@@ -100,6 +106,7 @@ export class DevGrantedStack extends cdk.Stack {
       EventBusArn: events.getEventBus().eventBusArn,
       EventBusSource: events.getEventBusSourceName(),
       IdpSyncFunctionName: approvals.getIdpSync().getFunctionName(),
+      AccessHandlerURL: accessHandler.getApiUrl(),
       Region: this.region,
     });
   }
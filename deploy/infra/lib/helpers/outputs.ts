@@ -22,6 +22,7 @@ export type StackOutputs = {
   EventBusArn: string;
   EventBusSource: string;
   IdpSyncFunctionName: string;
+  AccessHandlerURL: string;
   Region: string;
 };
 /**
@@ -25,6 +25,7 @@ const testOutputs: StackOutputs = {
   EventBusArn: "abcdefg",
   EventBusSource: "abcdefg",
   IdpSyncFunctionName: "abcdefg",
+  AccessHandlerURL: "abcdefg",
   Region: "abcdefg",
 };
 
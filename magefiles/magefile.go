@@ -89,6 +89,20 @@ func (Build) Syncer() error {
 	return sh.RunWith(env, "go", "build", "-o", "bin/syncer", "cmd/lambda/syncer/handler.go")
 }
 
+func (Build) Sweeper() error {
+	env := map[string]string{
+		"GOOS": "linux",
+	}
+	return sh.RunWith(env, "go", "build", "-o", "bin/sweeper", "cmd/lambda/sweeper/handler.go")
+}
+
+func (Build) Reconciler() error {
+	env := map[string]string{
+		"GOOS": "linux",
+	}
+	return sh.RunWith(env, "go", "build", "-o", "bin/reconciler", "cmd/lambda/reconciler/handler.go")
+}
+
 func (Build) SlackNotifier() error {
 	env := map[string]string{
 		"GOOS": "linux",
@@ -159,7 +173,7 @@ func PackageBackend() error {
 }
 
 func Package() {
-	mg.Deps(PackageBackend, PackageGranter, PackageAccessHandler, PackageSlackNotifier, PackageEventHandler, PackageSyncer, PackageWebhook, PackageFrontendDeployer)
+	mg.Deps(PackageBackend, PackageGranter, PackageAccessHandler, PackageSlackNotifier, PackageEventHandler, PackageSyncer, PackageSweeper, PackageReconciler, PackageWebhook, PackageFrontendDeployer)
 }
 
 // PackageGranter zips the Go granter so that it can be deployed to Lambda.
@@ -186,6 +200,18 @@ func PackageSyncer() error {
 	return sh.Run("zip", "--junk-paths", "bin/syncer.zip", "bin/syncer")
 }
 
+// PackageSweeper zips the Go Sweeper function handler so that it can be deployed to Lambda.
+func PackageSweeper() error {
+	mg.Deps(Build.Sweeper)
+	return sh.Run("zip", "--junk-paths", "bin/sweeper.zip", "bin/sweeper")
+}
+
+// PackageReconciler zips the Go grant reconciler function handler so that it can be deployed to Lambda.
+func PackageReconciler() error {
+	mg.Deps(Build.Reconciler)
+	return sh.Run("zip", "--junk-paths", "bin/reconciler.zip", "bin/reconciler")
+}
+
 // PackageNotifier zips the Go notifier so that it can be deployed to Lambda.
 func PackageSlackNotifier() error {
 	mg.Deps(Build.SlackNotifier)
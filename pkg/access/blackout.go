@@ -0,0 +1,38 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// Blackout is a period, such as a change freeze, during which access can't be granted for any access rule.
+type Blackout struct {
+	ID     string    `json:"id" dynamodbav:"id"`
+	Start  time.Time `json:"start" dynamodbav:"start"`
+	End    time.Time `json:"end" dynamodbav:"end"`
+	Reason string    `json:"reason" dynamodbav:"reason"`
+	// CreatedBy is the ID of the administrator who created the blackout.
+	CreatedBy string    `json:"createdBy" dynamodbav:"createdBy"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (b *Blackout) ToAPI() types.Blackout {
+	return types.Blackout{
+		Id:        b.ID,
+		Start:     b.Start,
+		End:       b.End,
+		Reason:    b.Reason,
+		CreatedBy: b.CreatedBy,
+	}
+}
+
+func (b *Blackout) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.Blackout.PK1,
+		SK: keys.Blackout.SK1(b.ID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,37 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// Comment is a message left on a request by the requestor or a reviewer.
+// Comments should not be updated once created.
+type Comment struct {
+	ID        string    `json:"id" dynamodbav:"id"`
+	RequestID string    `json:"requestId" dynamodbav:"requestId"`
+	UserID    string    `json:"userId" dynamodbav:"userId"`
+	Body      string    `json:"body" dynamodbav:"body"`
+	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
+}
+
+func (c *Comment) ToAPI() types.Comment {
+	return types.Comment{
+		Id:        c.ID,
+		RequestId: c.RequestID,
+		User:      c.UserID,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func (c *Comment) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.AccessRequestComment.PK1,
+		SK: keys.AccessRequestComment.SK1(c.RequestID, c.CreatedAt, c.ID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,27 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// RequestExpiry records when a pending request will expire if it hasn't been reviewed.
+// Expiries are stored in their own partition sorted by time, so that expired requests
+// can be found without scanning all pending requests.
+//
+// Expiries are not removed when a request is reviewed or cancelled.
+// They are removed by the sweeper, which skips requests that are no longer pending.
+type RequestExpiry struct {
+	RequestID string    `json:"requestId" dynamodbav:"requestId"`
+	ExpiresAt time.Time `json:"expiresAt" dynamodbav:"expiresAt"`
+}
+
+func (e *RequestExpiry) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.AccessRequestExpiry.PK1,
+		SK: keys.AccessRequestExpiry.SK1(e.ExpiresAt, e.RequestID),
+	}
+	return keys, nil
+}
@@ -0,0 +1,59 @@
+package access
+
+import (
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// ExtensionStatus is the status of a request to extend an active grant.
+type ExtensionStatus string
+
+const (
+	ExtensionPending  ExtensionStatus = "PENDING"
+	ExtensionApproved ExtensionStatus = "APPROVED"
+	ExtensionDeclined ExtensionStatus = "DECLINED"
+)
+
+// Extension is a request made by the requestor to extend the grant of an approved request.
+// Only the most recent extension is stored on the request, earlier extensions are recorded in the request history.
+type Extension struct {
+	// Duration is the amount of time to add to the grant.
+	Duration    time.Duration   `json:"duration" dynamodbav:"duration"`
+	Reason      *string         `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	Status      ExtensionStatus `json:"status" dynamodbav:"status"`
+	RequestedAt time.Time       `json:"requestedAt" dynamodbav:"requestedAt"`
+	// ReviewedBy is the ID of the user who reviewed the extension, or nil if it was approved automatically.
+	ReviewedBy *string `json:"reviewedBy,omitempty" dynamodbav:"reviewedBy,omitempty"`
+	// ApprovalStage is the index of the approval stage of the access rule the extension is waiting on.
+	// Extensions are reviewed through the same stages as the request.
+	ApprovalStage int `json:"approvalStage" dynamodbav:"approvalStage"`
+	// StageApprovedBy holds the IDs of the approvers who have approved the current approval stage of the extension.
+	// As for requests, a delegate's approval is recorded under the approver they reviewed on behalf of.
+	StageApprovedBy []string `json:"stageApprovedBy,omitempty" dynamodbav:"stageApprovedBy,omitempty"`
+}
+
+// IsPending is true if the extension is waiting on a review.
+func (e *Extension) IsPending() bool {
+	return e.Status == ExtensionPending
+}
+
+// HasApproved returns true if the approver has approved the current approval stage of the extension.
+func (e *Extension) HasApproved(approverID string) bool {
+	for _, a := range e.StageApprovedBy {
+		if a == approverID {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Extension) ToAPI() types.RequestExtension {
+	return types.RequestExtension{
+		DurationSeconds: int(e.Duration.Seconds()),
+		Reason:          e.Reason,
+		Status:          types.ExtensionStatus(e.Status),
+		RequestedAt:     e.RequestedAt,
+		ReviewedBy:      e.ReviewedBy,
+	}
+}
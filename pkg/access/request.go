@@ -1,6 +1,10 @@
 package access
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/common-fate/ddb"
@@ -20,6 +24,11 @@ const (
 	DECLINED  Status = "DECLINED"
 	CANCELLED Status = "CANCELLED"
 	PENDING   Status = "PENDING"
+	// NEEDS_RETROSPECTIVE_REVIEW is the status of a break-glass request which has been granted
+	// but has not yet been reviewed by an approver.
+	NEEDS_RETROSPECTIVE_REVIEW Status = "NEEDS_RETROSPECTIVE_REVIEW"
+	// EXPIRED is the status of a request which was not reviewed before the pending timeout of its Access Rule.
+	EXPIRED Status = "EXPIRED"
 )
 
 type Grant struct {
@@ -63,8 +72,13 @@ type Request struct {
 	ID string `json:"id" dynamodbav:"id"`
 
 	// RequestedBy is the ID of the user who has made the request.
+	// For delegated requests, this is the user who access is requested for.
 	RequestedBy string `json:"requestedBy" dynamodbav:"requestedBy"`
 
+	// DelegatedBy is the ID of the user who submitted the request on behalf of RequestedBy.
+	// It is nil unless the request was made on behalf of another user.
+	DelegatedBy *string `json:"delegatedBy,omitempty" dynamodbav:"delegatedBy,omitempty"`
+
 	// Rule is the ID of the Access Rule which the request relates to.
 	Rule string `json:"rule" dynamodbav:"rule"`
 	// RuleVersion is the version string of the rule that this request relates to
@@ -74,16 +88,79 @@ type Request struct {
 	RequestedTiming Timing      `json:"requestedTiming" dynamodbav:"requestedTiming"`
 	// When a request is approver, the approver has the option to override the timing, if they do so, this will be populated.
 	// If the timing was not overriden, then the original request timeing should be used.
-	// Override timing should only be set by an approving review
+	// Override timing should only be set by an approving review or an approved extension
 	OverrideTiming *Timing `json:"overrideTiming,omitempty" dynamodbav:"overrideTiming,omitempty"`
+	// TimingClippedReason explains why the timing was shortened, if it was shortened to fit within
+	// the allowed windows of the access rule or to avoid a blackout period.
+	TimingClippedReason *string `json:"timingClippedReason,omitempty" dynamodbav:"timingClippedReason,omitempty"`
 	// Grant is the ID of the grant when it is created by the access handler
 	Grant *Grant `json:"grant,omitempty" dynamodbav:"grant,omitempty"`
-	// ApprovalMethod explains whether an approval was AUTOMATIC, or REVIEWED
+	// AdditionalGrants are the grants for the additional targets of the Access Rule, in the same order as the targets.
+	// Each grant has the ID returned by GrantID for its target.
+	AdditionalGrants []Grant `json:"additionalGrants,omitempty" dynamodbav:"additionalGrants,omitempty"`
+	// ApprovalMethod explains whether an approval was AUTOMATIC, REVIEWED, or BREAK_GLASS
 	ApprovalMethod *types.ApprovalMethod `json:"approvalMethod,omitempty" dynamodbav:"approvalMethod,omitempty"`
+	// ApprovalPolicyID is the ID of the approval policy of the Access Rule which decided the ApprovalMethod.
+	// It is nil if no policy matched the request.
+	ApprovalPolicyID *string `json:"approvalPolicyId,omitempty" dynamodbav:"approvalPolicyId,omitempty"`
+	// ApprovedBy holds the IDs of the reviewers who have approved the request.
+	// For rules which require multiple approvals, the request remains PENDING until enough reviewers have approved it.
+	ApprovedBy []string `json:"approvedBy,omitempty" dynamodbav:"approvedBy,omitempty"`
+	// StageApprovedBy holds the IDs of the approvers who have approved the current approval stage.
+	// A delegate's approval is recorded under the approver they reviewed on behalf of, so that an approver's
+	// authority is only counted once. It is cleared when the request moves on to the next stage, so that each stage is counted separately.
+	StageApprovedBy []string `json:"stageApprovedBy,omitempty" dynamodbav:"stageApprovedBy,omitempty"`
+	// ApprovalStage is the index of the approval stage the request is waiting on.
+	// Rules without sequential approval stages only have a single stage.
+	ApprovalStage int `json:"approvalStage" dynamodbav:"approvalStage"`
+	// Extension is the most recent request by the requestor to extend the grant.
+	Extension *Extension `json:"extension,omitempty" dynamodbav:"extension,omitempty"`
 	// CreatedAt is a read-only field after the request has been created.
 	CreatedAt time.Time `json:"createdAt" dynamodbav:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+	// Version is incremented each time the request is updated.
+	// Updates are conditional on the version, so that concurrent reviews and cancellations can't both change the request.
+	Version int `json:"version" dynamodbav:"version"`
+}
+
+// grantTargetSeparator separates the request ID from the target number in the IDs of grants for additional targets.
+const grantTargetSeparator = "-target-"
+
+// GrantID returns the ID of the Access Handler grant for one of the targets of a request.
+// Target 0 is the target of the Access Rule, and its grant has the same ID as the request.
+// The additional targets of the rule are numbered from 1.
+func GrantID(requestID string, target int) string {
+	if target == 0 {
+		return requestID
+	}
+	return fmt.Sprintf("%s%s%d", requestID, grantTargetSeparator, target)
+}
+
+// ParseGrantID returns the request ID and target number of an Access Handler grant ID.
+func ParseGrantID(grantID string) (requestID string, target int) {
+	i := strings.LastIndex(grantID, grantTargetSeparator)
+	if i == -1 {
+		return grantID, 0
+	}
+	target, err := strconv.Atoi(grantID[i+len(grantTargetSeparator):])
+	if err != nil {
+		return grantID, 0
+	}
+	return grantID[:i], target
+}
+
+// GrantForTarget returns the grant for one of the targets of the request, numbered in the same way as GrantID.
+// It returns nil if the grant hasn't been created.
+func (r *Request) GrantForTarget(target int) *Grant {
+	if target == 0 {
+		return r.Grant
+	}
+	if target < 1 || target > len(r.AdditionalGrants) {
+		return nil
+	}
+	return &r.AdditionalGrants[target-1]
 }
+
 type GetIntervalOpts struct {
 	Now time.Time
 }
@@ -93,6 +170,29 @@ func WithNow(t time.Time) func(o *GetIntervalOpts) {
 	return func(o *GetIntervalOpts) { o.Now = t }
 }
 
+// HasApproved returns true if the approver, or their delegate, has already approved the current approval stage of the request.
+func (r *Request) HasApproved(approverID string) bool {
+	for _, a := range r.StageApprovedBy {
+		if a == approverID {
+			return true
+		}
+	}
+	return false
+}
+
+// AddApproval records an approval of the current approval stage by a reviewer.
+// approverID is the approver whose authority the approval counts towards, which is the reviewer
+// unless they are reviewing as a delegate. An approver of an earlier stage who approves again is only listed once in ApprovedBy.
+func (r *Request) AddApproval(reviewerID string, approverID string) {
+	r.StageApprovedBy = append(r.StageApprovedBy, approverID)
+	for _, a := range r.ApprovedBy {
+		if a == reviewerID {
+			return
+		}
+	}
+	r.ApprovedBy = append(r.ApprovedBy, reviewerID)
+}
+
 // GetInterval will return the interval for either the requested timing or for the override timing if it is present
 func (r *Request) GetInterval(opts ...func(o *GetIntervalOpts)) (start time.Time, end time.Time) {
 	if r.OverrideTiming != nil {
@@ -101,6 +201,19 @@ func (r *Request) GetInterval(opts ...func(o *GetIntervalOpts)) (start time.Time
 	return r.RequestedTiming.GetInterval(opts...)
 }
 
+// IsRequestor returns true if the user made the request, either for themselves or on behalf of another user.
+func (r *Request) IsRequestor(userID string) bool {
+	return r.RequestedBy == userID || (r.DelegatedBy != nil && *r.DelegatedBy == userID)
+}
+
+// GetTiming returns the override timing if it is present, otherwise the requested timing.
+func (r *Request) GetTiming() Timing {
+	if r.OverrideTiming != nil {
+		return *r.OverrideTiming
+	}
+	return r.RequestedTiming
+}
+
 // IsScheduled will return true if this request is scheduled, first checking for override timing, then for original timing
 func (r *Request) IsScheduled() bool {
 	if r.OverrideTiming != nil {
@@ -115,19 +228,36 @@ func (r *Request) ToAPI() types.Request {
 			Id:      r.Rule,
 			Version: r.RuleVersion,
 		},
-		Timing:         r.RequestedTiming.ToAPI(),
-		Reason:         r.Data.Reason,
-		ID:             r.ID,
-		RequestedAt:    r.CreatedAt,
-		Requestor:      r.RequestedBy,
-		Status:         types.RequestStatus(r.Status),
-		UpdatedAt:      r.UpdatedAt,
-		ApprovalMethod: r.ApprovalMethod,
+		Timing:              r.RequestedTiming.ToAPI(),
+		Reason:              r.Data.Reason,
+		ID:                  r.ID,
+		RequestedAt:         r.CreatedAt,
+		Requestor:           r.RequestedBy,
+		Status:              types.RequestStatus(r.Status),
+		UpdatedAt:           r.UpdatedAt,
+		ApprovalMethod:      r.ApprovalMethod,
+		ApprovalPolicyId:    r.ApprovalPolicyID,
+		DelegatedBy:         r.DelegatedBy,
+		TimingClippedReason: r.TimingClippedReason,
 	}
 	if r.Grant != nil {
 		g := r.Grant.ToAPI()
 		req.Grant = &g
 	}
+	if len(r.AdditionalGrants) > 0 {
+		grants := make([]types.Grant, len(r.AdditionalGrants))
+		for i, g := range r.AdditionalGrants {
+			grants[i] = g.ToAPI()
+		}
+		req.AdditionalGrants = &grants
+	}
+	if r.Extension != nil {
+		e := r.Extension.ToAPI()
+		req.Extension = &e
+	}
+	if len(r.Data.With) > 0 {
+		req.SelectedWith = &types.SelectedArguments{AdditionalProperties: r.Data.With}
+	}
 
 	// show the updated timing rather than the requested timing if it's been overridden by an approver.
 	if r.OverrideTiming != nil {
@@ -139,37 +269,66 @@ func (r *Request) ToAPI() types.Request {
 
 func (r *Request) ToAPIDetail(accessRule rule.AccessRule, canReview bool) types.RequestDetail {
 	req := types.RequestDetail{
-		AccessRule:     accessRule.ToAPI(),
-		Timing:         r.RequestedTiming.ToAPI(),
-		Reason:         r.Data.Reason,
-		ID:             r.ID,
-		RequestedAt:    r.CreatedAt,
-		Requestor:      r.RequestedBy,
-		Status:         types.RequestStatus(r.Status),
-		UpdatedAt:      r.UpdatedAt,
-		CanReview:      canReview,
-		ApprovalMethod: r.ApprovalMethod,
+		AccessRule:          accessRule.ToAPI(),
+		Timing:              r.RequestedTiming.ToAPI(),
+		Reason:              r.Data.Reason,
+		ID:                  r.ID,
+		RequestedAt:         r.CreatedAt,
+		Requestor:           r.RequestedBy,
+		Status:              types.RequestStatus(r.Status),
+		UpdatedAt:           r.UpdatedAt,
+		CanReview:           canReview,
+		ApprovalMethod:      r.ApprovalMethod,
+		ApprovalPolicyId:    r.ApprovalPolicyID,
+		DelegatedBy:         r.DelegatedBy,
+		TimingClippedReason: r.TimingClippedReason,
+	}
+	if r.ApprovedBy != nil {
+		approvedBy := r.ApprovedBy
+		req.ApprovedBy = &approvedBy
+	}
+	if r.ApprovalStage > 0 {
+		stage := r.ApprovalStage
+		req.ApprovalStage = &stage
 	}
 	if r.Grant != nil {
 		g := r.Grant.ToAPI()
 		req.Grant = &g
 	}
+	if len(r.AdditionalGrants) > 0 {
+		grants := make([]types.Grant, len(r.AdditionalGrants))
+		for i, g := range r.AdditionalGrants {
+			grants[i] = g.ToAPI()
+		}
+		req.AdditionalGrants = &grants
+	}
+	if r.Extension != nil {
+		e := r.Extension.ToAPI()
+		req.Extension = &e
+	}
+	if len(r.Data.With) > 0 {
+		req.SelectedWith = &types.SelectedArguments{AdditionalProperties: r.Data.With}
+	}
 	// show the updated timing rather than the requested timing if it's been overridden by an approver.
 	if r.OverrideTiming != nil {
 		req.Timing = r.OverrideTiming.ToAPI()
 	}
+	if fields := r.Data.FieldValues(accessRule.Fields); len(fields) > 0 {
+		req.Fields = &fields
+	}
 
 	return req
 }
 
-func (r *Request) DDBKeys() (ddb.Keys, error) {
-	// - APPROVED requests have an end time on the grant
+// requestEnd is the end time which requests are sorted and queried by in the indexes.
+func (r *Request) requestEnd() time.Time {
+	// - APPROVED and NEEDS_RETROSPECTIVE_REVIEW requests have an end time on the grant
 	// - PENDING Scheduled requests have a request end time
 	// - PENDING asap requests should have MAXIMUM endtime
-	// - Declined and Cancelled requests should have an end time = createdAt so they get a somewhat natural order in the results
+	// - Declined, Cancelled and Expired requests should have an end time = createdAt so they get a somewhat natural order in the results
 	// - REVOKED grants should have end time = created at
 	end := r.CreatedAt
-	if r.Status == APPROVED || r.Status == PENDING {
+	if r.Status == APPROVED || r.Status == PENDING || r.Status == NEEDS_RETROSPECTIVE_REVIEW {
 		if r.Grant != nil {
 			if r.Grant.Status != ac_types.REVOKED {
 				end = r.Grant.End
@@ -182,7 +341,11 @@ func (r *Request) DDBKeys() (ddb.Keys, error) {
 			end = time.Unix(1<<63-1, 0)
 		}
 	}
+	return end
+}
 
+func (r *Request) DDBKeys() (ddb.Keys, error) {
+	end := r.requestEnd()
 	keys := ddb.Keys{
 		PK:     keys.AccessRequest.PK1,
 		SK:     r.ID,
@@ -252,4 +415,33 @@ func (t *Timing) GetInterval(opts ...func(o *GetIntervalOpts)) (start time.Time,
 // through filling in form fields in the web application.
 type RequestData struct {
 	Reason *string `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	// Fields are the values given for the request fields of the access rule, keyed by field ID.
+	Fields map[string]string `json:"fields,omitempty" dynamodbav:"fields,omitempty"`
+	// With are the values chosen for the selectable arguments of the access rule's target, keyed by argument ID.
+	// They are combined with the fixed arguments of the target when the grant is created.
+	With map[string]string `json:"with,omitempty" dynamodbav:"with,omitempty"`
+}
+
+// FieldValues returns the values of the request fields in the order they are defined on the access rule.
+// Values for fields which have since been removed from the rule are returned last, sorted by ID, and labelled with their ID.
+func (d RequestData) FieldValues(fields []rule.RequestField) []types.RequestFieldValue {
+	var res []types.RequestFieldValue
+	seen := make(map[string]bool)
+	for _, f := range fields {
+		seen[f.ID] = true
+		if v, ok := d.Fields[f.ID]; ok {
+			res = append(res, types.RequestFieldValue{Id: f.ID, Label: f.Label, Value: v})
+		}
+	}
+	var removed []string
+	for id := range d.Fields {
+		if !seen[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(removed)
+	for _, id := range removed {
+		res = append(res, types.RequestFieldValue{Id: id, Label: id, Value: d.Fields[id]})
+	}
+	return res
 }
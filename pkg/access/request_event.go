@@ -25,18 +25,58 @@ type RequestEvent struct {
 	GrantCreated       *bool                 `json:"grantCreated,omitempty" dynamodbav:"grantCreated,omitempty"`
 	GrantFailureReason *string               `json:"grantFailureReason,omitempty" dynamodbav:"grantFailureReason,omitempty"`
 	RequestCreated     *bool                 `json:"requestCreated,omitempty" dynamodbav:"requestCreated,omitempty"`
+	FromApprovalStage  *int                  `json:"fromApprovalStage,omitempty" dynamodbav:"fromApprovalStage,omitempty"`
+	ToApprovalStage    *int                  `json:"toApprovalStage,omitempty" dynamodbav:"toApprovalStage,omitempty"`
+	// ExtensionStatus is set when a grant extension is requested or reviewed.
+	ExtensionStatus *ExtensionStatus `json:"extensionStatus,omitempty" dynamodbav:"extensionStatus,omitempty"`
+	// OnBehalfOf is the ID of the user a delegated request was made for. The Actor is the user who submitted it.
+	// For reviewer delegation events, it is the ID of the approver who the Delegate reviews on behalf of.
+	OnBehalfOf *string `json:"onBehalfOf,omitempty" dynamodbav:"onBehalfOf,omitempty"`
+	// Delegate is the ID of a user added as a reviewer on behalf of an approver who is away.
+	Delegate *string `json:"delegate,omitempty" dynamodbav:"delegate,omitempty"`
+	// Comment is the text of a comment made on the request.
+	Comment *string `json:"comment,omitempty" dynamodbav:"comment,omitempty"`
+	// ReleasedByRequestor is set when the requestor ends their own grant before it expires.
+	ReleasedByRequestor *bool `json:"releasedByRequestor,omitempty" dynamodbav:"releasedByRequestor,omitempty"`
+	// GrantID is set for grant events about the additional targets of an Access Rule.
+	GrantID *string `json:"grantId,omitempty" dynamodbav:"grantId,omitempty"`
+	// GrantDrift is set when the grant reconciler finds that the grant doesn't match its provider.
+	GrantDrift *ac_types.GrantReconciliationDrift `json:"grantDrift,omitempty" dynamodbav:"grantDrift,omitempty"`
+	// GrantDriftRemediated is set when the grant reconciler provisioned missing access again, or removed access which should have expired.
+	GrantDriftRemediated *bool `json:"grantDriftRemediated,omitempty" dynamodbav:"grantDriftRemediated,omitempty"`
+	// GrantDriftError is why the grant reconciler couldn't remediate the drift.
+	GrantDriftError *string `json:"grantDriftError,omitempty" dynamodbav:"grantDriftError,omitempty"`
+}
+
+// ForTarget returns the event for the grant of one of the targets of the request, numbered in the same way as GrantID.
+// Events for the rule's target are unchanged.
+func (r RequestEvent) ForTarget(target int) RequestEvent {
+	if target > 0 {
+		id := GrantID(r.RequestID, target)
+		r.GrantID = &id
+	}
+	return r
 }
 
 func NewRequestCreatedEvent(requestID string, createdAt time.Time, actor *string) RequestEvent {
 	t := true
 	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, RequestCreated: &t}
 }
+func NewDelegatedRequestCreatedEvent(requestID string, createdAt time.Time, actor *string, onBehalfOf string) RequestEvent {
+	t := true
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, RequestCreated: &t, OnBehalfOf: &onBehalfOf}
+}
 func NewGrantFailedEvent(requestID string, createdAt time.Time, from, to ac_types.GrantStatus, reason string) RequestEvent {
 	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, FromGrantStatus: &from, ToGrantStatus: &to, GrantFailureReason: &reason}
 }
 func NewGrantStatusChangeEvent(requestID string, createdAt time.Time, actor *string, from, to ac_types.GrantStatus) RequestEvent {
 	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, FromGrantStatus: &from, ToGrantStatus: &to}
 }
+func NewGrantReleasedEvent(requestID string, createdAt time.Time, actor *string, from ac_types.GrantStatus) RequestEvent {
+	t := true
+	to := ac_types.REVOKED
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, FromGrantStatus: &from, ToGrantStatus: &to, ReleasedByRequestor: &t}
+}
 func NewGrantCreatedEvent(requestID string, createdAt time.Time) RequestEvent {
 	t := true
 	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, GrantCreated: &t}
@@ -47,6 +87,25 @@ func NewStatusChangeEvent(requestID string, createdAt time.Time, actor *string,
 func NewTimingChangeEvent(requestID string, createdAt time.Time, actor *string, from, to Timing) RequestEvent {
 	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, FromTiming: &from, ToTiming: &to}
 }
+func NewApprovalStageChangeEvent(requestID string, createdAt time.Time, actor *string, from, to int) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, FromApprovalStage: &from, ToApprovalStage: &to}
+}
+func NewExtensionEvent(requestID string, createdAt time.Time, actor *string, status ExtensionStatus) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, ExtensionStatus: &status}
+}
+func NewCommentEvent(requestID string, createdAt time.Time, actor *string, comment string) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, Actor: actor, RequestID: requestID, Comment: &comment}
+}
+func NewReviewerDelegatedEvent(requestID string, createdAt time.Time, delegate, onBehalfOf string) RequestEvent {
+	return RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, Delegate: &delegate, OnBehalfOf: &onBehalfOf}
+}
+func NewGrantDriftedEvent(requestID string, createdAt time.Time, drift ac_types.GrantReconciliationDrift, remediated bool, driftErr *string) RequestEvent {
+	e := RequestEvent{ID: types.NewHistoryID(), CreatedAt: createdAt, RequestID: requestID, GrantDrift: &drift, GrantDriftError: driftErr}
+	if remediated {
+		e.GrantDriftRemediated = &remediated
+	}
+	return e
+}
 func (r *RequestEvent) ToAPI() types.RequestEvent {
 	var toTiming *types.RequestTiming
 	var fromTiming *types.RequestTiming
@@ -59,19 +118,30 @@ func (r *RequestEvent) ToAPI() types.RequestEvent {
 		fromTiming = &ft
 	}
 	return types.RequestEvent{
-		Id:                 r.ID,
-		RequestId:          r.RequestID,
-		CreatedAt:          r.CreatedAt,
-		Actor:              r.Actor,
-		FromGrantStatus:    (*types.RequestEventFromGrantStatus)(r.FromGrantStatus),
-		FromStatus:         (*types.RequestStatus)(r.FromStatus),
-		FromTiming:         fromTiming,
-		ToGrantStatus:      (*types.RequestEventToGrantStatus)(r.ToGrantStatus),
-		ToStatus:           (*types.RequestStatus)(r.ToStatus),
-		ToTiming:           toTiming,
-		GrantCreated:       r.GrantCreated,
-		RequestCreated:     r.RequestCreated,
-		GrantFailureReason: r.GrantFailureReason,
+		Id:                   r.ID,
+		RequestId:            r.RequestID,
+		CreatedAt:            r.CreatedAt,
+		Actor:                r.Actor,
+		FromGrantStatus:      (*types.RequestEventFromGrantStatus)(r.FromGrantStatus),
+		FromStatus:           (*types.RequestStatus)(r.FromStatus),
+		FromTiming:           fromTiming,
+		ToGrantStatus:        (*types.RequestEventToGrantStatus)(r.ToGrantStatus),
+		ToStatus:             (*types.RequestStatus)(r.ToStatus),
+		ToTiming:             toTiming,
+		GrantCreated:         r.GrantCreated,
+		RequestCreated:       r.RequestCreated,
+		GrantFailureReason:   r.GrantFailureReason,
+		FromApprovalStage:    r.FromApprovalStage,
+		ToApprovalStage:      r.ToApprovalStage,
+		ExtensionStatus:      (*types.ExtensionStatus)(r.ExtensionStatus),
+		OnBehalfOf:           r.OnBehalfOf,
+		Delegate:             r.Delegate,
+		Comment:              r.Comment,
+		ReleasedByRequestor:  r.ReleasedByRequestor,
+		GrantId:              r.GrantID,
+		GrantDrift:           (*types.RequestEventGrantDrift)(r.GrantDrift),
+		GrantDriftRemediated: r.GrantDriftRemediated,
+		GrantDriftError:      r.GrantDriftError,
 	}
 }
 
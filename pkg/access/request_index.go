@@ -0,0 +1,32 @@
+package access
+
+import (
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// RequestIndex is a copy of a Request which is saved alongside it.
+// The Request item uses all of the table's secondary indexes for queries by user,
+// so the copy is used to query requests by access rule, by status and end time, and by access rule, status and end time.
+// It is saved whenever the request is.
+type RequestIndex struct {
+	Request Request `json:"request" dynamodbav:"request"`
+}
+
+// DDBKeys provides the keys for storing the object in DynamoDB
+func (r *RequestIndex) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK:     keys.RequestIndex.PK1,
+		SK:     keys.RequestIndex.SK1(r.Request.ID),
+		GSI1PK: keys.RequestIndex.GSI1PK(r.Request.Rule),
+		GSI1SK: keys.RequestIndex.GSI1SK(r.Request.ID),
+		GSI2PK: keys.RequestIndex.GSI2PK(r.Request.Rule),
+		GSI2SK: keys.RequestIndex.GSI2SK(string(r.Request.Status), r.Request.ID),
+		GSI3PK: keys.RequestIndex.GSI3PK(string(r.Request.Status)),
+		GSI3SK: keys.RequestIndex.GSI3SK(r.Request.requestEnd()),
+		GSI4PK: keys.RequestIndex.GSI4PK(r.Request.Rule, string(r.Request.Status)),
+		GSI4SK: keys.RequestIndex.GSI4SK(r.Request.requestEnd()),
+	}
+
+	return keys, nil
+}
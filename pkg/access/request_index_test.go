@@ -0,0 +1,39 @@
+package access
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIndexDDBKeys(t *testing.T) {
+	end := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	r := RequestIndex{
+		Request: Request{
+			ID:     "req_1",
+			Rule:   "rul_1",
+			Status: APPROVED,
+			Grant:  &Grant{End: end},
+		},
+	}
+
+	want := ddb.Keys{
+		PK:     "REQUEST_INDEX#",
+		SK:     "req_1",
+		GSI1PK: "REQUEST_INDEX#rul_1",
+		GSI1SK: "req_1",
+		GSI2PK: "REQUEST_INDEX#rul_1",
+		GSI2SK: "APPROVED#req_1",
+		GSI3PK: "REQUEST_INDEX#APPROVED",
+		GSI3SK: "2022-01-01T10:00:00Z",
+		GSI4PK: "REQUEST_INDEX#rul_1#APPROVED",
+		GSI4SK: "2022-01-01T10:00:00Z",
+	}
+	got, err := r.DDBKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, want, got)
+}
@@ -185,3 +185,24 @@ func TestRequestGetInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestGrantID(t *testing.T) {
+	r := Request{
+		ID:               "req_1",
+		Grant:            &Grant{Provider: "aws-sso"},
+		AdditionalGrants: []Grant{{Provider: "okta"}, {Provider: "azure"}},
+	}
+
+	for target := 0; target <= 2; target++ {
+		id := GrantID(r.ID, target)
+		requestID, got := ParseGrantID(id)
+		assert.Equal(t, "req_1", requestID)
+		assert.Equal(t, target, got)
+	}
+	assert.Equal(t, "req_1", GrantID("req_1", 0))
+	assert.Equal(t, "req_1-target-2", GrantID("req_1", 2))
+
+	assert.Equal(t, "aws-sso", r.GrantForTarget(0).Provider)
+	assert.Equal(t, "azure", r.GrantForTarget(2).Provider)
+	assert.Nil(t, r.GrantForTarget(3))
+}
@@ -13,6 +13,11 @@ type Reviewer struct {
 	// Request is the associated request.
 	Request       Request       `json:"request" dynamodbav:"request"`
 	Notifications Notifications `json:"notifications" dynamodbav:"notifications"`
+	// ApprovalStage is the index of the approval stage this reviewer may review.
+	ApprovalStage int `json:"approvalStage" dynamodbav:"approvalStage"`
+	// OnBehalfOf is set if the reviewer was added as the delegate of an approver who is away.
+	// It is the ID of the approver.
+	OnBehalfOf *string `json:"onBehalfOf,omitempty" dynamodbav:"onBehalfOf,omitempty"`
 }
 
 type Notifications struct {
@@ -15,6 +15,15 @@ import (
 
 func (a *API) AdminArchiveAccessRule(w http.ResponseWriter, r *http.Request, ruleId string) {
 	ctx := r.Context()
+	var b types.AdminArchiveAccessRuleJSONRequestBody
+	// the request body is optional.
+	if r.ContentLength != 0 {
+		err := apio.DecodeJSONBody(w, r, &b)
+		if err != nil {
+			apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+			return
+		}
+	}
 	u := auth.UserFromContext(ctx)
 	q := storage.GetAccessRuleCurrent{ID: ruleId}
 	_, err := a.DB.Query(ctx, &q)
@@ -28,12 +37,57 @@ func (a *API) AdminArchiveAccessRule(w http.ResponseWriter, r *http.Request, rul
 		return
 	}
 
-	c, err := a.Rules.ArchiveAccessRule(ctx, u, *q.Result)
+	opts := rulesvc.ArchiveOpts{RevokeGrants: b.RevokeGrants != nil && *b.RevokeGrants}
+	c, err := a.Rules.ArchiveAccessRule(ctx, u, *q.Result, opts)
+	if err == rulesvc.ErrAccessRuleAlreadyArchived {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
 	}
-	apio.JSON(ctx, w, c.ToAPIDetail(), http.StatusCreated)
+	res := types.ArchiveAccessRuleResult{
+		AccessRule: c.Rule.ToAPIDetail(),
+		Outcomes:   make([]types.ArchiveOutcome, len(c.Outcomes)),
+	}
+	for i, o := range c.Outcomes {
+		res.Outcomes[i] = o.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Preview the impact of archiving an Access Rule
+// (GET /api/v1/admin/access-rules/{ruleId}/archive/impact)
+func (a *API) AdminGetAccessRuleArchiveImpact(w http.ResponseWriter, r *http.Request, ruleId string) {
+	ctx := r.Context()
+	q := storage.GetAccessRuleCurrent{ID: ruleId}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, &apio.APIError{Err: errors.New("this rule does not exist"), Status: http.StatusNotFound})
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	impact, err := a.Rules.GetArchiveImpact(ctx, ruleId)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.AccessRuleArchiveImpact{
+		PendingRequests: make([]types.Request, len(impact.PendingRequests)),
+		ActiveGrants:    make([]types.Request, len(impact.ActiveGrants)),
+	}
+	for i, req := range impact.PendingRequests {
+		res.PendingRequests[i] = req.ToAPI()
+	}
+	for i, req := range impact.ActiveGrants {
+		res.ActiveGrants[i] = req.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
 }
 
 // Returns a list of all Access Rules
@@ -181,6 +235,106 @@ func (a *API) AdminGetAccessRuleVersion(w http.ResponseWriter, r *http.Request,
 	apio.JSON(ctx, w, q.Result.ToAPIDetail(), http.StatusOK)
 }
 
+// Roll back Access Rule
+// (POST /api/v1/admin/access-rules/{ruleId}/versions/{version}/rollback)
+func (a *API) AdminRollbackAccessRule(w http.ResponseWriter, r *http.Request, ruleId string, version string) {
+	ctx := r.Context()
+	var b types.AdminRollbackAccessRuleJSONRequestBody
+	// the request body is optional.
+	if r.ContentLength != 0 {
+		err := apio.DecodeJSONBody(w, r, &b)
+		if err != nil {
+			apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+			return
+		}
+	}
+	uid := auth.UserIDFromContext(ctx)
+
+	ruleq := storage.GetAccessRuleCurrent{ID: ruleId}
+	_, err := a.DB.Query(ctx, &ruleq)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, &apio.APIError{Err: errors.New("this rule does not exist"), Status: http.StatusNotFound})
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	vq := storage.GetAccessRuleVersion{ID: ruleId, VersionID: version}
+	_, err = a.DB.Query(ctx, &vq)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, &apio.APIError{Err: errors.New("this rule or version does not exist"), Status: http.StatusNotFound})
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	rolledBack, err := a.Rules.RollbackRule(ctx, &rulesvc.RollbackOpts{
+		UpdaterID:     uid,
+		Rule:          *ruleq.Result,
+		Version:       *vq.Result,
+		UpdateMessage: b.UpdateMessage,
+	})
+	if err == rulesvc.ErrAccessRuleAlreadyArchived || err == rulesvc.ErrVersionIsCurrent {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, rolledBack.ToAPIDetail(), http.StatusOK)
+}
+
+// Diff Access Rule versions
+// (GET /api/v1/admin/access-rules/{ruleId}/diff)
+func (a *API) AdminDiffAccessRuleVersions(w http.ResponseWriter, r *http.Request, ruleId string, params types.AdminDiffAccessRuleVersionsParams) {
+	ctx := r.Context()
+	fq := storage.GetAccessRuleVersion{ID: ruleId, VersionID: params.From}
+	_, err := a.DB.Query(ctx, &fq)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, &apio.APIError{Err: errors.New("this rule or version does not exist"), Status: http.StatusNotFound})
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	var to *rule.AccessRule
+	if params.To != nil {
+		tq := storage.GetAccessRuleVersion{ID: ruleId, VersionID: *params.To}
+		_, err = a.DB.Query(ctx, &tq)
+		to = tq.Result
+	} else {
+		tq := storage.GetAccessRuleCurrent{ID: ruleId}
+		_, err = a.DB.Query(ctx, &tq)
+		to = tq.Result
+	}
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, &apio.APIError{Err: errors.New("this rule or version does not exist"), Status: http.StatusNotFound})
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	changes := rule.DiffVersions(*fq.Result, *to)
+	res := types.AccessRuleDiff{
+		RuleId:      ruleId,
+		FromVersion: fq.Result.Version,
+		ToVersion:   to.Version,
+		Changes:     make([]types.AccessRuleFieldChange, len(changes)),
+	}
+	for i, c := range changes {
+		res.Changes[i] = c.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
 // List Access Rules
 // (GET /api/v1/access-rules)
 func (a *API) ListUserAccessRules(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/auth"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// List owned Access Rules
+// (GET /api/v1/owner/access-rules)
+func (a *API) OwnerListAccessRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	rules, err := a.Rules.ListOwnedRules(ctx, u)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListAccessRulesDetailResponse{
+		AccessRules: make([]types.AccessRuleDetail, len(rules)),
+	}
+	for i, r := range rules {
+		res.AccessRules[i] = r.ToAPIDetail()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Get owned Access Rule
+// (GET /api/v1/owner/access-rules/{ruleId})
+func (a *API) OwnerGetAccessRule(w http.ResponseWriter, r *http.Request, ruleId string) {
+	ctx := r.Context()
+	rule, ok := a.getOwnedRule(w, r, ruleId)
+	if !ok {
+		return
+	}
+	apio.JSON(ctx, w, rule.ToAPIDetail(), http.StatusOK)
+}
+
+// Update owned Access Rule
+// (PUT /api/v1/owner/access-rules/{ruleId})
+func (a *API) OwnerUpdateAccessRule(w http.ResponseWriter, r *http.Request, ruleId string) {
+	ctx := r.Context()
+	var updateRequest types.UpdateAccessRuleRequest
+	err := apio.DecodeJSONBody(w, r, &updateRequest)
+	if err != nil {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	rule, ok := a.getOwnedRule(w, r, ruleId)
+	if !ok {
+		return
+	}
+	err = rulesvc.CheckOwnerUpdate(*rule, updateRequest, auth.IsAdmin(ctx))
+	if err != nil {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusForbidden))
+		return
+	}
+
+	updatedRule, err := a.Rules.UpdateRule(ctx, &rulesvc.UpdateOpts{
+		UpdaterID:     auth.UserIDFromContext(ctx),
+		Rule:          *rule,
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, updatedRule.ToAPIDetail(), http.StatusOK)
+}
+
+// Archive owned Access Rule
+// (POST /api/v1/owner/access-rules/{ruleId}/archive)
+func (a *API) OwnerArchiveAccessRule(w http.ResponseWriter, r *http.Request, ruleId string) {
+	ctx := r.Context()
+	rule, ok := a.getOwnedRule(w, r, ruleId)
+	if !ok {
+		return
+	}
+	// owners can't revoke grants through the owner API, so only the pending requests for the rule are cancelled.
+	archived, err := a.Rules.ArchiveAccessRule(ctx, auth.UserFromContext(ctx), *rule, rulesvc.ArchiveOpts{})
+	if err == rulesvc.ErrAccessRuleAlreadyArchived {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
+		return
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, archived.Rule.ToAPIDetail(), http.StatusOK)
+}
+
+// List requests for owned Access Rule
+// (GET /api/v1/owner/access-rules/{ruleId}/requests)
+func (a *API) OwnerListAccessRuleRequests(w http.ResponseWriter, r *http.Request, ruleId string, params types.OwnerListAccessRuleRequestsParams) {
+	ctx := r.Context()
+	_, ok := a.getOwnedRule(w, r, ruleId)
+	if !ok {
+		return
+	}
+
+	queryOpts := []func(*ddb.QueryOpts){ddb.Limit(50)}
+	if params.NextToken != nil {
+		queryOpts = append(queryOpts, ddb.Page(*params.NextToken))
+	}
+	q := storage.ListRequestsForRule{RuleID: ruleId}
+	qR, err := a.DB.Query(ctx, &q, queryOpts...)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListRequestsResponse{
+		Requests: make([]types.Request, len(q.Result)),
+	}
+	for i, req := range q.Result {
+		res.Requests[i] = req.ToAPI()
+	}
+	if qR != nil && qR.NextPage != "" {
+		res.Next = &qR.NextPage
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// getOwnedRule fetches a rule which the user is an owner of, writing an error response if they aren't.
+// Rules which the user doesn't own are reported as not found, so that their existence isn't revealed.
+func (a *API) getOwnedRule(w http.ResponseWriter, r *http.Request, ruleId string) (*rule.AccessRule, bool) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	rule, err := a.Rules.GetOwnedRule(ctx, ruleId, u, auth.IsAdmin(ctx))
+	if err == ddb.ErrNoItems || err == rulesvc.ErrUserNotAuthorized {
+		apio.Error(ctx, w, &apio.APIError{Err: errors.New("this rule doesn't exist or you aren't an owner of it"), Status: http.StatusNotFound})
+		return nil, false
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return nil, false
+	}
+	return rule, true
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/api/mocks"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnerUpdateAccessRule(t *testing.T) {
+	type testcase struct {
+		name           string
+		give           string
+		isAdmin        bool
+		mockGetRule    *rule.AccessRule
+		mockGetRuleErr error
+		wantUpdate     bool
+		wantCode       int
+		wantBody       string
+	}
+
+	owned := &rule.AccessRule{
+		ID:     "rule1",
+		Status: rule.ACTIVE,
+		Owners: rule.Owners{Users: []string{"owner"}},
+		Groups: []string{"developers"},
+	}
+	update := `{"timeConstraints":{"maxDurationSeconds": 60},"groups":["developers"],"name":"string","description":"string","approval":{"groups":[],"users":[]}}`
+	changeApprovers := `{"timeConstraints":{"maxDurationSeconds": 60},"groups":["developers"],"name":"string","description":"string","approval":{"groups":[],"users":["owner"]}}`
+	changeOwners := `{"timeConstraints":{"maxDurationSeconds": 60},"groups":["developers"],"name":"string","description":"string","approval":{"groups":[],"users":[]},"owners":{"users":["owner","other"],"groups":[]}}`
+
+	testcases := []testcase{
+		{
+			name:        "ok",
+			give:        update,
+			mockGetRule: owned,
+			wantUpdate:  true,
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:           "not an owner",
+			give:           update,
+			mockGetRuleErr: rulesvc.ErrUserNotAuthorized,
+			wantCode:       http.StatusNotFound,
+			wantBody:       `{"error":"this rule doesn't exist or you aren't an owner of it"}`,
+		},
+		{
+			name:           "rule not found",
+			give:           update,
+			mockGetRuleErr: ddb.ErrNoItems,
+			wantCode:       http.StatusNotFound,
+			wantBody:       `{"error":"this rule doesn't exist or you aren't an owner of it"}`,
+		},
+		{
+			name:        "owners can't change the owners",
+			give:        changeOwners,
+			mockGetRule: owned,
+			wantCode:    http.StatusForbidden,
+			wantBody:    `{"error":"only administrators can change the owners of an access rule"}`,
+		},
+		{
+			name:        "owners can't change the approvers",
+			give:        changeApprovers,
+			mockGetRule: owned,
+			wantCode:    http.StatusForbidden,
+			wantBody:    `{"error":"only administrators can change the groups, approvers, approval policies, admission policy or break-glass setting of an access rule (changed: approval.users)"}`,
+		},
+		{
+			name:        "admins can change the approvers",
+			give:        changeApprovers,
+			isAdmin:     true,
+			mockGetRule: owned,
+			wantUpdate:  true,
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:        "admins can change the owners",
+			give:        changeOwners,
+			isAdmin:     true,
+			mockGetRule: owned,
+			wantUpdate:  true,
+			wantCode:    http.StatusOK,
+		},
+		{
+			name:           "internal error",
+			give:           update,
+			mockGetRuleErr: errors.New("internal error"),
+			wantCode:       http.StatusInternalServerError,
+			wantBody:       `{"error":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			user := identity.User{ID: "owner"}
+			m := mocks.NewMockAccessRuleService(ctrl)
+			m.EXPECT().GetOwnedRule(gomock.Any(), "rule1", &user, tc.isAdmin).Return(tc.mockGetRule, tc.mockGetRuleErr)
+			if tc.wantUpdate {
+				m.EXPECT().UpdateRule(gomock.Any(), gomock.Any()).Return(tc.mockGetRule, nil)
+			}
+			a := API{Rules: m}
+			handler := newTestServer(t, &a, withRequestUser(user), withIsAdmin(tc.isAdmin))
+
+			req, err := http.NewRequest("PUT", "/api/v1/owner/access-rules/rule1", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantBody == "" {
+				return
+			}
+			data, err := io.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
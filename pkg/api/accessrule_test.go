@@ -2,6 +2,7 @@ package api
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -546,3 +547,196 @@ func TestUserGetAccessRuleApprovers(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminRollbackAccessRule(t *testing.T) {
+	type testcase struct {
+		name            string
+		give            string
+		version         *rule.AccessRule
+		mockRollback    *rule.AccessRule
+		mockRollbackErr error
+		wantCode        int
+		wantBody        string
+	}
+
+	current := rule.AccessRule{ID: "rule1", Status: rule.ACTIVE, Version: "v2", Current: true}
+	earlier := rule.AccessRule{ID: "rule1", Status: rule.ACTIVE, Version: "v1"}
+	v1 := "v1"
+
+	testcases := []testcase{
+		{
+			name:    "ok",
+			give:    `{"updateMessage":"reverting bad edit"}`,
+			version: &earlier,
+			mockRollback: &rule.AccessRule{
+				ID:       "rule1",
+				Status:   rule.ACTIVE,
+				Version:  "v3",
+				Current:  true,
+				Metadata: rule.AccessRuleMetadata{RolledBackTo: &v1},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"approval":{"groups":[],"users":[]},"description":"","groups":null,"id":"rule1","isCurrent":true,"metadata":{"createdAt":"0001-01-01T00:00:00Z","createdBy":"","rolledBackTo":"v1","updatedAt":"0001-01-01T00:00:00Z","updatedBy":""},"name":"","status":"ACTIVE","target":{"provider":{"id":"","type":""},"with":{}},"timeConstraints":{"maxDurationSeconds":0},"version":"v3"}`,
+		},
+		{
+			name:            "version is current",
+			give:            `{}`,
+			version:         &current,
+			mockRollbackErr: rulesvc.ErrVersionIsCurrent,
+			wantCode:        http.StatusBadRequest,
+			wantBody:        `{"error":"this version is already the current version of the access rule"}`,
+		},
+		{
+			name:     "version not found",
+			give:     `{}`,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"error":"this rule or version does not exist"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockAccessRuleService(ctrl)
+			if tc.mockRollback != nil || tc.mockRollbackErr != nil {
+				m.EXPECT().RollbackRule(gomock.Any(), gomock.Any()).Return(tc.mockRollback, tc.mockRollbackErr)
+			}
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &current})
+			if tc.version != nil {
+				db.MockQuery(&storage.GetAccessRuleVersion{Result: tc.version})
+			} else {
+				db.MockQueryWithErr(&storage.GetAccessRuleVersion{}, ddb.ErrNoItems)
+			}
+			a := API{Rules: m, DB: db}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/access-rules/rule1/versions/v1/rollback", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := ioutil.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
+func TestAdminDiffAccessRuleVersions(t *testing.T) {
+	from := rule.AccessRule{
+		ID:       "rule1",
+		Version:  "v1",
+		Groups:   []string{"developers"},
+		Approval: rule.Approval{Users: []string{"alice"}},
+		Target:   rule.Target{ProviderID: "okta", With: map[string]string{"groupId": "123"}},
+	}
+	current := from
+	current.Version = "v2"
+	current.Current = true
+	current.Groups = []string{"developers", "contractors"}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.GetAccessRuleVersion{Result: &from})
+	db.MockQuery(&storage.GetAccessRuleCurrent{Result: &current})
+	a := API{DB: db}
+	handler := newTestServer(t, &a)
+
+	req, err := http.NewRequest("GET", "/api/v1/admin/access-rules/rule1/diff?from=v1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	data, err := ioutil.ReadAll(rr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"changes":[{"added":["contractors"],"field":"groups"}],"fromVersion":"v1","ruleId":"rule1","toVersion":"v2"}`, string(data))
+}
+
+func TestAdminArchiveAccessRule(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        string
+		wantOpts    rulesvc.ArchiveOpts
+		mockArchive *rulesvc.ArchiveResult
+		mockArchErr error
+		wantCode    int
+		wantBody    string
+	}
+
+	current := rule.AccessRule{ID: "rule1", Status: rule.ACTIVE}
+	archived := rule.AccessRule{ID: "rule1", Status: rule.ARCHIVED}
+
+	testcases := []testcase{
+		{
+			name:        "without a body",
+			mockArchive: &rulesvc.ArchiveResult{Rule: archived},
+			wantCode:    http.StatusOK,
+			wantBody:    `{"accessRule":{"approval":{"groups":[],"users":[]},"description":"","groups":null,"id":"rule1","isCurrent":false,"metadata":{"createdAt":"0001-01-01T00:00:00Z","createdBy":"","updatedAt":"0001-01-01T00:00:00Z","updatedBy":""},"name":"","status":"ARCHIVED","target":{"provider":{"id":"","type":""},"with":{}},"timeConstraints":{"maxDurationSeconds":0},"version":""},"outcomes":[]}`,
+		},
+		{
+			name:     "revoke grants",
+			give:     `{"revokeGrants":true}`,
+			wantOpts: rulesvc.ArchiveOpts{RevokeGrants: true},
+			mockArchive: &rulesvc.ArchiveResult{Rule: archived, Outcomes: []rulesvc.ArchiveOutcome{
+				{RequestID: "req_1", Action: rulesvc.ArchiveCancelRequest, Result: rulesvc.ArchiveSucceeded},
+				{RequestID: "req_2", Action: rulesvc.ArchiveRevokeGrant, Result: rulesvc.ArchiveFailed, Message: "provider error"},
+			}},
+			wantCode: http.StatusOK,
+			wantBody: `{"accessRule":{"approval":{"groups":[],"users":[]},"description":"","groups":null,"id":"rule1","isCurrent":false,"metadata":{"createdAt":"0001-01-01T00:00:00Z","createdBy":"","updatedAt":"0001-01-01T00:00:00Z","updatedBy":""},"name":"","status":"ARCHIVED","target":{"provider":{"id":"","type":""},"with":{}},"timeConstraints":{"maxDurationSeconds":0},"version":""},"outcomes":[{"action":"CANCEL_REQUEST","requestId":"req_1","result":"SUCCEEDED"},{"action":"REVOKE_GRANT","message":"provider error","requestId":"req_2","result":"FAILED"}]}`,
+		},
+		{
+			name:        "already archived",
+			mockArchErr: rulesvc.ErrAccessRuleAlreadyArchived,
+			wantCode:    http.StatusBadRequest,
+			wantBody:    `{"error":"access rule already archived"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockAccessRuleService(ctrl)
+			m.EXPECT().ArchiveAccessRule(gomock.Any(), gomock.Any(), current, tc.wantOpts).Return(tc.mockArchive, tc.mockArchErr)
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &current})
+			a := API{Rules: m, DB: db}
+			handler := newTestServer(t, &a)
+
+			var body io.Reader
+			if tc.give != "" {
+				body = strings.NewReader(tc.give)
+			}
+			req, err := http.NewRequest("POST", "/api/v1/admin/access-rules/rule1/archive", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+			data, err := ioutil.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
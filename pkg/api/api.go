@@ -9,6 +9,7 @@ import (
 	"github.com/benbjohnson/clock"
 
 	ahtypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/identity"
 	"github.com/common-fate/granted-approvals/pkg/rule"
@@ -17,6 +18,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
 
 	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/common-fate/granted-approvals/pkg/types"
 
 	"github.com/go-chi/chi/v5"
@@ -48,6 +50,7 @@ type API struct {
 	AccessHandlerClient ahtypes.ClientWithResponsesInterface
 	AdminGroup          string
 	Granter             accesssvc.Granter
+	Clock               clock.Clock
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_access_service.go -package=mocks . AccessService
@@ -55,18 +58,27 @@ type API struct {
 // RequestServices can create Access Requests.
 type AccessService interface {
 	CreateRequest(ctx context.Context, user *identity.User, in types.CreateRequestRequest) (*accesssvc.CreateRequestResult, error)
+	PreviewRequest(ctx context.Context, user *identity.User, in types.CreateRequestRequest) (*accesssvc.PreviewRequestResult, error)
 	AddReviewAndGrantAccess(ctx context.Context, opts accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error)
 	CancelRequest(ctx context.Context, opts accesssvc.CancelRequestOpts) error
+	ExtendRequest(ctx context.Context, opts accesssvc.ExtendRequestOpts) (*access.Request, error)
+	ReleaseRequest(ctx context.Context, opts accesssvc.ReleaseRequestOpts) (*access.Request, error)
+	ReviewExtension(ctx context.Context, opts accesssvc.ReviewExtensionOpts) (*access.Request, error)
+	AddComment(ctx context.Context, opts accesssvc.AddCommentOpts) (*access.Comment, error)
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/mock_accessrule_service.go -package=mocks . AccessRuleService
 
 // AccessRuleService can create and get rules
 type AccessRuleService interface {
-	ArchiveAccessRule(ctx context.Context, user *identity.User, in rule.AccessRule) (*rule.AccessRule, error)
+	ArchiveAccessRule(ctx context.Context, user *identity.User, in rule.AccessRule, opts rulesvc.ArchiveOpts) (*rulesvc.ArchiveResult, error)
+	GetArchiveImpact(ctx context.Context, ruleID string) (*rulesvc.ArchiveImpact, error)
 	CreateAccessRule(ctx context.Context, user *identity.User, in types.CreateAccessRuleRequest) (*rule.AccessRule, error)
 	GetRule(ctx context.Context, ID string, user *identity.User, isAdmin bool) (*rule.AccessRule, error)
 	UpdateRule(ctx context.Context, in *rulesvc.UpdateOpts) (*rule.AccessRule, error)
+	RollbackRule(ctx context.Context, in *rulesvc.RollbackOpts) (*rule.AccessRule, error)
+	GetOwnedRule(ctx context.Context, ID string, user *identity.User, isAdmin bool) (*rule.AccessRule, error)
+	ListOwnedRules(ctx context.Context, user *identity.User) ([]rule.AccessRule, error)
 }
 
 // API must meet the generated REST API interface.
@@ -89,7 +101,7 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 		return nil, errors.New("AccessHandlerClient must be provided")
 	}
 
-	db, err := ddb.New(ctx, opts.DynamoTable)
+	db, err := storage.New(ctx, opts.DynamoTable)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +110,7 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 
 	a := API{
 		AdminGroup: opts.AdminGroup,
+		Clock:      clk,
 		Access: &accesssvc.Service{
 			Clock: clk,
 			DB:    db,
@@ -108,11 +121,20 @@ func New(ctx context.Context, opts Opts) (*API, error) {
 				EventBus: opts.EventSender,
 			},
 			EventPutter: opts.EventSender,
+			AHClient:    opts.AccessHandlerClient,
+			AdminGroup:  opts.AdminGroup,
 		},
 		Rules: &rulesvc.Service{
-			Clock:    clk,
-			DB:       db,
-			AHClient: opts.AccessHandlerClient,
+			Clock:       clk,
+			DB:          db,
+			AHClient:    opts.AccessHandlerClient,
+			EventPutter: opts.EventSender,
+			Granter: &grantsvc.Granter{
+				AHClient: opts.AccessHandlerClient,
+				DB:       db,
+				Clock:    clk,
+				EventBus: opts.EventSender,
+			},
 		},
 
 		AccessHandlerClient: opts.AccessHandlerClient,
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/auth"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// List blackout periods
+// (GET /api/v1/admin/blackouts)
+func (a *API) AdminListBlackouts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := storage.ListBlackouts{}
+	_, err := a.DB.Query(ctx, &q)
+	// don't return an error response when there are no blackouts
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListBlackoutsResponse{
+		Blackouts: make([]types.Blackout, len(q.Result)),
+	}
+	for i, b := range q.Result {
+		res.Blackouts[i] = b.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Create a blackout period
+// (POST /api/v1/admin/blackouts)
+func (a *API) AdminCreateBlackout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	var b types.AdminCreateBlackoutJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	if !b.End.After(b.Start) {
+		apio.Error(ctx, w, &apio.APIError{
+			Err:    errors.New("blackout validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{{Field: "end", Error: "end must be after start"}},
+		})
+		return
+	}
+
+	blackout := access.Blackout{
+		ID:        types.NewBlackoutID(),
+		Start:     b.Start,
+		End:       b.End,
+		Reason:    b.Reason,
+		CreatedBy: u.ID,
+		CreatedAt: a.Clock.Now(),
+	}
+	err = a.DB.Put(ctx, &blackout)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, blackout.ToAPI(), http.StatusCreated)
+}
+
+// Delete a blackout period
+// (DELETE /api/v1/admin/blackouts/{blackoutId})
+func (a *API) AdminDeleteBlackout(w http.ResponseWriter, r *http.Request, blackoutId string) {
+	ctx := r.Context()
+	q := storage.GetBlackout{ID: blackoutId}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(errors.New("blackout not found"), http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	err = a.DB.Delete(ctx, q.Result)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminListBlackouts(t *testing.T) {
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListBlackouts{Result: []access.Blackout{
+		{
+			ID:        "blk_1",
+			Start:     time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:       time.Date(2100, 1, 2, 0, 0, 0, 0, time.UTC),
+			Reason:    "change freeze",
+			CreatedBy: "usr_1",
+		},
+	}})
+
+	a := API{DB: db}
+	handler := newTestServer(t, &a)
+
+	req, err := http.NewRequest("GET", "/api/v1/admin/blackouts", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	data, err := ioutil.ReadAll(rr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"blackouts":[{"createdBy":"usr_1","end":"2100-01-02T00:00:00Z","id":"blk_1","reason":"change freeze","start":"2100-01-01T00:00:00Z"}]}`, string(data))
+}
+
+func TestAdminCreateBlackout(t *testing.T) {
+	type testcase struct {
+		name     string
+		give     string
+		wantCode int
+		wantBody string
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			give:     `{"start":"2100-01-01T00:00:00Z","end":"2100-01-02T00:00:00Z","reason":"change freeze"}`,
+			wantCode: http.StatusCreated,
+		},
+		{
+			name:     "end before start",
+			give:     `{"start":"2100-01-02T00:00:00Z","end":"2100-01-01T00:00:00Z","reason":"change freeze"}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"blackout validation failed","fields":[{"field":"end","error":"end must be after start"}]}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+
+			a := API{DB: db, Clock: clock.NewMock()}
+			handler := newTestServer(t, &a, withRequestUser(identity.User{ID: "usr_1"}))
+
+			req, err := http.NewRequest("POST", "/api/v1/admin/blackouts", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := ioutil.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(data))
+			} else {
+				assert.Contains(t, string(data), `"createdBy":"usr_1"`)
+			}
+		})
+	}
+}
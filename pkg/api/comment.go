@@ -0,0 +1,83 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/auth"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// List request comments
+// (GET /api/v1/requests/{requestId}/comments)
+func (a *API) ListRequestComments(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	q := storage.GetRequest{ID: requestId}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+		return
+	} else if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	if !auth.IsAdmin(ctx) && !q.Result.IsRequestor(u.ID) {
+		qrv := storage.GetRequestReviewer{RequestID: requestId, ReviewerID: u.ID}
+		_, err = a.DB.Query(ctx, &qrv)
+		if err == ddb.ErrNoItems {
+			// user is not a reviewer of this request or the requestor
+			apio.Error(ctx, w, apio.NewRequestError(err, http.StatusNotFound))
+			return
+		} else if err != nil {
+			apio.Error(ctx, w, err)
+			return
+		}
+	}
+
+	qc := storage.ListRequestComments{RequestID: requestId}
+	_, err = a.DB.Query(ctx, &qc)
+	if err != nil && err != ddb.ErrNoItems {
+		apio.Error(ctx, w, err)
+		return
+	}
+	res := types.ListRequestCommentsResponse{
+		Comments: make([]types.Comment, len(qc.Result)),
+	}
+	for i, c := range qc.Result {
+		res.Comments[i] = c.ToAPI()
+	}
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// Comment on a request
+// (POST /api/v1/requests/{requestId}/comments)
+func (a *API) AddRequestComment(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.AddRequestCommentJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	uid := auth.UserIDFromContext(ctx)
+
+	c, err := a.Access.AddComment(ctx, accesssvc.AddCommentOpts{
+		UserID:      uid,
+		UserIsAdmin: auth.IsAdmin(ctx),
+		RequestID:   requestId,
+		Body:        b.Body,
+	})
+	if err == ddb.ErrNoItems || err == accesssvc.ErrUserNotAuthorized {
+		// users who can't see the request get a 404 rather than a 401, so that we don't reveal the request exists.
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, c.ToAPI(), http.StatusCreated)
+}
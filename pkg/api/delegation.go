@@ -0,0 +1,96 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/auth"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// Get the current user's reviewer delegation
+// (GET /api/v1/users/me/delegation)
+func (a *API) GetMyDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	q := storage.GetUserDelegation{UserID: u.ID}
+	_, err := a.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, q.Result.ToAPI(), http.StatusOK)
+}
+
+// Set the current user's reviewer delegation
+// (PUT /api/v1/users/me/delegation)
+func (a *API) SetMyDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	var b types.SetMyDelegationJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	var fields []apio.FieldError
+	if b.Delegate == u.ID {
+		fields = append(fields, apio.FieldError{Field: "delegate", Error: "you cannot delegate your reviews to yourself"})
+	} else {
+		q := storage.GetUser{ID: b.Delegate}
+		_, err = a.DB.Query(ctx, &q)
+		if err == ddb.ErrNoItems {
+			fields = append(fields, apio.FieldError{Field: "delegate", Error: "user not found"})
+		} else if err != nil {
+			apio.Error(ctx, w, err)
+			return
+		}
+	}
+	if !b.End.After(b.Start) {
+		fields = append(fields, apio.FieldError{Field: "end", Error: "end must be after start"})
+	} else if !b.End.After(a.Clock.Now()) {
+		fields = append(fields, apio.FieldError{Field: "end", Error: "end must be in the future"})
+	}
+	if len(fields) > 0 {
+		apio.Error(ctx, w, &apio.APIError{
+			Err:    errors.New("delegation validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		})
+		return
+	}
+
+	d := identity.Delegation{
+		UserID:     u.ID,
+		DelegateID: b.Delegate,
+		Start:      b.Start,
+		End:        b.End,
+	}
+	err = a.DB.Put(ctx, &d)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, d.ToAPI(), http.StatusOK)
+}
+
+// Remove the current user's reviewer delegation
+// (DELETE /api/v1/users/me/delegation)
+func (a *API) DeleteMyDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+	err := a.DB.Delete(ctx, &identity.Delegation{UserID: u.ID})
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMyDelegation(t *testing.T) {
+	type testcase struct {
+		name         string
+		give         string
+		withDelegate *identity.User
+		wantCode     int
+		wantBody     string
+	}
+
+	testcases := []testcase{
+		{
+			name:         "ok",
+			give:         `{"delegate":"usr_2","start":"2100-01-01T00:00:00Z","end":"2100-01-08T00:00:00Z"}`,
+			withDelegate: &identity.User{ID: "usr_2"},
+			wantCode:     http.StatusOK,
+			wantBody:     `{"delegate":"usr_2","end":"2100-01-08T00:00:00Z","start":"2100-01-01T00:00:00Z","user":"usr_1"}`,
+		},
+		{
+			name:     "cannot delegate to self",
+			give:     `{"delegate":"usr_1","start":"2100-01-01T00:00:00Z","end":"2100-01-08T00:00:00Z"}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"delegation validation failed","fields":[{"field":"delegate","error":"you cannot delegate your reviews to yourself"}]}`,
+		},
+		{
+			name:     "delegate not found",
+			give:     `{"delegate":"usr_3","start":"2100-01-01T00:00:00Z","end":"2100-01-08T00:00:00Z"}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"error":"delegation validation failed","fields":[{"field":"delegate","error":"user not found"}]}`,
+		},
+		{
+			name:         "end before start",
+			give:         `{"delegate":"usr_2","start":"2100-01-08T00:00:00Z","end":"2100-01-01T00:00:00Z"}`,
+			withDelegate: &identity.User{ID: "usr_2"},
+			wantCode:     http.StatusBadRequest,
+			wantBody:     `{"error":"delegation validation failed","fields":[{"field":"end","error":"end must be after start"}]}`,
+		},
+		{
+			name:         "end in the past",
+			give:         `{"delegate":"usr_2","start":"2000-01-01T00:00:00Z","end":"2000-01-08T00:00:00Z"}`,
+			withDelegate: &identity.User{ID: "usr_2"},
+			wantCode:     http.StatusBadRequest,
+			wantBody:     `{"error":"delegation validation failed","fields":[{"field":"end","error":"end must be in the future"}]}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			if tc.withDelegate != nil {
+				db.MockQuery(&storage.GetUser{Result: tc.withDelegate})
+			} else {
+				db.MockQueryWithErr(&storage.GetUser{}, ddb.ErrNoItems)
+			}
+
+			clk := clock.NewMock()
+			clk.Set(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+			a := API{DB: db, Clock: clk}
+			handler := newTestServer(t, &a, withRequestUser(identity.User{ID: "usr_1"}))
+
+			req, err := http.NewRequest("PUT", "/api/v1/users/me/delegation", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := ioutil.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	access "github.com/common-fate/granted-approvals/pkg/access"
 	identity "github.com/common-fate/granted-approvals/pkg/identity"
 	accesssvc "github.com/common-fate/granted-approvals/pkg/service/accesssvc"
 	types "github.com/common-fate/granted-approvals/pkg/types"
@@ -37,6 +38,21 @@ func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
 	return m.recorder
 }
 
+// AddComment mocks base method.
+func (m *MockAccessService) AddComment(arg0 context.Context, arg1 accesssvc.AddCommentOpts) (*access.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", arg0, arg1)
+	ret0, _ := ret[0].(*access.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockAccessServiceMockRecorder) AddComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockAccessService)(nil).AddComment), arg0, arg1)
+}
+
 // AddReviewAndGrantAccess mocks base method.
 func (m *MockAccessService) AddReviewAndGrantAccess(arg0 context.Context, arg1 accesssvc.AddReviewOpts) (*accesssvc.AddReviewResult, error) {
 	m.ctrl.T.Helper()
@@ -80,3 +96,63 @@ func (mr *MockAccessServiceMockRecorder) CreateRequest(arg0, arg1, arg2 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockAccessService)(nil).CreateRequest), arg0, arg1, arg2)
 }
+
+// ExtendRequest mocks base method.
+func (m *MockAccessService) ExtendRequest(arg0 context.Context, arg1 accesssvc.ExtendRequestOpts) (*access.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendRequest", arg0, arg1)
+	ret0, _ := ret[0].(*access.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendRequest indicates an expected call of ExtendRequest.
+func (mr *MockAccessServiceMockRecorder) ExtendRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendRequest", reflect.TypeOf((*MockAccessService)(nil).ExtendRequest), arg0, arg1)
+}
+
+// PreviewRequest mocks base method.
+func (m *MockAccessService) PreviewRequest(arg0 context.Context, arg1 *identity.User, arg2 types.CreateRequestRequest) (*accesssvc.PreviewRequestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*accesssvc.PreviewRequestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewRequest indicates an expected call of PreviewRequest.
+func (mr *MockAccessServiceMockRecorder) PreviewRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewRequest", reflect.TypeOf((*MockAccessService)(nil).PreviewRequest), arg0, arg1, arg2)
+}
+
+// ReleaseRequest mocks base method.
+func (m *MockAccessService) ReleaseRequest(arg0 context.Context, arg1 accesssvc.ReleaseRequestOpts) (*access.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRequest", arg0, arg1)
+	ret0, _ := ret[0].(*access.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseRequest indicates an expected call of ReleaseRequest.
+func (mr *MockAccessServiceMockRecorder) ReleaseRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRequest", reflect.TypeOf((*MockAccessService)(nil).ReleaseRequest), arg0, arg1)
+}
+
+// ReviewExtension mocks base method.
+func (m *MockAccessService) ReviewExtension(arg0 context.Context, arg1 accesssvc.ReviewExtensionOpts) (*access.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewExtension", arg0, arg1)
+	ret0, _ := ret[0].(*access.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewExtension indicates an expected call of ReviewExtension.
+func (mr *MockAccessServiceMockRecorder) ReviewExtension(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewExtension", reflect.TypeOf((*MockAccessService)(nil).ReviewExtension), arg0, arg1)
+}
@@ -39,18 +39,18 @@ func (m *MockAccessRuleService) EXPECT() *MockAccessRuleServiceMockRecorder {
 }
 
 // ArchiveAccessRule mocks base method.
-func (m *MockAccessRuleService) ArchiveAccessRule(arg0 context.Context, arg1 *identity.User, arg2 rule.AccessRule) (*rule.AccessRule, error) {
+func (m *MockAccessRuleService) ArchiveAccessRule(arg0 context.Context, arg1 *identity.User, arg2 rule.AccessRule, arg3 rulesvc.ArchiveOpts) (*rulesvc.ArchiveResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ArchiveAccessRule", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*rule.AccessRule)
+	ret := m.ctrl.Call(m, "ArchiveAccessRule", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*rulesvc.ArchiveResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ArchiveAccessRule indicates an expected call of ArchiveAccessRule.
-func (mr *MockAccessRuleServiceMockRecorder) ArchiveAccessRule(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockAccessRuleServiceMockRecorder) ArchiveAccessRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveAccessRule", reflect.TypeOf((*MockAccessRuleService)(nil).ArchiveAccessRule), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveAccessRule", reflect.TypeOf((*MockAccessRuleService)(nil).ArchiveAccessRule), arg0, arg1, arg2, arg3)
 }
 
 // CreateAccessRule mocks base method.
@@ -68,6 +68,36 @@ func (mr *MockAccessRuleServiceMockRecorder) CreateAccessRule(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessRule", reflect.TypeOf((*MockAccessRuleService)(nil).CreateAccessRule), arg0, arg1, arg2)
 }
 
+// GetArchiveImpact mocks base method.
+func (m *MockAccessRuleService) GetArchiveImpact(arg0 context.Context, arg1 string) (*rulesvc.ArchiveImpact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchiveImpact", arg0, arg1)
+	ret0, _ := ret[0].(*rulesvc.ArchiveImpact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchiveImpact indicates an expected call of GetArchiveImpact.
+func (mr *MockAccessRuleServiceMockRecorder) GetArchiveImpact(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchiveImpact", reflect.TypeOf((*MockAccessRuleService)(nil).GetArchiveImpact), arg0, arg1)
+}
+
+// GetOwnedRule mocks base method.
+func (m *MockAccessRuleService) GetOwnedRule(arg0 context.Context, arg1 string, arg2 *identity.User, arg3 bool) (*rule.AccessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnedRule", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*rule.AccessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnedRule indicates an expected call of GetOwnedRule.
+func (mr *MockAccessRuleServiceMockRecorder) GetOwnedRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnedRule", reflect.TypeOf((*MockAccessRuleService)(nil).GetOwnedRule), arg0, arg1, arg2, arg3)
+}
+
 // GetRule mocks base method.
 func (m *MockAccessRuleService) GetRule(arg0 context.Context, arg1 string, arg2 *identity.User, arg3 bool) (*rule.AccessRule, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +113,36 @@ func (mr *MockAccessRuleServiceMockRecorder) GetRule(arg0, arg1, arg2, arg3 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRule", reflect.TypeOf((*MockAccessRuleService)(nil).GetRule), arg0, arg1, arg2, arg3)
 }
 
+// ListOwnedRules mocks base method.
+func (m *MockAccessRuleService) ListOwnedRules(arg0 context.Context, arg1 *identity.User) ([]rule.AccessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOwnedRules", arg0, arg1)
+	ret0, _ := ret[0].([]rule.AccessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOwnedRules indicates an expected call of ListOwnedRules.
+func (mr *MockAccessRuleServiceMockRecorder) ListOwnedRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOwnedRules", reflect.TypeOf((*MockAccessRuleService)(nil).ListOwnedRules), arg0, arg1)
+}
+
+// RollbackRule mocks base method.
+func (m *MockAccessRuleService) RollbackRule(arg0 context.Context, arg1 *rulesvc.RollbackOpts) (*rule.AccessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackRule", arg0, arg1)
+	ret0, _ := ret[0].(*rule.AccessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackRule indicates an expected call of RollbackRule.
+func (mr *MockAccessRuleServiceMockRecorder) RollbackRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRule", reflect.TypeOf((*MockAccessRuleService)(nil).RollbackRule), arg0, arg1)
+}
+
 // UpdateRule mocks base method.
 func (m *MockAccessRuleService) UpdateRule(arg0 context.Context, arg1 *rulesvc.UpdateOpts) (*rule.AccessRule, error) {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
 	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
 	"github.com/common-fate/granted-approvals/pkg/types"
 )
 
@@ -152,7 +153,8 @@ func (a *API) UserGetRequest(w http.ResponseWriter, r *http.Request, requestId s
 		apio.Error(ctx, w, errors.New("access rule result was nil"))
 		return
 	}
-	if q.Result.RequestedBy == u.ID {
+	// the user who delegated a request can view it, but can't review it.
+	if q.Result.IsRequestor(u.ID) {
 		apio.JSON(ctx, w, q.Result.ToAPIDetail(*qr.Result, false), http.StatusOK)
 		return
 	}
@@ -185,19 +187,96 @@ func (a *API) UserCreateRequest(w http.ResponseWriter, r *http.Request) {
 	// create the request. The RequestCreator handles the validation
 	// and saving the request to the database.
 	result, err := a.Access.CreateRequest(ctx, u, incomingRequest)
-	if err == accesssvc.ErrNoMatchingGroup {
-		// the user isn't authorized to make requests on this rule.
-		err = apio.NewRequestError(err, http.StatusUnauthorized)
-	} else if err == accesssvc.ErrRuleNotFound {
-		err = apio.NewRequestError(fmt.Errorf("access rule %s not found", incomingRequest.AccessRuleId), http.StatusNotFound)
+	if err != nil {
+		apio.Error(ctx, w, createRequestError(err, incomingRequest))
+		return
 	}
 
+	apio.JSON(ctx, w, result.Request.ToAPI(), http.StatusCreated)
+}
+
+// Preview a request
+// (POST /api/v1/requests/preview)
+func (a *API) UserPreviewRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u := auth.UserFromContext(ctx)
+
+	var incomingRequest types.CreateRequestRequest
+	err := apio.DecodeJSONBody(w, r, &incomingRequest)
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
 	}
 
-	apio.JSON(ctx, w, result.Request.ToAPI(), http.StatusCreated)
+	result, err := a.Access.PreviewRequest(ctx, u, incomingRequest)
+	if err != nil {
+		err = createRequestError(err, incomingRequest)
+		var apiErr *apio.APIError
+		if !errors.As(err, &apiErr) || apiErr.Status >= http.StatusInternalServerError {
+			apio.Error(ctx, w, err)
+			return
+		}
+		// the request would be rejected, so return the reason rather than an error response.
+		msg := apiErr.Error()
+		res := types.RequestPreview{
+			Valid:     false,
+			Error:     &msg,
+			Reviewers: []string{},
+		}
+		if len(apiErr.Fields) > 0 {
+			fields := make([]types.FieldError, len(apiErr.Fields))
+			for i, f := range apiErr.Fields {
+				fields[i] = types.FieldError{Field: f.Field, Error: f.Error}
+			}
+			res.Fields = &fields
+		}
+		apio.JSON(ctx, w, res, http.StatusOK)
+		return
+	}
+
+	req := result.Request.ToAPI()
+	res := types.RequestPreview{
+		Valid:            true,
+		Status:           &req.Status,
+		ApprovalMethod:   req.ApprovalMethod,
+		ApprovalPolicyId: req.ApprovalPolicyId,
+		Timing:           &req.Timing,
+		Reviewers:        []string{},
+		GrantValidation:  &types.GrantValidation{Valid: result.GrantError == nil},
+	}
+	for _, rev := range result.Reviewers {
+		res.Reviewers = append(res.Reviewers, rev.ReviewerID)
+	}
+	if result.GrantError != nil {
+		msg := result.GrantError.Error()
+		res.GrantValidation.Error = &msg
+	}
+
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// createRequestError wraps the expected errors from creating a request with the HTTP status code they should be returned with.
+func createRequestError(err error, in types.CreateRequestRequest) error {
+	if err == accesssvc.ErrNoMatchingGroup {
+		// the user isn't authorized to make requests on this rule.
+		return apio.NewRequestError(err, http.StatusUnauthorized)
+	}
+	if err == accesssvc.ErrRuleNotFound {
+		return apio.NewRequestError(fmt.Errorf("access rule %s not found", in.AccessRuleId), http.StatusNotFound)
+	}
+	if err == accesssvc.ErrBreakGlassNotAllowed || err == accesssvc.ErrOnBehalfOfUserNotFound || err == accesssvc.ErrOnBehalfOfUserNoMatchingGroup || err == accesssvc.ErrOutsideAllowedWindow || err == accesssvc.ErrRequestOverlapsExistingGrant {
+		return apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if errors.As(err, &accesssvc.BlackoutError{}) {
+		return apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if errors.As(err, &accesssvc.AdmissionDeniedError{}) {
+		return apio.NewRequestError(err, http.StatusForbidden)
+	}
+	if err == accesssvc.ErrOnBehalfOfNotAllowed {
+		return apio.NewRequestError(err, http.StatusForbidden)
+	}
+	return err
 }
 
 func (a *API) CancelRequest(w http.ResponseWriter, r *http.Request, requestId string) {
@@ -211,6 +290,10 @@ func (a *API) CancelRequest(w http.ResponseWriter, r *http.Request, requestId st
 	if err == ddb.ErrNoItems {
 		err = apio.NewRequestError(err, http.StatusNotFound)
 	}
+	if err == dbupdate.ErrRequestConflict {
+		// wrap the error in a 409 status code
+		err = apio.NewRequestError(err, http.StatusConflict)
+	}
 	if err == accesssvc.ErrUserNotAuthorized {
 		// wrap the error in a 401 status code
 		err = apio.NewRequestError(err, http.StatusUnauthorized)
@@ -279,6 +362,10 @@ func (a *API) RevokeRequest(w http.ResponseWriter, r *http.Request, requestID st
 		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusBadRequest))
 		return
 	}
+	if err == dbupdate.ErrRequestConflict {
+		apio.Error(ctx, w, apio.NewRequestError(err, http.StatusConflict))
+		return
+	}
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
@@ -286,6 +373,79 @@ func (a *API) RevokeRequest(w http.ResponseWriter, r *http.Request, requestID st
 	apio.JSON(ctx, w, res, http.StatusOK)
 }
 
+// Release an active request
+// (POST /api/v1/requests/{requestId}/release)
+func (a *API) ReleaseRequest(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	uid := auth.UserIDFromContext(ctx)
+
+	req, err := a.Access.ReleaseRequest(ctx, accesssvc.ReleaseRequestOpts{
+		RequestorID: uid,
+		RequestID:   requestId,
+	})
+	if err == ddb.ErrNoItems {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err == dbupdate.ErrRequestConflict {
+		// wrap the error in a 409 status code
+		err = apio.NewRequestError(err, http.StatusConflict)
+	}
+	if err == accesssvc.ErrUserNotAuthorized {
+		// wrap the error in a 401 status code
+		err = apio.NewRequestError(err, http.StatusUnauthorized)
+	}
+	if err == accesssvc.ErrRequestCannotBeReleased || err == grantsvc.ErrGrantInactive {
+		// wrap the error in a 400 status code
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, req.ToAPI(), http.StatusOK)
+}
+
+// Extend an active request
+// (POST /api/v1/requests/{requestId}/extend)
+func (a *API) ExtendRequest(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.ExtendRequestJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	uid := auth.UserIDFromContext(ctx)
+
+	req, err := a.Access.ExtendRequest(ctx, accesssvc.ExtendRequestOpts{
+		RequestorID: uid,
+		RequestID:   requestId,
+		Duration:    time.Second * time.Duration(b.DurationSeconds),
+		Reason:      b.Reason,
+	})
+	if err == ddb.ErrNoItems || err == accesssvc.ErrRuleNotFound {
+		err = apio.NewRequestError(err, http.StatusNotFound)
+	}
+	if err == dbupdate.ErrRequestConflict {
+		// wrap the error in a 409 status code
+		err = apio.NewRequestError(err, http.StatusConflict)
+	}
+	if err == accesssvc.ErrUserNotAuthorized {
+		// wrap the error in a 401 status code
+		err = apio.NewRequestError(err, http.StatusUnauthorized)
+	}
+	if err == accesssvc.ErrRequestCannotBeExtended || err == accesssvc.ErrExtensionAlreadyPending || err == accesssvc.ErrRequestOverlapsExistingGrant ||
+		err == accesssvc.ErrExtensionOutsideTimeConstraints || err == accesssvc.ErrOutsideAllowedWindow || errors.As(err, &accesssvc.BlackoutError{}) {
+		// wrap the error in a 400 status code
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, req.ToAPI(), http.StatusOK)
+}
+
 // Get Access Instructions
 // (GET /api/v1/requests/{requestId}/access-instructions)
 func (a *API) GetAccessInstructions(w http.ResponseWriter, r *http.Request, requestId string) {
@@ -341,7 +501,7 @@ func (a *API) ListRequestEvents(w http.ResponseWriter, r *http.Request, requestI
 		return
 	}
 	if !canView {
-		if q.Result.RequestedBy == u.ID {
+		if q.Result.IsRequestor(u.ID) {
 			canView = true
 		} else {
 			qrv := storage.GetRequestReviewer{RequestID: requestId, ReviewerID: u.ID}
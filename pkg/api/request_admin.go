@@ -98,5 +98,5 @@ func (a *API) AdminGetRequest(w http.ResponseWriter, r *http.Request, requestId
 		apio.Error(ctx, w, errors.New("access rule result was nil"))
 		return
 	}
-	apio.JSON(ctx, w, q.Result.ToAPIDetail(*qr.Result, q.Result.RequestedBy != u.ID), http.StatusOK)
+	apio.JSON(ctx, w, q.Result.ToAPIDetail(*qr.Result, !q.Result.IsRequestor(u.ID)), http.StatusOK)
 }
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/common-fate/granted-approvals/pkg/access"
@@ -18,6 +19,8 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
+	"github.com/common-fate/granted-approvals/pkg/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -110,6 +113,106 @@ func TestUserCreateRequest(t *testing.T) {
 
 }
 
+func TestUserPreviewRequest(t *testing.T) {
+	type testcase struct {
+		name           string
+		give           string
+		mockPreview    *accesssvc.PreviewRequestResult
+		mockPreviewErr error
+		wantCode       int
+		wantBody       string
+	}
+
+	reviewed := types.REVIEWED
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: `{"timing":{"durationSeconds": 10}, "accessRuleId": "rul_123"}`,
+			mockPreview: &accesssvc.PreviewRequestResult{
+				Request: access.Request{
+					Status:         access.PENDING,
+					ApprovalMethod: &reviewed,
+					RequestedTiming: access.Timing{
+						Duration: time.Second * 10,
+					},
+				},
+				Reviewers: []access.Reviewer{{ReviewerID: "usr_1"}},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"approvalMethod":"REVIEWED","grantValidation":{"valid":true},"reviewers":["usr_1"],"status":"PENDING","timing":{"durationSeconds":10},"valid":true}`,
+		},
+		{
+			name: "access handler would fail to grant access",
+			give: `{"timing":{"durationSeconds": 10}, "accessRuleId": "rul_123"}`,
+			mockPreview: &accesssvc.PreviewRequestResult{
+				Request: access.Request{
+					Status: access.APPROVED,
+					RequestedTiming: access.Timing{
+						Duration: time.Second * 10,
+					},
+				},
+				GrantError: errors.New("group Admins was not found"),
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"grantValidation":{"error":"group Admins was not found","valid":false},"reviewers":[],"status":"APPROVED","timing":{"durationSeconds":10},"valid":true}`,
+		},
+		{
+			name:           "overlaps existing grant",
+			give:           `{"timing":{"durationSeconds": 10}, "accessRuleId": "rul_123"}`,
+			mockPreviewErr: accesssvc.ErrRequestOverlapsExistingGrant,
+			wantCode:       http.StatusOK,
+			wantBody:       `{"error":"this request overlaps an existing grant","reviewers":[],"valid":false}`,
+		},
+		{
+			name: "invalid fields",
+			give: `{"timing":{"durationSeconds": 10}, "accessRuleId": "rul_123"}`,
+			mockPreviewErr: &apio.APIError{
+				Err:    errors.New("request validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{{Field: "timing.durationSeconds", Error: "too long"}},
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"error":"request validation failed","fields":[{"error":"too long","field":"timing.durationSeconds"}],"reviewers":[],"valid":false}`,
+		},
+		{
+			name:           "unexpected error",
+			give:           `{"timing":{"durationSeconds": 10}, "accessRuleId": "rul_123"}`,
+			mockPreviewErr: errors.New("database unavailable"),
+			wantCode:       http.StatusInternalServerError,
+			wantBody:       `{"error":"Internal Server Error"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().PreviewRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.mockPreview, tc.mockPreviewErr).AnyTimes()
+			a := API{Access: mockAccess}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/requests/preview", strings.NewReader(tc.give))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := ioutil.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
 func TestUserCancelRequest(t *testing.T) {
 	type testcase struct {
 		name          string
@@ -500,6 +603,83 @@ func TestRevokeRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseRequest(t *testing.T) {
+	type testcase struct {
+		name           string
+		mockRelease    *access.Request
+		mockReleaseErr error
+		wantCode       int
+		wantBody       string
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			mockRelease: &access.Request{
+				ID:          "req_123",
+				RequestedBy: "testuser",
+				Rule:        "rul_123",
+				RuleVersion: "0001-01-01T00:00:00Z",
+				Status:      access.APPROVED,
+			},
+			wantCode: http.StatusOK,
+			wantBody: `{"accessRule":{"id":"rul_123","version":"0001-01-01T00:00:00Z"},"id":"req_123","requestedAt":"0001-01-01T00:00:00Z","requestor":"testuser","status":"APPROVED","timing":{"durationSeconds":0},"updatedAt":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			name:           "not the requestor",
+			mockReleaseErr: accesssvc.ErrUserNotAuthorized,
+			wantCode:       http.StatusUnauthorized,
+			wantBody:       `{"error":"user is not authorized to perform this action"}`,
+		},
+		{
+			name:           "no active grant",
+			mockReleaseErr: accesssvc.ErrRequestCannotBeReleased,
+			wantCode:       http.StatusBadRequest,
+			wantBody:       `{"error":"only requests with an active grant can be released"}`,
+		},
+		{
+			name:           "not found",
+			mockReleaseErr: ddb.ErrNoItems,
+			wantCode:       http.StatusNotFound,
+			wantBody:       `{"error":"item query returned no items"}`,
+		},
+		{
+			name:           "request changed while releasing",
+			mockReleaseErr: dbupdate.ErrRequestConflict,
+			wantCode:       http.StatusConflict,
+			wantBody:       `{"error":"the request was changed by someone else while you were updating it, reload it and try again"}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAccess := mocks.NewMockAccessService(ctrl)
+			mockAccess.EXPECT().ReleaseRequest(gomock.Any(), gomock.Any()).Return(tc.mockRelease, tc.mockReleaseErr).AnyTimes()
+			a := API{Access: mockAccess}
+			handler := newTestServer(t, &a)
+
+			req, err := http.NewRequest("POST", "/api/v1/requests/req_123/release", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantCode, rr.Code)
+
+			data, err := ioutil.ReadAll(rr.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tc.wantBody, string(data))
+		})
+	}
+}
+
 func TestUserListRequestEvents(t *testing.T) {
 
 	type testcase struct {
@@ -1,16 +1,20 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
 	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/auth"
+	"github.com/common-fate/granted-approvals/pkg/identity"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/service/accesssvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
 	"github.com/common-fate/granted-approvals/pkg/types"
 	"golang.org/x/sync/errgroup"
 )
@@ -27,6 +31,74 @@ func (a *API) ReviewRequest(w http.ResponseWriter, r *http.Request, requestId st
 	}
 	user := auth.UserFromContext(ctx)
 
+	var overrideTiming *access.Timing
+	if b.OverrideTiming != nil {
+		ot := access.TimingFromRequestTiming(*b.OverrideTiming)
+		overrideTiming = &ot
+	}
+	result, err := a.reviewRequest(ctx, user, requestId, access.Decision(b.Decision), b.Comment, overrideTiming)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+
+	requestAPI := result.ToAPI()
+
+	res := types.ReviewResponse{
+		Request: &requestAPI,
+	}
+
+	apio.JSON(ctx, w, res, http.StatusCreated)
+}
+
+// Review multiple requests
+// (POST /api/v1/requests/review)
+func (a *API) BulkReviewRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b types.BulkReviewRequestsJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	user := auth.UserFromContext(ctx)
+	log := logger.Get(ctx)
+
+	res := types.BulkReviewResponse{
+		Results: make([]types.BulkReviewResult, len(b.RequestIds)),
+	}
+	// requests are reviewed one at a time, so that approving requests for the same user and rule
+	// is checked against the grants created earlier in the batch.
+	for i, id := range b.RequestIds {
+		res.Results[i].RequestId = id
+		result, err := a.reviewRequest(ctx, user, id, access.Decision(b.Decision), b.Comment, nil)
+		if err != nil {
+			// a failure to review one request doesn't stop the others from being reviewed.
+			log.Infow("failed to review request in bulk review", "request.id", id, "error", err)
+			msg := bulkReviewErrorMessage(err)
+			res.Results[i].Error = &msg
+			continue
+		}
+		requestAPI := result.ToAPI()
+		res.Results[i].Request = &requestAPI
+	}
+
+	apio.JSON(ctx, w, res, http.StatusOK)
+}
+
+// bulkReviewErrorMessage returns the message shown to the user for a request which couldn't be reviewed in a bulk review.
+// Only the messages of expected errors are shown, so that internal errors aren't exposed to users.
+func bulkReviewErrorMessage(err error) string {
+	var apiErr *apio.APIError
+	if errors.As(err, &apiErr) && apiErr.Status < http.StatusInternalServerError {
+		return apiErr.Err.Error()
+	}
+	return "an unexpected error occurred while reviewing the request"
+}
+
+// reviewRequest loads a request along with its reviewers and access rule, and then reviews it.
+// Expected errors are wrapped with the HTTP status code they should be returned with.
+func (a *API) reviewRequest(ctx context.Context, user *identity.User, requestID string, decision access.Decision, comment *string, overrideTiming *access.Timing) (*access.Request, error) {
 	// load the request and the reviewers, so that we can process the review.
 	// this can be done concurrently, so we use an errgroup.
 	g, fetchctx := errgroup.WithContext(ctx)
@@ -35,7 +107,7 @@ func (a *API) ReviewRequest(w http.ResponseWriter, r *http.Request, requestId st
 	var rule *rule.AccessRule
 	g.Go(func() error {
 		var err error
-		q := storage.GetRequest{ID: requestId}
+		q := storage.GetRequest{ID: requestID}
 		_, err = a.DB.Query(ctx, &q)
 		req = q.Result
 		if err == ddb.ErrNoItems {
@@ -53,59 +125,87 @@ func (a *API) ReviewRequest(w http.ResponseWriter, r *http.Request, requestId st
 		return err
 	})
 
-	reviewers := storage.ListRequestReviewers{RequestID: requestId}
+	reviewers := storage.ListRequestReviewers{RequestID: requestID}
 	g.Go(func() error {
 		_, err := a.DB.Query(fetchctx, &reviewers)
 		return err
 	})
 
-	err = g.Wait()
-
+	err := g.Wait()
 	if err != nil {
-		apio.Error(ctx, w, err)
-		return
+		return nil, err
 	}
 	if req == nil {
-		apio.Error(ctx, w, errors.New("request was nil"))
-		return
+		return nil, errors.New("request was nil")
 	}
 	if rule == nil {
-		apio.Error(ctx, w, errors.New("rule was nil"))
-		return
-	}
-	var overrideTiming *access.Timing
-	if b.OverrideTiming != nil {
-		ot := access.TimingFromRequestTiming(*b.OverrideTiming)
-		overrideTiming = &ot
+		return nil, errors.New("rule was nil")
 	}
 	result, err := a.Access.AddReviewAndGrantAccess(ctx, accesssvc.AddReviewOpts{
 		ReviewerID:      user.ID,
-		Decision:        access.Decision(b.Decision),
+		Decision:        decision,
 		ReviewerIsAdmin: user.BelongsToGroup(a.AdminGroup),
 		Request:         *req,
 		Reviewers:       reviewers.Result,
-		Comment:         b.Comment,
+		Comment:         comment,
 		AccessRule:      *rule,
 		OverrideTiming:  overrideTiming,
 	})
-	if err == accesssvc.ErrRequestOverlapsExistingGrant {
+	if err == accesssvc.ErrRequestOverlapsExistingGrant || err == accesssvc.ErrReviewerAlreadyApproved || err == accesssvc.ErrOutsideAllowedWindow || errors.As(err, &accesssvc.BlackoutError{}) || errors.As(err, &accesssvc.InvalidStatusError{}) {
 		// wrap the error in a 400 status code
 		err = apio.NewRequestError(err, http.StatusBadRequest)
 	}
+	if err == dbupdate.ErrRequestConflict {
+		// wrap the error in a 409 status code
+		err = apio.NewRequestError(err, http.StatusConflict)
+	}
 	if err == accesssvc.ErrUserNotAuthorized {
 		// wrap the error in a 401 status code
 		err = apio.NewRequestError(errors.New("you are not a reviewer of this request"), http.StatusUnauthorized)
 	}
+	if err != nil {
+		return nil, err
+	}
+	return &result.Request, nil
+}
+
+// Review a request extension
+// (POST /api/v1/requests/{requestId}/extension/review)
+func (a *API) ReviewRequestExtension(w http.ResponseWriter, r *http.Request, requestId string) {
+	ctx := r.Context()
+	var b types.ReviewRequestExtensionJSONRequestBody
+	err := apio.DecodeJSONBody(w, r, &b)
 	if err != nil {
 		apio.Error(ctx, w, err)
 		return
 	}
+	user := auth.UserFromContext(ctx)
 
-	requestAPI := result.Request.ToAPI()
-
-	res := types.ReviewResponse{
-		Request: &requestAPI,
+	req, err := a.Access.ReviewExtension(ctx, accesssvc.ReviewExtensionOpts{
+		ReviewerID:      user.ID,
+		ReviewerIsAdmin: user.BelongsToGroup(a.AdminGroup),
+		RequestID:       requestId,
+		Decision:        access.Decision(b.Decision),
+	})
+	if err == ddb.ErrNoItems || err == accesssvc.ErrRuleNotFound {
+		err = apio.NewRequestError(err, http.StatusNotFound)
 	}
-
-	apio.JSON(ctx, w, res, http.StatusCreated)
+	if err == accesssvc.ErrNoPendingExtension || err == accesssvc.ErrRequestCannotBeExtended || err == accesssvc.ErrRequestOverlapsExistingGrant ||
+		err == accesssvc.ErrExtensionOutsideTimeConstraints || err == accesssvc.ErrOutsideAllowedWindow || errors.As(err, &accesssvc.BlackoutError{}) {
+		// wrap the error in a 400 status code
+		err = apio.NewRequestError(err, http.StatusBadRequest)
+	}
+	if err == dbupdate.ErrRequestConflict {
+		// wrap the error in a 409 status code
+		err = apio.NewRequestError(err, http.StatusConflict)
+	}
+	if err == accesssvc.ErrUserNotAuthorized {
+		// wrap the error in a 401 status code
+		err = apio.NewRequestError(errors.New("you are not a reviewer of this request"), http.StatusUnauthorized)
+	}
+	if err != nil {
+		apio.Error(ctx, w, err)
+		return
+	}
+	apio.JSON(ctx, w, req.ToAPI(), http.StatusOK)
 }
@@ -137,3 +137,45 @@ func TestReviewRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestBulkReviewRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAccess := mocks.NewMockAccessService(ctrl)
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListRequestReviewers{})
+	db.MockQuery(&storage.GetRequest{Result: &access.Request{}})
+	db.MockQuery(&storage.GetAccessRuleCurrent{Result: &rule.AccessRule{}})
+
+	wantOpts := accesssvc.AddReviewOpts{Decision: access.DecisionApproved}
+	// the second and third reviews fail, but the fourth request is still reviewed.
+	// the message of an unexpected error isn't shown to the user.
+	gomock.InOrder(
+		mockAccess.EXPECT().AddReviewAndGrantAccess(gomock.Any(), wantOpts).Return(&accesssvc.AddReviewResult{Request: access.Request{ID: "req_1"}}, nil),
+		mockAccess.EXPECT().AddReviewAndGrantAccess(gomock.Any(), wantOpts).Return(nil, accesssvc.ErrRequestOverlapsExistingGrant),
+		mockAccess.EXPECT().AddReviewAndGrantAccess(gomock.Any(), wantOpts).Return(nil, errors.New("ProvisionedThroughputExceededException: internal details")),
+		mockAccess.EXPECT().AddReviewAndGrantAccess(gomock.Any(), wantOpts).Return(&accesssvc.AddReviewResult{Request: access.Request{ID: "req_4"}}, nil),
+	)
+
+	a := API{Access: mockAccess, DB: db}
+	handler := newTestServer(t, &a)
+
+	req, err := http.NewRequest("POST", "/api/v1/requests/review", strings.NewReader(`{"decision": "APPROVED", "requestIds": ["req_1", "req_2", "req_3", "req_4"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	data, err := ioutil.ReadAll(rr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"results":[{"request":{"accessRule":{"id":"","version":""},"id":"req_1","requestedAt":"0001-01-01T00:00:00Z","requestor":"","status":"","timing":{"durationSeconds":0},"updatedAt":"0001-01-01T00:00:00Z"},"requestId":"req_1"},{"error":"this request overlaps an existing grant","requestId":"req_2"},{"error":"an unexpected error occurred while reviewing the request","requestId":"req_3"},{"request":{"accessRule":{"id":"","version":""},"id":"req_4","requestedAt":"0001-01-01T00:00:00Z","requestor":"","status":"","timing":{"durationSeconds":0},"updatedAt":"0001-01-01T00:00:00Z"},"requestId":"req_4"}]}`
+	assert.Equal(t, want, string(data))
+}
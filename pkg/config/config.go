@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type Config struct {
 	Host              string `env:"APPROVALS_HOST,default=0.0.0.0:8080"`
 	LogLevel          string `env:"LOG_LEVEL,default=info"`
@@ -38,6 +40,27 @@ type SyncConfig struct {
 	IdentitySettings string `env:"IDENTITY_SETTINGS,default={}"`
 }
 
+type SweeperConfig struct {
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+	DynamoTable string `env:"APPROVALS_TABLE_NAME,required"`
+	EventBusArn string `env:"EVENT_BUS_ARN,required"`
+}
+
+type ReconcilerConfig struct {
+	LogLevel         string `env:"LOG_LEVEL,default=info"`
+	DynamoTable      string `env:"APPROVALS_TABLE_NAME,required"`
+	EventBusArn      string `env:"EVENT_BUS_ARN,required"`
+	Region           string `env:"AWS_REGION,required"`
+	AccessHandlerURL string `env:"ACCESS_HANDLER_URL,required"`
+	// RemediateDrift provisions access again for active grants which are missing from their provider, rather than only reporting them.
+	RemediateDrift bool `env:"GRANT_DRIFT_REMEDIATE,default=false"`
+	// RevokeDrift removes access for expired grants which are still provisioned in their provider, rather than only reporting them.
+	// It is separate from RemediateDrift, as the access may have been provisioned outside of Granted.
+	RevokeDrift bool `env:"GRANT_DRIFT_REVOKE,default=false"`
+	// ExpiredLookback is how long after they end that EXPIRED grants are checked for drift.
+	ExpiredLookback time.Duration `env:"GRANT_DRIFT_EXPIRED_LOOKBACK,default=168h"`
+}
+
 type FrontendDeployerConfig struct {
 	LogLevel                             string `env:"LOG_LEVEL,default=info"`
 	Region                               string `env:"AWS_REGION,required"`
@@ -61,6 +61,12 @@ func (c Config) CDKContextArgs() []string {
 	if c.Deployment.Parameters.SamlSSOMetadataURL != "" {
 		args = append(args, "-c", fmt.Sprintf("samlMetadataUrl=%s", string(c.Deployment.Parameters.SamlSSOMetadataURL)))
 	}
+	if c.Deployment.Parameters.RemediateGrantDrift != "" {
+		args = append(args, "-c", fmt.Sprintf("remediateGrantDrift=%s", string(c.Deployment.Parameters.RemediateGrantDrift)))
+	}
+	if c.Deployment.Parameters.RevokeGrantDrift != "" {
+		args = append(args, "-c", fmt.Sprintf("revokeGrantDrift=%s", string(c.Deployment.Parameters.RevokeGrantDrift)))
+	}
 	return args
 }
 
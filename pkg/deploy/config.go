@@ -195,6 +195,12 @@ type Parameters struct {
 	SamlSSOMetadataURL     string `yaml:"SamlSSOMetadataURL,omitempty"`
 	FrontendDomain         string `yaml:"FrontendDomain,omitempty"`
 	FrontendCertificateARN string `yaml:"FrontendCertificateARN,omitempty"`
+	// RemediateGrantDrift is "true" if active grants which are missing from their provider should be provisioned again.
+	// By default, drift is only reported.
+	RemediateGrantDrift string `yaml:"RemediateGrantDrift,omitempty"`
+	// RevokeGrantDrift is "true" if access should be removed for expired grants which are still provisioned in their provider.
+	// By default, drift is only reported, as the access may have been provisioned outside of Granted.
+	RevokeGrantDrift string `yaml:"RevokeGrantDrift,omitempty"`
 }
 
 // AddProvider adds a new provider to the deployment configuration.
@@ -321,6 +327,20 @@ func (c *Config) CfnParams() ([]types.Parameter, error) {
 		})
 	}
 
+	if c.Deployment.Parameters.RemediateGrantDrift != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("RemediateGrantDrift"),
+			ParameterValue: &p.RemediateGrantDrift,
+		})
+	}
+
+	if c.Deployment.Parameters.RevokeGrantDrift != "" {
+		res = append(res, types.Parameter{
+			ParameterKey:   aws.String("RevokeGrantDrift"),
+			ParameterValue: &p.RevokeGrantDrift,
+		})
+	}
+
 	return res, nil
 }
 
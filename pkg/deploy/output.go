@@ -38,6 +38,7 @@ type Output struct {
 	EventBusArn               string `json:"EventBusArn"`
 	EventBusSource            string `json:"EventBusSource"`
 	IdpSyncFunctionName       string `json:"IdpSyncFunctionName"`
+	AccessHandlerURL          string `json:"AccessHandlerURL"`
 	Region                    string `json:"Region"`
 }
 
@@ -56,6 +56,7 @@ func TestOutputStructMatchesTSType(t *testing.T) {
 		EventBusArn:               "abcdefg",
 		EventBusSource:            "abcdefg",
 		IdpSyncFunctionName:       "abcdefg",
+		AccessHandlerURL:          "abcdefg",
 		Region:                    "abcdefg",
 	}
 	b, err := json.Marshal(output)
@@ -45,29 +45,48 @@ func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLog
 	if err != nil {
 		return err
 	}
-	gq := storage.GetRequest{ID: grantEvent.Grant.ID}
+	// rules with additional targets have a grant for each target, which are all part of the same request.
+	requestID, target := access.ParseGrantID(grantEvent.Grant.ID)
+	gq := storage.GetRequest{ID: requestID}
 	_, err = n.db.Query(ctx, &gq)
 	if err != nil {
 		return err
 	}
+	grant := gq.Result.GrantForTarget(target)
 	// This would indicate a race condition or a major error
-	if gq.Result.Grant == nil {
-		return fmt.Errorf("request: %s does not have a grant", grantEvent.Grant.ID)
+	if grant == nil {
+		return fmt.Errorf("request: %s does not have a grant %s", requestID, grantEvent.Grant.ID)
 	}
 
 	if event.DetailType == gevent.GrantRevokedType {
 		log.Infow("Ignored grant revoke event")
 		return nil
 	}
-	oldStatus := gq.Result.Grant.Status
+	// grant extensions are saved by the approvals app before the event is emitted, so there is nothing to update.
+	if event.DetailType == gevent.GrantExtendedType {
+		log.Infow("Ignored grant extended event")
+		return nil
+	}
+	// drift doesn't change the status of the grant, so it is only recorded in the audit log of the request for administrators to review.
+	if event.DetailType == gevent.GrantDriftedType {
+		var grantDriftedEvent gevent.GrantDrifted
+		err := json.Unmarshal(event.Detail, &grantDriftedEvent)
+		if err != nil {
+			return err
+		}
+		requestEvent := access.NewGrantDriftedEvent(gq.Result.ID, event.Time, grantDriftedEvent.Drift, grantDriftedEvent.Remediated, grantDriftedEvent.Error).ForTarget(target)
+		log.Infow("inserting request event for grant drifted")
+		return n.db.Put(ctx, &requestEvent)
+	}
+	oldStatus := grant.Status
 	newStatus := grantEvent.Grant.Status
-	gq.Result.Grant.Status = newStatus
-	gq.Result.Grant.UpdatedAt = event.Time
+	grant.Status = newStatus
+	grant.UpdatedAt = event.Time
 	// I anticipate that this would be succeptible to a race condition, recoverable if the eventbridge retries the event handler
 	// this is because the grant events are sourced from the access handler prior to the request being saved to dynamodb on creation
 	// we could solve this by saving the request to the DB prior to making the call to the access handler?
 	if event.DetailType == gevent.GrantCreatedType {
-		requestEvent := access.NewGrantCreatedEvent(gq.Result.ID, event.Time)
+		requestEvent := access.NewGrantCreatedEvent(gq.Result.ID, event.Time).ForTarget(target)
 		log.Infow("inserting request event for grant created")
 		return n.db.Put(ctx, &requestEvent)
 	}
@@ -86,11 +105,8 @@ func (n *EventHandler) HandleGrantEvent(ctx context.Context, log *zap.SugaredLog
 		requestEvent = access.NewGrantStatusChangeEvent(gq.Result.ID, event.Time, nil, oldStatus, newStatus)
 		log.Infow("inserting request event for grant status change")
 	}
-	items, err := dbupdate.GetUpdateRequestItems(ctx, n.db, *gq.Result)
-	if err != nil {
-		return err
-	}
-	items = append(items, &requestEvent)
-	// Updates the grant status
-	return n.db.PutBatch(ctx, items...)
+	requestEvent = requestEvent.ForTarget(target)
+	// Updates the grant status.
+	// If the request was changed while the event was being handled, the error causes the event to be retried.
+	return dbupdate.UpdateRequest(ctx, n.db, gq.Result, []ddb.Keyer{&requestEvent})
 }
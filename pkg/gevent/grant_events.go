@@ -8,6 +8,8 @@ const (
 	GrantExpiredType   = "grant.expired"
 	GrantRevokedType   = "grant.revoked"
 	GrantFailedType    = "grant.failed"
+	GrantExtendedType  = "grant.extended"
+	GrantDriftedType   = "grant.drifted"
 )
 
 // GrantCreated is emitted when a new grant is
@@ -66,6 +68,23 @@ func (GrantRevoked) EventType() string {
 	return GrantRevokedType
 }
 
+// GrantExtended is emitted when the end time
+// of an active grant is moved later.
+//
+// Like GrantRevoked, this event is emitted by
+// Granted rather than the Access Handler, as grants
+// are extended through a synchronous API.
+type GrantExtended struct {
+	Grant types.Grant `json:"grant"`
+	// Actor is the user who approved the extension,
+	// or the requestor if it was approved automatically.
+	Actor string `json:"actor"`
+}
+
+func (GrantExtended) EventType() string {
+	return GrantExtendedType
+}
+
 // GrantFailed is emitted when the access handler
 // encounters an unrecoverable error when activating
 // or deactivating a grant.
@@ -79,6 +98,31 @@ func (GrantFailed) EventType() string {
 	return GrantFailedType
 }
 
+// GrantDrifted is emitted when the grant reconciler
+// finds a grant which doesn't match its provider,
+// such as an EXPIRED grant which is still provisioned,
+// or an ACTIVE grant which was removed in the provider directly.
+//
+// Like GrantRevoked, this event is emitted by Granted
+// rather than the Access Handler.
+type GrantDrifted struct {
+	Grant types.Grant                    `json:"grant"`
+	Drift types.GrantReconciliationDrift `json:"drift"`
+	// Remediated is true if access was provisioned
+	// again for an ACTIVE grant which was missing,
+	// or removed for an EXPIRED grant which was still
+	// provisioned. EXPIRED grants are only removed if
+	// the reconciler is configured to revoke drift.
+	Remediated bool `json:"remediated"`
+	// Error contains details about why the drift
+	// couldn't be remediated.
+	Error *string `json:"error,omitempty"`
+}
+
+func (GrantDrifted) EventType() string {
+	return GrantDriftedType
+}
+
 // GrantEventPayload is a payload which is common to
 // all Grant events. It is used to conveniently unmarshal
 // the Grant payloads in our event handler code.
@@ -3,10 +3,15 @@ package gevent
 import "github.com/common-fate/granted-approvals/pkg/access"
 
 const (
-	RequestCreatedType   = "request.created"
-	RequestApprovedType  = "request.approved"
-	RequestCancelledType = "request.cancelled"
-	RequestDeclinedType  = "request.declined"
+	RequestCreatedType          = "request.created"
+	RequestApprovedType         = "request.approved"
+	RequestCancelledType        = "request.cancelled"
+	RequestDeclinedType         = "request.declined"
+	RequestAdvancedType         = "request.advanced"
+	RequestBreakGlassType       = "request.breakglass"
+	RequestExpiredType          = "request.expired"
+	RequestCommentedType        = "request.commented"
+	RequestExtensionPendingType = "request.extensionpending"
 )
 
 // RequestCreated is emitted when a user requests access
@@ -45,6 +50,62 @@ func (RequestDeclined) EventType() string {
 	return RequestDeclinedType
 }
 
+// RequestAdvanced is emitted when an approval stage of a
+// request is completed and the reviewers for the next
+// stage have been added to the request.
+type RequestAdvanced struct {
+	Request access.Request `json:"request"`
+}
+
+func (RequestAdvanced) EventType() string {
+	return RequestAdvancedType
+}
+
+// RequestBreakGlass is emitted when a user uses break-glass
+// access. Access is granted immediately and the request
+// must be reviewed retrospectively by an approver.
+type RequestBreakGlass struct {
+	Request access.Request `json:"request"`
+}
+
+func (RequestBreakGlass) EventType() string {
+	return RequestBreakGlassType
+}
+
+// RequestExpired is emitted when a pending request
+// isn't reviewed before the pending timeout of its Access Rule.
+type RequestExpired struct {
+	Request access.Request `json:"request"`
+}
+
+func (RequestExpired) EventType() string {
+	return RequestExpiredType
+}
+
+// RequestCommented is emitted when a user comments
+// on a request.
+type RequestCommented struct {
+	Request access.Request `json:"request"`
+	Comment access.Comment `json:"comment"`
+}
+
+func (RequestCommented) EventType() string {
+	return RequestCommentedType
+}
+
+// RequestExtensionPending is emitted when a user asks to extend
+// their access and the extension must be reviewed. It is emitted again
+// each time the extension moves on to the next approval stage.
+type RequestExtensionPending struct {
+	Request access.Request `json:"request"`
+	// Reviewers holds the IDs of the users who can review the current approval stage of the extension.
+	Reviewers []string `json:"reviewers"`
+}
+
+func (RequestExtensionPending) EventType() string {
+	return RequestExtensionPendingType
+}
+
 // RequestEventPayload is a payload which is common to
 // all Request events. It is used to conveniently unmarshal
 // the Request payloads in our event handler code.
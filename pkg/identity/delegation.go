@@ -0,0 +1,42 @@
+package identity
+
+import (
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// Delegation allows a user to nominate someone to review requests on their behalf
+// while they are away. A user has at most one delegation.
+type Delegation struct {
+	// UserID is the user whose reviews are delegated.
+	UserID string `json:"userId" dynamodbav:"userId"`
+	// DelegateID is the user who reviews requests on behalf of UserID.
+	DelegateID string    `json:"delegateId" dynamodbav:"delegateId"`
+	Start      time.Time `json:"start" dynamodbav:"start"`
+	End        time.Time `json:"end" dynamodbav:"end"`
+}
+
+// IsActive returns true if the delegation applies at the given time.
+func (d *Delegation) IsActive(now time.Time) bool {
+	return !now.Before(d.Start) && now.Before(d.End)
+}
+
+func (d *Delegation) ToAPI() types.Delegation {
+	return types.Delegation{
+		User:     d.UserID,
+		Delegate: d.DelegateID,
+		Start:    d.Start,
+		End:      d.End,
+	}
+}
+
+func (d *Delegation) DDBKeys() (ddb.Keys, error) {
+	keys := ddb.Keys{
+		PK: keys.UserDelegation.PK1,
+		SK: keys.UserDelegation.SK1(d.UserID),
+	}
+	return keys, nil
+}
@@ -4,10 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/notifiers"
+	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/pkg/errors"
 	"github.com/slack-go/slack"
 	"go.uber.org/zap"
 )
@@ -19,7 +25,13 @@ func (n *Notifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger,
 		return err
 	}
 
-	gq := storage.GetRequest{ID: grantEvent.Grant.ID}
+	requestID, target := access.ParseGrantID(grantEvent.Grant.ID)
+	// users are notified about the grant for the rule's target, which covers the whole bundle for rules with additional targets.
+	// Failures for the additional targets are still sent, as the user may not have all of the access they requested.
+	if target > 0 && event.DetailType != gevent.GrantFailedType && event.DetailType != gevent.GrantDriftedType {
+		return nil
+	}
+	gq := storage.GetRequest{ID: requestID}
 	_, err = n.DB.Query(ctx, &gq)
 	if err != nil {
 		return err
@@ -29,6 +41,9 @@ func (n *Notifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger,
 	if err != nil {
 		return err
 	}
+	if event.DetailType == gevent.GrantDriftedType {
+		return n.sendGrantDrifted(ctx, log, slackClient, event, *gq.Result, *rq.Result)
+	}
 	var msg string
 	var fallback string
 	// get the message text based on the event type
@@ -45,6 +60,12 @@ func (n *Notifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger,
 	case gevent.GrantRevokedType:
 		msg = fmt.Sprintf("Your access to *%s* has been cancelled by your administrator. Please contact your cloud administrator for more information.", rq.Result.Name)
 		fallback = fmt.Sprintf("Your access to %s has been cancelled by your administrator", rq.Result.Name)
+	case gevent.GrantExtendedType:
+		end := gq.Result.Grant.End.Format(time.RFC1123)
+		// Slack formats the date in the user's timezone
+		slackEnd := fmt.Sprintf("<!date^%d^{date_short_pretty} at {time}|%s>", gq.Result.Grant.End.Unix(), end)
+		msg = fmt.Sprintf("Your access to *%s* has been extended until %s.", rq.Result.Name, slackEnd)
+		fallback = fmt.Sprintf("Your access to %s has been extended until %s", rq.Result.Name, end)
 	default:
 		zap.S().Infow("unhandled grant event", "detailType", event.DetailType)
 	}
@@ -54,3 +75,58 @@ func (n *Notifier) HandleGrantEvent(ctx context.Context, log *zap.SugaredLogger,
 	}
 	return nil
 }
+
+// sendGrantDrifted messages the owners of the access rule about a grant which doesn't match its provider, as they manage the access it provides.
+// Rules without owners are left to administrators, who can see the drift in the audit log of the request.
+func (n *Notifier) sendGrantDrifted(ctx context.Context, log *zap.SugaredLogger, slackClient *slack.Client, event events.CloudWatchEvent, req access.Request, rule rule.AccessRule) error {
+	var drifted gevent.GrantDrifted
+	err := json.Unmarshal(event.Detail, &drifted)
+	if err != nil {
+		return err
+	}
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, req.ID)
+	if err != nil {
+		return errors.Wrap(err, "building review URL")
+	}
+
+	subject := string(drifted.Grant.Subject)
+	var msg, fallback string
+	switch {
+	case drifted.Drift == ahTypes.MISSING && drifted.Remediated:
+		msg = fmt.Sprintf("%s's access to *%s* was removed in the provider while their grant was active, so it has been provisioned again. <%s|View request>", subject, rule.Name, reviewURL.Review)
+		fallback = fmt.Sprintf("%s's access to %s was removed in the provider and has been provisioned again", subject, rule.Name)
+	case drifted.Drift == ahTypes.MISSING:
+		msg = fmt.Sprintf("%s's access to *%s* was removed in the provider while their grant is active. <%s|View request>", subject, rule.Name, reviewURL.Review)
+		fallback = fmt.Sprintf("%s's access to %s was removed in the provider while their grant is active", subject, rule.Name)
+	case drifted.Drift == ahTypes.NOTREMOVED:
+		msg = fmt.Sprintf("%s still has access to *%s* in the provider after their grant expired. It hasn't been removed automatically, as the access may have been provisioned outside of Granted. Please check whether they should still have it. <%s|View request>", subject, rule.Name, reviewURL.Review)
+		fallback = fmt.Sprintf("%s still has access to %s after their grant expired", subject, rule.Name)
+	default:
+		log.Infow("unhandled grant drift", "drift", drifted.Drift)
+		return nil
+	}
+	if drifted.Error != nil {
+		msg += fmt.Sprintf("\n\nThe access couldn't be provisioned again: %s", *drifted.Error)
+	}
+
+	owners := map[string]bool{}
+	for _, u := range rule.Owners.Users {
+		owners[u] = true
+	}
+	for _, g := range rule.Owners.Groups {
+		gq := storage.GetGroup{ID: g}
+		_, err = n.DB.Query(ctx, &gq)
+		if err != nil {
+			log.Errorw("failed to get access rule owner group", "group.id", g, zap.Error(err))
+			continue
+		}
+		for _, u := range gq.Result.Users {
+			owners[u] = true
+		}
+	}
+	log.Infow("messaging access rule owners about grant drift", "owners", owners)
+	for u := range owners {
+		_ = n.SendDMWithLogOnError(ctx, slackClient, log, u, msg, fallback)
+	}
+	return nil
+}
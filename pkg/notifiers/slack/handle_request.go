@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/notifiers"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
 	"github.com/pkg/errors"
 	"github.com/slack-go/slack"
 	"go.uber.org/zap"
@@ -42,7 +44,17 @@ func (n *Notifier) HandleRequestEvent(ctx context.Context, log *zap.SugaredLogge
 
 	switch event.DetailType {
 	case gevent.RequestCreatedType:
-		if ruleQuery.Result.Approval.IsRequired() {
+		if delegator := n.delegatorEmail(ctx, log, req); delegator != "" {
+			msg := fmt.Sprintf("%s has requested access to *%s* on your behalf.", delegator, ruleQuery.Result.Name)
+			fallback := fmt.Sprintf("%s has requested access to %s on your behalf.", delegator, ruleQuery.Result.Name)
+			_ = n.SendDMWithLogOnError(ctx, slackClient, log, req.RequestedBy, msg, fallback)
+		}
+		// approval policies can auto-approve some requests for a rule which otherwise requires review.
+		reviewRequired := ruleQuery.Result.Approval.IsRequired()
+		if req.ApprovalMethod != nil {
+			reviewRequired = *req.ApprovalMethod == types.REVIEWED
+		}
+		if reviewRequired {
 			msg := fmt.Sprintf("Your request to access *%s* requires approval. We've notified the approvers and will let you know once your request has been reviewed.", ruleQuery.Result.Name)
 			fallback := fmt.Sprintf("Your request to access %s requires approval.", ruleQuery.Result.Name)
 
@@ -52,87 +64,64 @@ func (n *Notifier) HandleRequestEvent(ctx context.Context, log *zap.SugaredLogge
 			}
 
 			// Notify approvers
-			reviewURL, err := notifiers.ReviewURL(n.FrontendURL, req.ID)
-			if err != nil {
-				return errors.Wrap(err, "building review URL")
-			}
-
-			// get the requestor's Slack user ID if it exists to render it nicely in the message to approvers.
-			var slackUserID string
-			requestor, err := slackClient.GetUserByEmailContext(ctx, userQuery.Result.Email)
-			if err != nil {
-				zap.S().Infow("couldn't get slack user from requestor - falling back to email address", "requestor.id", userQuery.Result.ID, zap.Error(err))
-			}
-			if requestor != nil {
-				slackUserID = requestor.ID
-			}
-
-			var wg sync.WaitGroup
-
 			reviewers := storage.ListRequestReviewers{RequestID: req.ID}
 			_, err = n.DB.Query(ctx, &reviewers)
-
 			if err != nil {
 				return errors.Wrap(err, "getting reviewers")
 			}
-
-			log.Infow("messaging reviewers", "reviewers", reviewers)
-
-			for _, usr := range reviewers.Result {
-				if usr.ReviewerID == req.RequestedBy {
-					log.Infow("skipping sending approval message to requestor", "user.id", usr)
-					continue
-				}
-
-				wg.Add(1)
-				go func(usr access.Reviewer) {
-					defer wg.Done()
-					approver := storage.GetUser{ID: usr.ReviewerID}
-					_, err := n.DB.Query(ctx, &approver)
-					if err != nil {
-						log.Errorw("failed to fetch user by id while trying to send message in slack", "user.id", usr, zap.Error(err))
-						return
-					}
-
-					summary, msg := BuildRequestMessage(RequestMessageOpts{
-						Request:          req,
-						Rule:             rule,
-						RequestorSlackID: slackUserID,
-						RequestorEmail:   userQuery.Result.Email,
-						ReviewURLs:       reviewURL,
-					})
-
-					ts, err := SendMessageBlocks(ctx, slackClient, approver.Result.Email, msg, summary)
-					if err != nil {
-						log.Errorw("failed to send request approval message", "user", usr, zap.Error(err))
-					}
-
-					updatedUsr := usr
-					updatedUsr.Notifications = access.Notifications{
-						SlackMessageID: &ts,
-					}
-					log.Infow("updating reviewer with slack msg id", "updatedUsr.SlackMessageID", ts)
-
-					err = n.DB.Put(ctx, &updatedUsr)
-
-					if err != nil {
-						log.Errorw("failed to update reviewer", "user", usr, zap.Error(err))
-					}
-				}(usr)
+			err = n.sendReviewRequests(ctx, slackClient, log, req, rule, userQuery.Result, reviewers.Result)
+			if err != nil {
+				return err
 			}
-
-			wg.Wait()
 		} else {
 			//Review not required
 			msg := fmt.Sprintf(":white_check_mark: Your request to access *%s* has been automatically approved. Hang tight - we're provisioning the role now and will let you know when it's ready.", ruleQuery.Result.Name)
 			fallback := fmt.Sprintf("Your request to access %s has been automatically approved.", ruleQuery.Result.Name)
 			_ = n.SendDMWithLogOnError(ctx, slackClient, log, req.RequestedBy, msg, fallback)
 		}
-	case gevent.RequestApprovedType:
-		msg := fmt.Sprintf("Your request to access *%s* has been approved. Hang tight - we're provisioning the access now and will let you know when it's ready.", ruleQuery.Result.Name)
-		fallback := fmt.Sprintf("Your request to access %s has been approved.", ruleQuery.Result.Name)
+	case gevent.RequestBreakGlassType:
+		msg := fmt.Sprintf(":rotating_light: You have used break-glass access for *%s*. Hang tight - we're provisioning the access now. The approvers have been notified and will review your request retrospectively.", ruleQuery.Result.Name)
+		fallback := fmt.Sprintf("You have used break-glass access for %s.", ruleQuery.Result.Name)
 		_ = n.SendDMWithLogOnError(ctx, slackClient, log, req.RequestedBy, msg, fallback)
 
+		// every approver on the rule is asked to review the break-glass request
+		reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil {
+			return errors.Wrap(err, "getting reviewers")
+		}
+		err = n.sendReviewRequests(ctx, slackClient, log, req, rule, userQuery.Result, reviewers.Result)
+		if err != nil {
+			return err
+		}
+	case gevent.RequestAdvancedType:
+		// the request has moved on to the next approval stage, so notify the reviewers for that stage.
+		reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil {
+			return errors.Wrap(err, "getting reviewers")
+		}
+		var stageReviewers []access.Reviewer
+		for _, rev := range reviewers.Result {
+			if rev.ApprovalStage == req.ApprovalStage {
+				stageReviewers = append(stageReviewers, rev)
+			}
+		}
+		err = n.sendReviewRequests(ctx, slackClient, log, req, rule, userQuery.Result, stageReviewers)
+		if err != nil {
+			return err
+		}
+	case gevent.RequestApprovedType:
+		if isBreakGlass(req) {
+			msg := fmt.Sprintf("Your break-glass access to *%s* has been reviewed and approved.", ruleQuery.Result.Name)
+			fallback := fmt.Sprintf("Your break-glass access to %s has been approved.", ruleQuery.Result.Name)
+			_ = n.SendDMWithLogOnError(ctx, slackClient, log, req.RequestedBy, msg, fallback)
+		} else {
+			msg := fmt.Sprintf("Your request to access *%s* has been approved. Hang tight - we're provisioning the access now and will let you know when it's ready.", ruleQuery.Result.Name)
+			fallback := fmt.Sprintf("Your request to access %s has been approved.", ruleQuery.Result.Name)
+			_ = n.SendDMWithLogOnError(ctx, slackClient, log, req.RequestedBy, msg, fallback)
+		}
+
 		// Loop over the request reviewers
 		reviewers := storage.ListRequestReviewers{RequestID: req.ID}
 		_, err = n.DB.Query(ctx, &reviewers)
@@ -165,11 +154,90 @@ func (n *Notifier) HandleRequestEvent(ctx context.Context, log *zap.SugaredLogge
 				log.Errorw("failed to update slack message", "user", usr, zap.Error(err))
 			}
 		}
-	case gevent.RequestDeclinedType:
-		msg := fmt.Sprintf("Your request to access *%s* has been declined.", ruleQuery.Result.Name)
-		fallback := fmt.Sprintf("Your request to access %s has been declined.", ruleQuery.Result.Name)
+	case gevent.RequestExpiredType:
+		msg := fmt.Sprintf("Your request to access *%s* has expired because it wasn't reviewed in time. You can make a new request if you still need access.", ruleQuery.Result.Name)
+		fallback := fmt.Sprintf("Your request to access %s has expired.", ruleQuery.Result.Name)
 		_ = n.SendDMWithLogOnError(ctx, slackClient, log, req.RequestedBy, msg, fallback)
 
+		// update the reviewers' messages so that the request can no longer be reviewed from Slack
+		reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+		_, err = n.DB.Query(ctx, &reviewers)
+		if err != nil {
+			return errors.Wrap(err, "getting reviewers")
+		}
+
+		log.Infow("messaging reviewers", "reviewers", reviewers.Result)
+
+		for _, usr := range reviewers.Result {
+			err := n.UpdateSlackMessage(ctx, slackClient, log, usr, req, rule, userQuery.Result)
+			if err != nil {
+				log.Errorw("failed to update slack message", "user", usr, zap.Error(err))
+			}
+		}
+	case gevent.RequestCommentedType:
+		var commented gevent.RequestCommented
+		err = json.Unmarshal(event.Detail, &commented)
+		if err != nil {
+			return err
+		}
+		c := commented.Comment
+		commenter := storage.GetUser{ID: c.UserID}
+		_, err = n.DB.Query(ctx, &commenter)
+		if err != nil {
+			return errors.Wrap(err, "getting commenter")
+		}
+		msg := fmt.Sprintf("%s commented on the request to access *%s*:\n>%s", commenter.Result.Email, ruleQuery.Result.Name, c.Body)
+		fallback := fmt.Sprintf("%s commented on the request to access %s.", commenter.Result.Email, ruleQuery.Result.Name)
+
+		// comments from the requestor, or from the user who made the request on their behalf, are relayed to the reviewers.
+		// The requestor and the user who delegated the request are told about comments from anyone else, including each other.
+		var recipients []string
+		if req.IsRequestor(c.UserID) {
+			reviewers := storage.ListRequestReviewers{RequestID: req.ID}
+			_, err = n.DB.Query(ctx, &reviewers)
+			if err != nil {
+				return errors.Wrap(err, "getting reviewers")
+			}
+			for _, rev := range reviewers.Result {
+				recipients = append(recipients, rev.ReviewerID)
+			}
+		}
+		recipients = append(recipients, req.RequestedBy)
+		if req.DelegatedBy != nil {
+			recipients = append(recipients, *req.DelegatedBy)
+		}
+		for _, userID := range recipients {
+			if userID == c.UserID {
+				continue
+			}
+			_ = n.SendDMWithLogOnError(ctx, slackClient, log, userID, msg, fallback)
+		}
+	case gevent.RequestExtensionPendingType:
+		var pending gevent.RequestExtensionPending
+		err = json.Unmarshal(event.Detail, &pending)
+		if err != nil {
+			return err
+		}
+		reviewURL, err := notifiers.ReviewURL(n.FrontendURL, req.ID)
+		if err != nil {
+			return errors.Wrap(err, "building review URL")
+		}
+		msg := fmt.Sprintf("%s has asked to extend their access to *%s* by %s. <%s|Review the extension>", userQuery.Result.Email, ruleQuery.Result.Name, req.Extension.Duration, reviewURL.Review)
+		fallback := fmt.Sprintf("%s has asked to extend their access to %s.", userQuery.Result.Email, ruleQuery.Result.Name)
+		for _, userID := range pending.Reviewers {
+			_ = n.SendDMWithLogOnError(ctx, slackClient, log, userID, msg, fallback)
+		}
+	case gevent.RequestDeclinedType:
+		if isBreakGlass(req) {
+			msg := fmt.Sprintf("Your break-glass access to *%s* has been declined in review. Any remaining access has been revoked.", ruleQuery.Result.Name)
+			fallback := fmt.Sprintf("Your break-glass access to %s has been declined.", ruleQuery.Result.Name)
+			_ = n.SendDMWithLogOnError(ctx, slackClient, log, req.RequestedBy, msg, fallback)
+		} else {
+			msg := fmt.Sprintf("Your request to access *%s* has been declined.", ruleQuery.Result.Name)
+			fallback := fmt.Sprintf("Your request to access %s has been declined.", ruleQuery.Result.Name)
+			_ = n.SendDMWithLogOnError(ctx, slackClient, log, req.RequestedBy, msg, fallback)
+		}
+
 		// Loop over the request reviewers
 		reviewers := storage.ListRequestReviewers{RequestID: req.ID}
 		_, err = n.DB.Query(ctx, &reviewers)
@@ -189,12 +257,124 @@ func (n *Notifier) HandleRequestEvent(ctx context.Context, log *zap.SugaredLogge
 	return nil
 }
 
+// isBreakGlass returns true if the request was made using break-glass access.
+func isBreakGlass(req access.Request) bool {
+	return req.ApprovalMethod != nil && *req.ApprovalMethod == types.BREAKGLASS
+}
+
+// delegatorEmail returns the email address of the user who made a request on behalf of the requestor.
+// It returns an empty string if the request wasn't delegated, or if the user can't be found.
+func (n *Notifier) delegatorEmail(ctx context.Context, log *zap.SugaredLogger, req access.Request) string {
+	if req.DelegatedBy == nil {
+		return ""
+	}
+	q := storage.GetUser{ID: *req.DelegatedBy}
+	_, err := n.DB.Query(ctx, &q)
+	if err != nil {
+		log.Errorw("failed to get user who delegated the request", "user.id", *req.DelegatedBy, zap.Error(err))
+		return ""
+	}
+	return q.Result.Email
+}
+
+// onBehalfOfEmail returns the email address of the approver a reviewer is a delegate for.
+// It returns an empty string if the reviewer isn't a delegate, or if the approver can't be found.
+func (n *Notifier) onBehalfOfEmail(ctx context.Context, log *zap.SugaredLogger, rev access.Reviewer) string {
+	if rev.OnBehalfOf == nil {
+		return ""
+	}
+	q := storage.GetUser{ID: *rev.OnBehalfOf}
+	_, err := n.DB.Query(ctx, &q)
+	if err != nil {
+		log.Errorw("failed to get approver who delegated their reviews", "user.id", *rev.OnBehalfOf, zap.Error(err))
+		return ""
+	}
+	return q.Result.Email
+}
+
+// sendReviewRequests messages each reviewer asking them to review the request,
+// and saves the Slack message ID against the reviewer so that the message can be updated later.
+func (n *Notifier) sendReviewRequests(ctx context.Context, slackClient *slack.Client, log *zap.SugaredLogger, req access.Request, rule rule.AccessRule, dbRequestor *identity.User, reviewers []access.Reviewer) error {
+	reviewURL, err := notifiers.ReviewURL(n.FrontendURL, req.ID)
+	if err != nil {
+		return errors.Wrap(err, "building review URL")
+	}
+
+	// get the requestor's Slack user ID if it exists to render it nicely in the message to approvers.
+	var slackUserID string
+	requestor, err := slackClient.GetUserByEmailContext(ctx, dbRequestor.Email)
+	if err != nil {
+		zap.S().Infow("couldn't get slack user from requestor - falling back to email address", "requestor.id", dbRequestor.ID, zap.Error(err))
+	}
+	if requestor != nil {
+		slackUserID = requestor.ID
+	}
+
+	delegator := n.delegatorEmail(ctx, log, req)
+
+	var wg sync.WaitGroup
+
+	log.Infow("messaging reviewers", "reviewers", reviewers)
+
+	for _, usr := range reviewers {
+		if usr.ReviewerID == req.RequestedBy {
+			log.Infow("skipping sending approval message to requestor", "user.id", usr)
+			continue
+		}
+
+		wg.Add(1)
+		go func(usr access.Reviewer) {
+			defer wg.Done()
+			approver := storage.GetUser{ID: usr.ReviewerID}
+			_, err := n.DB.Query(ctx, &approver)
+			if err != nil {
+				log.Errorw("failed to fetch user by id while trying to send message in slack", "user.id", usr, zap.Error(err))
+				return
+			}
+
+			summary, msg := BuildRequestMessage(RequestMessageOpts{
+				Request:          req,
+				Rule:             rule,
+				RequestorSlackID: slackUserID,
+				RequestorEmail:   dbRequestor.Email,
+				DelegatorEmail:   delegator,
+				OnBehalfOfEmail:  n.onBehalfOfEmail(ctx, log, usr),
+				ReviewURLs:       reviewURL,
+			})
+
+			ts, err := SendMessageBlocks(ctx, slackClient, approver.Result.Email, msg, summary)
+			if err != nil {
+				log.Errorw("failed to send request approval message", "user", usr, zap.Error(err))
+			}
+
+			updatedUsr := usr
+			updatedUsr.Notifications = access.Notifications{
+				SlackMessageID: &ts,
+			}
+			log.Infow("updating reviewer with slack msg id", "updatedUsr.SlackMessageID", ts)
+
+			err = n.DB.Put(ctx, &updatedUsr)
+
+			if err != nil {
+				log.Errorw("failed to update reviewer", "user", usr, zap.Error(err))
+			}
+		}(usr)
+	}
+
+	wg.Wait()
+	return nil
+}
+
 func (n *Notifier) UpdateSlackMessage(ctx context.Context, slackClient *slack.Client, log *zap.SugaredLogger, rev access.Reviewer, req access.Request, rule rule.AccessRule, dbRequestor *identity.User) error {
 
 	// Skip if requestor == reviewer
 	if rev.ReviewerID == req.RequestedBy {
 		return nil
 	}
+	// Skip reviewers who were never sent a message, such as approvers for a later approval stage
+	if rev.Notifications.SlackMessageID == nil {
+		return nil
+	}
 
 	// Get the reviewers email from db
 	reviewerQuery := storage.GetUser{ID: rev.ReviewerID}
@@ -223,6 +403,8 @@ func (n *Notifier) UpdateSlackMessage(ctx context.Context, slackClient *slack.Cl
 		Rule:             rule,
 		RequestorSlackID: slackUserID,
 		RequestorEmail:   dbRequestor.Email,
+		DelegatorEmail:   n.delegatorEmail(ctx, log, req),
+		OnBehalfOfEmail:  n.onBehalfOfEmail(ctx, log, rev),
 		ReviewURLs:       reviewURL,
 		Reviewer:         reviewerQuery.Result,
 	})
@@ -241,7 +423,11 @@ type RequestMessageOpts struct {
 	ReviewURLs       notifiers.ReviewURLs
 	RequestorSlackID string
 	RequestorEmail   string
-	Reviewer         *identity.User
+	// DelegatorEmail is the email of the user who made the request on behalf of the requestor, if the request was delegated.
+	DelegatorEmail string
+	// OnBehalfOfEmail is the email of the approver the reviewer is a delegate for, if the reviewer is a delegate.
+	OnBehalfOfEmail string
+	Reviewer        *identity.User
 }
 
 func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Message) {
@@ -251,6 +437,11 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 	}
 
 	summary = fmt.Sprintf("New request for %s from %s", o.Rule.Name, o.RequestorEmail)
+	heading := fmt.Sprintf("*<%s|New request for %s> from %s*", o.ReviewURLs.Review, o.Rule.Name, requestor)
+	if isBreakGlass(o.Request) {
+		summary = fmt.Sprintf("Break-glass access to %s used by %s", o.Rule.Name, o.RequestorEmail)
+		heading = fmt.Sprintf(":rotating_light: *<%s|Break-glass access to %s> used by %s* - access has already been granted and needs a retrospective review", o.ReviewURLs.Review, o.Rule.Name, requestor)
+	}
 
 	when := "ASAP"
 	if o.Request.RequestedTiming.StartTime != nil {
@@ -258,7 +449,7 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 		when = fmt.Sprintf("<!date^%d^{date_short_pretty} at {time}|%s>", t.Unix(), t.String())
 	}
 
-	status := strings.ToLower(string(o.Request.Status))
+	status := strings.ReplaceAll(strings.ToLower(string(o.Request.Status)), "_", " ")
 	status = strings.ToUpper(string(status[0])) + status[1:]
 
 	requestDetails := []*slack.TextBlockObject{
@@ -276,6 +467,20 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 		},
 	}
 
+	if o.DelegatorEmail != "" {
+		requestDetails = append(requestDetails, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*Requested by:*\n%s", o.DelegatorEmail),
+		})
+	}
+
+	if o.OnBehalfOfEmail != "" {
+		requestDetails = append(requestDetails, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*Reviewing on behalf of:*\n%s", o.OnBehalfOfEmail),
+		})
+	}
+
 	// Only show the Request reason if it is not empty
 	if o.Request.Data.Reason != nil && len(*o.Request.Data.Reason) > 0 {
 		requestDetails = append(requestDetails, &slack.TextBlockObject{
@@ -289,7 +494,7 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 			Type: slack.MBTSection,
 			Text: &slack.TextBlockObject{
 				Type: slack.MarkdownType,
-				Text: fmt.Sprintf("*<%s|New request for %s> from %s*", o.ReviewURLs.Review, o.Rule.Name, requestor),
+				Text: heading,
 			},
 		},
 		slack.SectionBlock{
@@ -298,6 +503,38 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 		},
 	)
 
+	// the request fields of the access rule and the target arguments chosen by the requestor
+	// are shown in their own sections, as Slack allows at most 10 fields in a section.
+	var requestFields []*slack.TextBlockObject
+	for _, f := range o.Request.Data.FieldValues(o.Rule.Fields) {
+		requestFields = append(requestFields, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*%s:*\n%s", f.Label, f.Value),
+		})
+	}
+	args := make([]string, 0, len(o.Request.Data.With))
+	for id := range o.Request.Data.With {
+		args = append(args, id)
+	}
+	sort.Strings(args)
+	for _, id := range args {
+		requestFields = append(requestFields, &slack.TextBlockObject{
+			Type: "mrkdwn",
+			Text: fmt.Sprintf("*%s:*\n%s", id, o.Request.Data.With[id]),
+		})
+	}
+	for len(requestFields) > 0 {
+		n := len(requestFields)
+		if n > 10 {
+			n = 10
+		}
+		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, slack.SectionBlock{
+			Type:   slack.MBTSection,
+			Fields: requestFields[:n],
+		})
+		requestFields = requestFields[n:]
+	}
+
 	if o.Reviewer != nil {
 
 		t := o.Reviewer.UpdatedAt
@@ -311,8 +548,8 @@ func BuildRequestMessage(o RequestMessageOpts) (summary string, msg slack.Messag
 		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, reviewContextBlock)
 	}
 
-	// If the request has just been sent (PENDING), then append Action Blocks
-	if o.Request.Status == access.PENDING {
+	// If the request has just been sent (PENDING) or is waiting on a retrospective review, then append Action Blocks
+	if o.Request.Status == access.PENDING || o.Request.Status == access.NEEDS_RETROSPECTIVE_REVIEW {
 		msg.Blocks.BlockSet = append(msg.Blocks.BlockSet, slack.NewActionBlock("review_actions",
 			slack.ButtonBlockElement{
 				Type:     slack.METButton,
@@ -31,6 +31,21 @@ type AccessRule struct {
 	Name            string                `json:"name" dynamodbav:"name"`
 	Target          Target                `json:"target" dynamodbav:"target"`
 	TimeConstraints types.TimeConstraints `json:"timeConstraints" dynamodbav:"timeConstraints"`
+	// AdditionalTargets are granted together with Target, for rules which grant a bundle of access.
+	// Like Target, they can't be changed once the rule is created.
+	AdditionalTargets []Target `json:"additionalTargets,omitempty" dynamodbav:"additionalTargets,omitempty"`
+	// BreakGlass allows users to request emergency access, which is granted immediately
+	// and reviewed by the rule's approvers afterwards.
+	BreakGlass bool `json:"breakGlass,omitempty" dynamodbav:"breakGlass,omitempty"`
+	// Fields are additional fields which users fill in when requesting access.
+	Fields []RequestField `json:"fields,omitempty" dynamodbav:"fields,omitempty"`
+	// UsageLimits restricts how many grants the rule can have active at once and how much each user can use it.
+	UsageLimits *types.UsageLimits `json:"usageLimits,omitempty" dynamodbav:"usageLimits,omitempty"`
+	// AdmissionPolicy is a CEL expression which is evaluated when a request is created,
+	// to allow it, deny it or require it to be reviewed.
+	AdmissionPolicy *string `json:"admissionPolicy,omitempty" dynamodbav:"admissionPolicy,omitempty"`
+	// Owners can manage the rule through the owner API without being administrators.
+	Owners Owners `json:"owners" dynamodbav:"owners"`
 }
 
 func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
@@ -52,8 +67,25 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 	} else {
 		approval.Users = make([]string, 0)
 	}
+	if a.Approval.RequiredApprovals > 0 {
+		approval.RequiredApprovals = &a.Approval.RequiredApprovals
+	}
+	if len(a.Approval.Stages) > 0 {
+		stages := make([]types.ApprovalStage, len(a.Approval.Stages))
+		for i, st := range a.Approval.Stages {
+			stages[i] = st.ToAPI()
+		}
+		approval.Stages = &stages
+	}
+	if len(a.Approval.Policies) > 0 {
+		policies := make([]types.ApprovalPolicy, len(a.Approval.Policies))
+		for i, p := range a.Approval.Policies {
+			policies[i] = p.ToAPI()
+		}
+		approval.Policies = &policies
+	}
 
-	return types.AccessRuleDetail{
+	detail := types.AccessRuleDetail{
 		ID:          a.ID,
 		Description: a.Description,
 		Name:        a.Name,
@@ -63,48 +95,71 @@ func (a AccessRule) ToAPIDetail() types.AccessRuleDetail {
 			UpdateMessage: a.Metadata.UpdateMessage,
 			CreatedBy:     a.Metadata.CreatedBy,
 			UpdatedBy:     a.Metadata.UpdatedBy,
+			RolledBackTo:  a.Metadata.RolledBackTo,
 		},
 		Groups: a.Groups,
+		Owners: a.Owners.ToAPI(),
 		TimeConstraints: types.TimeConstraints{
-			MaxDurationSeconds: a.TimeConstraints.MaxDurationSeconds,
+			MaxDurationSeconds:    a.TimeConstraints.MaxDurationSeconds,
+			PendingTimeoutSeconds: a.TimeConstraints.PendingTimeoutSeconds,
+			AllowedWindows:        a.TimeConstraints.AllowedWindows,
 		},
 		Approval: approval,
 
-		Target: types.AccessRuleTarget{
-			Provider: types.Provider{
-				Id:   a.Target.ProviderID,
-				Type: a.Target.ProviderType,
-			},
-			With: types.AccessRuleTarget_With{
-				AdditionalProperties: a.Target.With,
-			},
-		},
+		Target:            a.Target.ToAPI(),
+		AdditionalTargets: a.additionalTargetsToAPI(),
 
-		Status:    status,
-		Version:   a.Version,
-		IsCurrent: a.Current,
+		Status:          status,
+		Version:         a.Version,
+		IsCurrent:       a.Current,
+		Fields:          requestFieldsToAPI(a.Fields),
+		UsageLimits:     a.UsageLimits,
+		AdmissionPolicy: a.AdmissionPolicy,
+	}
+	if a.BreakGlass {
+		detail.BreakGlass = &a.BreakGlass
 	}
+	return detail
 }
+
+// Targets returns every target which is granted by the rule, starting with Target.
+func (a AccessRule) Targets() []Target {
+	return append([]Target{a.Target}, a.AdditionalTargets...)
+}
+
+func (a AccessRule) additionalTargetsToAPI() *[]types.AccessRuleTarget {
+	if len(a.AdditionalTargets) == 0 {
+		return nil
+	}
+	targets := make([]types.AccessRuleTarget, len(a.AdditionalTargets))
+	for i, t := range a.AdditionalTargets {
+		targets[i] = t.ToAPI()
+	}
+	return &targets
+}
+
 func (a AccessRule) ToAPI() types.AccessRule {
-	return types.AccessRule{
+	rule := types.AccessRule{
 		ID:          a.ID,
 		Version:     a.Version,
 		Description: a.Description,
 		Name:        a.Name,
 		TimeConstraints: types.TimeConstraints{
-			MaxDurationSeconds: a.TimeConstraints.MaxDurationSeconds,
-		},
-		Target: types.AccessRuleTarget{
-			Provider: types.Provider{
-				Id:   a.Target.ProviderID,
-				Type: a.Target.ProviderType,
-			},
-			With: types.AccessRuleTarget_With{
-				AdditionalProperties: a.Target.With,
-			},
+			MaxDurationSeconds:    a.TimeConstraints.MaxDurationSeconds,
+			PendingTimeoutSeconds: a.TimeConstraints.PendingTimeoutSeconds,
+			AllowedWindows:        a.TimeConstraints.AllowedWindows,
 		},
-		IsCurrent: a.Current,
+		Target:            a.Target.ToAPI(),
+		AdditionalTargets: a.additionalTargetsToAPI(),
+		IsCurrent:         a.Current,
+		Fields:            requestFieldsToAPI(a.Fields),
+		UsageLimits:       a.UsageLimits,
+		AdmissionPolicy:   a.AdmissionPolicy,
 	}
+	if a.BreakGlass {
+		rule.BreakGlass = &a.BreakGlass
+	}
+	return rule
 }
 
 // AccessRuleMetadata defines model for AccessRuleMetadata.
@@ -117,6 +172,9 @@ type AccessRuleMetadata struct {
 	UpdatedAt      time.Time               `json:"updatedAt" dynamodbav:"updatedAt"`
 	// userID
 	UpdatedBy string `json:"updatedBy" dynamodbav:"updatedBy"`
+	// RolledBackTo is set if this version was created by rolling the rule back.
+	// It is the ID of the earlier version which this version copies.
+	RolledBackTo *string `json:"rolledBackTo,omitempty" dynamodbav:"rolledBackTo,omitempty"`
 }
 
 // Approver config for access rules
@@ -126,10 +184,93 @@ type Approval struct {
 	//List of users ids represents the individual users who may approve requests for this rule.
 	// This does not represent members of the approval groups
 	Users []string `json:"users" dynamodbav:"users"`
+	// RequiredApprovals is the number of distinct approving reviews needed before access is granted.
+	// If this is not set, a single approving review is enough.
+	RequiredApprovals int `json:"requiredApprovals,omitempty" dynamodbav:"requiredApprovals,omitempty"`
+	// Stages is an ordered approval chain.
+	// When stages are set, Groups, Users and RequiredApprovals are not used.
+	Stages []ApprovalStage `json:"stages,omitempty" dynamodbav:"stages,omitempty"`
+	// Policies are evaluated in order when a request is created, and the first one which matches
+	// decides whether the request is approved automatically or needs review.
+	Policies []ApprovalPolicy `json:"policies,omitempty" dynamodbav:"policies,omitempty"`
+}
+
+// ApprovalStage is a single step in a sequential approval chain.
+// Reviewers for a stage are only added to a request once the previous stage has been approved.
+type ApprovalStage struct {
+	Groups            []string `json:"groups" dynamodbav:"groups"`
+	Users             []string `json:"users" dynamodbav:"users"`
+	RequiredApprovals int      `json:"requiredApprovals,omitempty" dynamodbav:"requiredApprovals,omitempty"`
+}
+
+// ApprovalFromAPI converts the API approver config into an Approval.
+func ApprovalFromAPI(in types.ApproverConfig) Approval {
+	a := Approval{
+		Groups: in.Groups,
+		Users:  in.Users,
+	}
+	if in.RequiredApprovals != nil {
+		a.RequiredApprovals = *in.RequiredApprovals
+	}
+	if in.Stages != nil {
+		for _, st := range *in.Stages {
+			stage := ApprovalStage{
+				Groups: st.Groups,
+				Users:  st.Users,
+			}
+			if st.RequiredApprovals != nil {
+				stage.RequiredApprovals = *st.RequiredApprovals
+			}
+			a.Stages = append(a.Stages, stage)
+		}
+	}
+	a.Policies = ApprovalPoliciesFromAPI(in.Policies)
+	return a
 }
 
 func (a *Approval) IsRequired() bool {
-	return len(a.Users) > 0 || len(a.Groups) > 0
+	for _, st := range a.GetStages() {
+		if len(st.Users) > 0 || len(st.Groups) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStages returns the approval stages for the rule.
+// Rules without explicit stages have a single stage made up of the rule's approvers.
+func (a *Approval) GetStages() []ApprovalStage {
+	if len(a.Stages) > 0 {
+		return a.Stages
+	}
+	return []ApprovalStage{{Groups: a.Groups, Users: a.Users, RequiredApprovals: a.RequiredApprovals}}
+}
+
+// Threshold returns the number of approving reviews required to complete the stage.
+// It is always at least one.
+func (s *ApprovalStage) Threshold() int {
+	if s.RequiredApprovals < 1 {
+		return 1
+	}
+	return s.RequiredApprovals
+}
+
+func (s ApprovalStage) ToAPI() types.ApprovalStage {
+	stage := types.ApprovalStage{
+		Groups: s.Groups,
+		Users:  s.Users,
+	}
+	if stage.Groups == nil {
+		stage.Groups = make([]string, 0)
+	}
+	if stage.Users == nil {
+		stage.Users = make([]string, 0)
+	}
+	if s.RequiredApprovals > 0 {
+		r := s.RequiredApprovals
+		stage.RequiredApprovals = &r
+	}
+	return stage
 }
 
 // Provider defines model for Provider.
@@ -139,6 +280,127 @@ type Target struct {
 	ProviderID   string            `json:"providerId"  dynamodbav:"providerId"`
 	ProviderType string            `json:"providerType"  dynamodbav:"providerType"`
 	With         map[string]string `json:"with"  dynamodbav:"with"`
+	// WithSelectable are arguments which the requestor chooses a value for when they request access.
+	// The chosen values are saved on the request and combined with With when the grant is created.
+	WithSelectable map[string]SelectableArgument `json:"withSelectable,omitempty"  dynamodbav:"withSelectable,omitempty"`
+}
+
+// Arguments returns the arguments of the target combined with the values chosen for its selectable arguments.
+func (t Target) Arguments(selected map[string]string) map[string]string {
+	if len(selected) == 0 {
+		return t.With
+	}
+	with := make(map[string]string, len(t.With)+len(selected))
+	for k, v := range t.With {
+		with[k] = v
+	}
+	for k, v := range selected {
+		with[k] = v
+	}
+	return with
+}
+
+// SelectableArgument is the set of values which a requestor can choose from for an argument of a target.
+type SelectableArgument struct {
+	Values []string `json:"values,omitempty" dynamodbav:"values,omitempty"`
+	// AnyOption allows any of the options suggested by the provider for the argument, in addition to Values.
+	AnyOption bool `json:"anyOption,omitempty" dynamodbav:"anyOption,omitempty"`
+}
+
+// HasValue returns true if value is one of the Values of the argument.
+// Options suggested by the provider are not checked.
+func (a SelectableArgument) HasValue(value string) bool {
+	for _, v := range a.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (a SelectableArgument) ToAPI() types.SelectableArgument {
+	out := types.SelectableArgument{}
+	if len(a.Values) > 0 {
+		values := a.Values
+		out.Values = &values
+	}
+	if a.AnyOption {
+		anyOption := a.AnyOption
+		out.AnyOption = &anyOption
+	}
+	return out
+}
+
+func (t Target) ToAPI() types.AccessRuleTarget {
+	target := types.AccessRuleTarget{
+		Provider: types.Provider{
+			Id:   t.ProviderID,
+			Type: t.ProviderType,
+		},
+		With: types.AccessRuleTarget_With{
+			AdditionalProperties: t.With,
+		},
+	}
+	if len(t.WithSelectable) > 0 {
+		target.WithSelectable = &types.AccessRuleTarget_WithSelectable{
+			AdditionalProperties: t.selectableToAPI(),
+		}
+	}
+	return target
+}
+
+// ToCreateAPI returns the target in the form used to create an access rule.
+func (t Target) ToCreateAPI() types.CreateAccessRuleTarget {
+	target := types.CreateAccessRuleTarget{
+		ProviderId: t.ProviderID,
+		With:       types.CreateAccessRuleTarget_With{AdditionalProperties: t.With},
+	}
+	if len(t.WithSelectable) > 0 {
+		target.WithSelectable = &types.CreateAccessRuleTarget_WithSelectable{
+			AdditionalProperties: t.selectableToAPI(),
+		}
+	}
+	return target
+}
+
+func (t Target) selectableToAPI() map[string]types.SelectableArgument {
+	args := make(map[string]types.SelectableArgument, len(t.WithSelectable))
+	for id, a := range t.WithSelectable {
+		args[id] = a.ToAPI()
+	}
+	return args
+}
+
+// TargetFromAPI converts the API target into a Target.
+// The provider type isn't part of the API target, so it is left empty.
+func TargetFromAPI(in types.CreateAccessRuleTarget) Target {
+	t := Target{ProviderID: in.ProviderId, With: in.With.AdditionalProperties}
+	if in.WithSelectable != nil && len(in.WithSelectable.AdditionalProperties) > 0 {
+		t.WithSelectable = make(map[string]SelectableArgument, len(in.WithSelectable.AdditionalProperties))
+		for id, a := range in.WithSelectable.AdditionalProperties {
+			var arg SelectableArgument
+			if a.Values != nil {
+				arg.Values = *a.Values
+			}
+			if a.AnyOption != nil {
+				arg.AnyOption = *a.AnyOption
+			}
+			t.WithSelectable[id] = arg
+		}
+	}
+	return t
+}
+
+// TargetsFromAPI converts the API targets into Targets.
+func TargetsFromAPI(in *[]types.CreateAccessRuleTarget) []Target {
+	if in == nil {
+		return nil
+	}
+	var targets []Target
+	for _, t := range *in {
+		targets = append(targets, TargetFromAPI(t))
+	}
+	return targets
 }
 
 func (r *AccessRule) DDBKeys() (ddb.Keys, error) {
@@ -0,0 +1,86 @@
+package rule
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+)
+
+// AdmissionAction is the outcome of evaluating the admission policy of an access rule.
+type AdmissionAction string
+
+const (
+	AdmissionAllow  AdmissionAction = "allow"
+	AdmissionDeny   AdmissionAction = "deny"
+	AdmissionReview AdmissionAction = "review"
+)
+
+// AdmissionDecision is the result of an admission policy.
+type AdmissionDecision struct {
+	Action AdmissionAction
+	// Message explains the decision to the requestor. It is optional.
+	Message string
+}
+
+// admissionEnv declares the variables which admission policies can use.
+// The contents of each variable are documented on the admissionPolicy field of the API.
+var admissionEnv, admissionEnvErr = cel.NewEnv(
+	cel.Variable("requestor", cel.MapType(cel.StringType, cel.DynType)),
+	cel.Variable("request", cel.MapType(cel.StringType, cel.DynType)),
+	cel.Variable("rule", cel.MapType(cel.StringType, cel.DynType)),
+	cel.Variable("activeRequests", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+)
+
+// CompileAdmissionPolicy parses and type checks an admission policy expression.
+// The expression must evaluate to a bool, where false denies the request,
+// or to a map with a "decision" of "allow", "deny" or "review" and an optional "message".
+func CompileAdmissionPolicy(expr string) (cel.Program, error) {
+	if admissionEnvErr != nil {
+		return nil, admissionEnvErr
+	}
+	ast, iss := admissionEnv.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	// expressions which evaluate to dyn, such as a request field, are checked when they are evaluated.
+	out := ast.OutputType()
+	if out != cel.DynType && !cel.BoolType.IsAssignableType(out) && !cel.MapType(cel.StringType, cel.StringType).IsAssignableType(out) {
+		return nil, fmt.Errorf("the policy must evaluate to a bool or a map of strings, but evaluates to %s", out)
+	}
+	return admissionEnv.Program(ast)
+}
+
+// EvaluateAdmissionPolicy evaluates an admission policy expression against the variables.
+func EvaluateAdmissionPolicy(expr string, vars map[string]interface{}) (AdmissionDecision, error) {
+	prg, err := CompileAdmissionPolicy(expr)
+	if err != nil {
+		return AdmissionDecision{}, err
+	}
+	val, _, err := prg.Eval(vars)
+	if err != nil {
+		return AdmissionDecision{}, err
+	}
+
+	if allowed, ok := val.Value().(bool); ok {
+		if allowed {
+			return AdmissionDecision{Action: AdmissionAllow}, nil
+		}
+		return AdmissionDecision{Action: AdmissionDeny}, nil
+	}
+
+	res, err := val.ConvertToNative(reflect.TypeOf(map[string]string{}))
+	if err != nil {
+		return AdmissionDecision{}, fmt.Errorf("the policy must evaluate to a bool or a map of strings: %w", err)
+	}
+	m := res.(map[string]string)
+	d := AdmissionDecision{
+		Action:  AdmissionAction(m["decision"]),
+		Message: m["message"],
+	}
+	switch d.Action {
+	case AdmissionAllow, AdmissionDeny, AdmissionReview:
+		return d, nil
+	}
+	return AdmissionDecision{}, fmt.Errorf("unknown decision %q: must be one of allow, deny or review", m["decision"])
+}
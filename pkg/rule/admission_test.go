@@ -0,0 +1,98 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileAdmissionPolicy(t *testing.T) {
+	type testcase struct {
+		name    string
+		give    string
+		wantErr bool
+	}
+
+	testcases := []testcase{
+		{name: "bool", give: `"admins" in requestor.groups`},
+		{name: "map", give: `request.durationSeconds > 3600 ? {"decision": "review"} : {"decision": "allow"}`},
+		{name: "syntax error", give: `requestor.groups.exists(g,`, wantErr: true},
+		{name: "undeclared variable", give: `user.id == "a"`, wantErr: true},
+		{name: "dyn is checked when evaluated", give: `request.fields["decision"]`},
+		{name: "unsupported type", give: `size(requestor.groups)`, wantErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := CompileAdmissionPolicy(tc.give)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEvaluateAdmissionPolicy(t *testing.T) {
+	vars := map[string]interface{}{
+		"requestor": map[string]interface{}{"id": "usr_1", "email": "a@example.com", "groups": []string{"developers"}},
+		"request":   map[string]interface{}{"durationSeconds": int64(7200), "startTime": nil, "reason": "", "fields": map[string]string{}},
+		"rule":      map[string]interface{}{"id": "rul_1", "name": "Prod admin", "provider": "aws-prod", "with": map[string]string{}},
+		"activeRequests": []interface{}{
+			map[string]interface{}{"id": "req_1", "ruleId": "rul_2", "status": "APPROVED", "provider": "aws-prod", "with": map[string]string{}},
+		},
+	}
+
+	type testcase struct {
+		name    string
+		give    string
+		want    AdmissionDecision
+		wantErr bool
+	}
+
+	testcases := []testcase{
+		{
+			name: "true allows",
+			give: `"developers" in requestor.groups`,
+			want: AdmissionDecision{Action: AdmissionAllow},
+		},
+		{
+			name: "false denies",
+			give: `"admins" in requestor.groups`,
+			want: AdmissionDecision{Action: AdmissionDeny},
+		},
+		{
+			name: "review",
+			give: `request.durationSeconds > 3600 ? {"decision": "review"} : {"decision": "allow"}`,
+			want: AdmissionDecision{Action: AdmissionReview},
+		},
+		{
+			name: "deny if requestor holds another rule for the provider",
+			give: `activeRequests.exists(r, r.ruleId != rule.id && r.provider == rule.provider) ? {"decision": "deny", "message": "you already have prod access"} : {"decision": "allow"}`,
+			want: AdmissionDecision{Action: AdmissionDeny, Message: "you already have prod access"},
+		},
+		{
+			name:    "unknown decision",
+			give:    `{"decision": "maybe"}`,
+			wantErr: true,
+		},
+		{
+			name:    "runtime error",
+			give:    `request.missing == "a"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := EvaluateAdmissionPolicy(tc.give, vars)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
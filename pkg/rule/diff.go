@@ -0,0 +1,189 @@
+package rule
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// FieldChange is a difference in a single field between two versions of an access rule.
+type FieldChange struct {
+	// Field is the path of the field, such as "approval.users" or "target.with.groupId".
+	Field string
+	// From and To are the values of the field in each version. They are nil if the field isn't set.
+	From *string
+	To   *string
+	// Added and Removed are the items which changed for fields which are lists of IDs.
+	Added   []string
+	Removed []string
+}
+
+func (c FieldChange) ToAPI() types.AccessRuleFieldChange {
+	out := types.AccessRuleFieldChange{
+		Field: c.Field,
+		From:  c.From,
+		To:    c.To,
+	}
+	if len(c.Added) > 0 {
+		out.Added = &c.Added
+	}
+	if len(c.Removed) > 0 {
+		out.Removed = &c.Removed
+	}
+	return out
+}
+
+// DiffVersions returns the changes made to an access rule between two of its versions.
+// Metadata and the version ID aren't compared, so a version which is a rollback to another has no changes from it.
+func DiffVersions(from, to AccessRule) []FieldChange {
+	var d differ
+	d.value("name", &from.Name, &to.Name)
+	d.value("description", &from.Description, &to.Description)
+	d.value("status", strPtr(string(from.Status)), strPtr(string(to.Status)))
+	d.list("groups", from.Groups, to.Groups)
+	d.list("owners.users", from.Owners.Users, to.Owners.Users)
+	d.list("owners.groups", from.Owners.Groups, to.Owners.Groups)
+
+	d.list("approval.users", from.Approval.Users, to.Approval.Users)
+	d.list("approval.groups", from.Approval.Groups, to.Approval.Groups)
+	d.value("approval.requiredApprovals", intPtr(from.Approval.RequiredApprovals), intPtr(to.Approval.RequiredApprovals))
+	d.json("approval.stages", from.Approval.Stages, to.Approval.Stages)
+	d.json("approval.policies", from.Approval.Policies, to.Approval.Policies)
+
+	d.value("target.providerId", &from.Target.ProviderID, &to.Target.ProviderID)
+	for _, k := range mapKeys(from.Target.With, to.Target.With) {
+		d.value("target.with."+k, mapValue(from.Target.With, k), mapValue(to.Target.With, k))
+	}
+	d.json("target.withSelectable", from.Target.WithSelectable, to.Target.WithSelectable)
+	d.json("additionalTargets", from.AdditionalTargets, to.AdditionalTargets)
+
+	ft, tt := from.TimeConstraints, to.TimeConstraints
+	d.value("timeConstraints.maxDurationSeconds", intPtr(ft.MaxDurationSeconds), intPtr(tt.MaxDurationSeconds))
+	d.value("timeConstraints.pendingTimeoutSeconds", optionalIntPtr(ft.PendingTimeoutSeconds), optionalIntPtr(tt.PendingTimeoutSeconds))
+	d.json("timeConstraints.allowedWindows", ft.AllowedWindows, tt.AllowedWindows)
+
+	d.value("breakGlass", boolPtr(from.BreakGlass), boolPtr(to.BreakGlass))
+	d.json("fields", from.Fields, to.Fields)
+	d.json("usageLimits", from.UsageLimits, to.UsageLimits)
+	d.value("admissionPolicy", from.AdmissionPolicy, to.AdmissionPolicy)
+	return d.changes
+}
+
+type differ struct {
+	changes []FieldChange
+}
+
+func (d *differ) value(field string, from, to *string) {
+	if from != nil && *from == "" {
+		from = nil
+	}
+	if to != nil && *to == "" {
+		to = nil
+	}
+	if reflect.DeepEqual(from, to) {
+		return
+	}
+	d.changes = append(d.changes, FieldChange{Field: field, From: from, To: to})
+}
+
+// list compares lists of IDs, where the order doesn't matter.
+func (d *differ) list(field string, from, to []string) {
+	added := missing(to, from)
+	removed := missing(from, to)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	d.changes = append(d.changes, FieldChange{Field: field, Added: added, Removed: removed})
+}
+
+// json compares structured fields by their JSON representation.
+func (d *differ) json(field string, from, to interface{}) {
+	d.value(field, jsonPtr(from), jsonPtr(to))
+}
+
+// missing returns the items in a which aren't in b, sorted.
+func missing(a, b []string) []string {
+	in := map[string]bool{}
+	for _, s := range b {
+		in[s] = true
+	}
+	var out []string
+	for _, s := range a {
+		if !in[s] {
+			out = append(out, s)
+			in[s] = true
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func mapKeys(a, b map[string]string) []string {
+	keys := map[string]bool{}
+	for k := range a {
+		keys[k] = true
+	}
+	for k := range b {
+		keys[k] = true
+	}
+	out := make([]string, 0, len(keys))
+	for k := range keys {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func mapValue(m map[string]string, key string) *string {
+	if v, ok := m[key]; ok {
+		return &v
+	}
+	return nil
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *string {
+	if i == 0 {
+		return nil
+	}
+	return strPtr(strconv.Itoa(i))
+}
+
+func optionalIntPtr(i *int) *string {
+	if i == nil {
+		return nil
+	}
+	return strPtr(strconv.Itoa(*i))
+}
+
+func boolPtr(b bool) *string {
+	if !b {
+		return nil
+	}
+	return strPtr("true")
+}
+
+func jsonPtr(v interface{}) *string {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+	case reflect.Slice, reflect.Map:
+		if rv.Len() == 0 {
+			return nil
+		}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return strPtr(string(b))
+}
@@ -0,0 +1,114 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffVersions(t *testing.T) {
+	str := func(s string) *string { return &s }
+	timeout := 600
+
+	base := AccessRule{
+		ID:          "rule1",
+		Version:     "v1",
+		Name:        "Developers",
+		Description: "dev access",
+		Groups:      []string{"developers"},
+		Approval:    Approval{Users: []string{"alice", "bob"}},
+		Target:      Target{ProviderID: "okta", With: map[string]string{"groupId": "123"}},
+		TimeConstraints: types.TimeConstraints{
+			MaxDurationSeconds: 3600,
+		},
+	}
+
+	type testcase struct {
+		name   string
+		change func(r *AccessRule)
+		want   []FieldChange
+	}
+
+	testcases := []testcase{
+		{
+			name:   "no changes",
+			change: func(r *AccessRule) { r.Version = "v2"; r.Metadata.UpdatedBy = "admin" },
+		},
+		{
+			name: "approvers and groups",
+			change: func(r *AccessRule) {
+				r.Approval.Users = []string{"bob", "carol"}
+				r.Groups = []string{"developers", "contractors"}
+			},
+			want: []FieldChange{
+				{Field: "groups", Added: []string{"contractors"}},
+				{Field: "approval.users", Added: []string{"carol"}, Removed: []string{"alice"}},
+			},
+		},
+		{
+			name: "reordered approvers",
+			change: func(r *AccessRule) {
+				r.Approval.Users = []string{"bob", "alice"}
+			},
+		},
+		{
+			name: "target args",
+			change: func(r *AccessRule) {
+				r.Target.With = map[string]string{"groupId": "456", "accountId": "789"}
+			},
+			want: []FieldChange{
+				{Field: "target.with.accountId", To: str("789")},
+				{Field: "target.with.groupId", From: str("123"), To: str("456")},
+			},
+		},
+		{
+			name: "owners",
+			change: func(r *AccessRule) {
+				r.Owners = Owners{Users: []string{"alice"}}
+			},
+			want: []FieldChange{
+				{Field: "owners.users", Added: []string{"alice"}},
+			},
+		},
+		{
+			name: "selectable target args",
+			change: func(r *AccessRule) {
+				r.Target.WithSelectable = map[string]SelectableArgument{"accountId": {Values: []string{"789"}}}
+			},
+			want: []FieldChange{
+				{Field: "target.withSelectable", To: str(`{"accountId":{"values":["789"]}}`)},
+			},
+		},
+		{
+			name: "time constraints",
+			change: func(r *AccessRule) {
+				r.TimeConstraints = types.TimeConstraints{MaxDurationSeconds: 7200, PendingTimeoutSeconds: &timeout}
+			},
+			want: []FieldChange{
+				{Field: "timeConstraints.maxDurationSeconds", From: str("3600"), To: str("7200")},
+				{Field: "timeConstraints.pendingTimeoutSeconds", To: str("600")},
+			},
+		},
+		{
+			name: "stages",
+			change: func(r *AccessRule) {
+				r.Approval = Approval{Stages: []ApprovalStage{{Users: []string{"alice"}}}}
+			},
+			want: []FieldChange{
+				{Field: "approval.users", Removed: []string{"alice", "bob"}},
+				{Field: "approval.stages", To: str(`[{"groups":null,"users":["alice"]}]`)},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			to := base
+			to.Approval.Users = append([]string{}, base.Approval.Users...)
+			tc.change(&to)
+			got := DiffVersions(base, to)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// RequestField is an additional field which users fill in when requesting access,
+// such as a ticket number or the environment they are working in.
+type RequestField struct {
+	// ID is the key the value of the field is stored under on the request.
+	ID          string  `json:"id" dynamodbav:"id"`
+	Label       string  `json:"label" dynamodbav:"label"`
+	Description *string `json:"description,omitempty" dynamodbav:"description,omitempty"`
+	Required    bool    `json:"required" dynamodbav:"required"`
+	// Regex is a regular expression which the whole value must match.
+	Regex *string `json:"regex,omitempty" dynamodbav:"regex,omitempty"`
+	// Options restricts the value to one of a fixed set of values.
+	Options []string `json:"options,omitempty" dynamodbav:"options,omitempty"`
+}
+
+// RequestFieldsFromAPI converts the API request fields into RequestFields.
+func RequestFieldsFromAPI(in *[]types.RequestField) []RequestField {
+	if in == nil {
+		return nil
+	}
+	var fields []RequestField
+	for _, f := range *in {
+		field := RequestField{
+			ID:          f.Id,
+			Label:       f.Label,
+			Description: f.Description,
+			Required:    f.Required,
+			Regex:       f.Regex,
+		}
+		if f.Options != nil {
+			field.Options = *f.Options
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func (f RequestField) ToAPI() types.RequestField {
+	field := types.RequestField{
+		Id:          f.ID,
+		Label:       f.Label,
+		Description: f.Description,
+		Required:    f.Required,
+		Regex:       f.Regex,
+	}
+	if len(f.Options) > 0 {
+		options := f.Options
+		field.Options = &options
+	}
+	return field
+}
+
+// requestFieldsToAPI returns nil if there are no fields, so that the fields are omitted from API responses.
+func requestFieldsToAPI(fields []RequestField) *[]types.RequestField {
+	if len(fields) == 0 {
+		return nil
+	}
+	res := make([]types.RequestField, len(fields))
+	for i, f := range fields {
+		res[i] = f.ToAPI()
+	}
+	return &res
+}
+
+// Pattern returns the compiled regex of the field, anchored so that it must match the whole value.
+// It returns nil if the field doesn't have a regex.
+func (f RequestField) Pattern() (*regexp.Regexp, error) {
+	if f.Regex == nil {
+		return nil, nil
+	}
+	return regexp.Compile("^(?:" + *f.Regex + ")$")
+}
+
+// Validate checks a value given for the field. An empty value means that the field wasn't filled in.
+func (f RequestField) Validate(value string) error {
+	if value == "" {
+		if f.Required {
+			return errors.New("this field is required")
+		}
+		return nil
+	}
+	if len(f.Options) > 0 {
+		found := false
+		for _, o := range f.Options {
+			if o == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("must be one of: %s", strings.Join(f.Options, ", "))
+		}
+	}
+	re, err := f.Pattern()
+	if err != nil {
+		return err
+	}
+	if re != nil && !re.MatchString(value) {
+		return fmt.Errorf("must match the pattern: %s", *f.Regex)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package rule
+
+import "github.com/common-fate/granted-approvals/pkg/types"
+
+// Owners are the users and groups who can edit and archive an access rule and view its requests,
+// without being administrators.
+type Owners struct {
+	Users  []string `json:"users,omitempty" dynamodbav:"users,omitempty"`
+	Groups []string `json:"groups,omitempty" dynamodbav:"groups,omitempty"`
+}
+
+// Includes returns true if the user is an owner, either directly or through one of their groups.
+func (o Owners) Includes(userID string, groups []string) bool {
+	for _, u := range o.Users {
+		if u == userID {
+			return true
+		}
+	}
+	for _, g := range o.Groups {
+		for _, ug := range groups {
+			if g == ug {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (o Owners) ToAPI() *types.AccessRuleOwners {
+	if len(o.Users) == 0 && len(o.Groups) == 0 {
+		return nil
+	}
+	// return empty arrays rather than null, as both fields are required in the API.
+	owners := types.AccessRuleOwners{Users: make([]string, 0), Groups: make([]string, 0)}
+	owners.Users = append(owners.Users, o.Users...)
+	owners.Groups = append(owners.Groups, o.Groups...)
+	return &owners
+}
+
+// OwnersFromAPI converts the API owners into Owners.
+func OwnersFromAPI(in *types.AccessRuleOwners) Owners {
+	if in == nil {
+		return Owners{}
+	}
+	return Owners{Users: in.Users, Groups: in.Groups}
+}
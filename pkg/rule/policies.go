@@ -0,0 +1,108 @@
+package rule
+
+import (
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// ApprovalPolicy decides whether requests which meet its conditions are approved automatically or need review.
+type ApprovalPolicy struct {
+	ID          string                     `json:"id" dynamodbav:"id"`
+	Description string                     `json:"description,omitempty" dynamodbav:"description,omitempty"`
+	Action      types.ApprovalPolicyAction `json:"action" dynamodbav:"action"`
+	Conditions  ApprovalConditions         `json:"conditions" dynamodbav:"conditions"`
+}
+
+// ApprovalConditions are the conditions a request must meet for an approval policy to apply.
+// Conditions which aren't set are ignored, so a policy without conditions applies to every request.
+type ApprovalConditions struct {
+	// RequestorGroups matches requests from members of any of the groups.
+	RequestorGroups []string `json:"requestorGroups,omitempty" dynamodbav:"requestorGroups,omitempty"`
+	// MaxDurationSeconds matches requests for at most this long.
+	MaxDurationSeconds int `json:"maxDurationSeconds,omitempty" dynamodbav:"maxDurationSeconds,omitempty"`
+}
+
+// PolicyInput is the information about a request which approval policies are evaluated against.
+type PolicyInput struct {
+	// RequestorGroups are the groups of the user who access is requested for.
+	RequestorGroups []string
+	Duration        time.Duration
+}
+
+// Matches returns true if the input meets all of the conditions.
+func (c ApprovalConditions) Matches(in PolicyInput) bool {
+	if len(c.RequestorGroups) > 0 && !containsAny(in.RequestorGroups, c.RequestorGroups) {
+		return false
+	}
+	if c.MaxDurationSeconds > 0 && in.Duration > time.Duration(c.MaxDurationSeconds)*time.Second {
+		return false
+	}
+	return true
+}
+
+// RequiresReview evaluates the approval policies of the rule in order, and returns whether a request needs review
+// along with the policy which decided it. If no policy matches, the policy is nil and the request needs review
+// if the rule has approvers.
+func (a *Approval) RequiresReview(in PolicyInput) (bool, *ApprovalPolicy) {
+	for i := range a.Policies {
+		p := &a.Policies[i]
+		if p.Conditions.Matches(in) {
+			return p.Action == types.REQUIREREVIEW, p
+		}
+	}
+	return a.IsRequired(), nil
+}
+
+func (p ApprovalPolicy) ToAPI() types.ApprovalPolicy {
+	policy := types.ApprovalPolicy{
+		Id:     p.ID,
+		Action: p.Action,
+	}
+	if p.Description != "" {
+		policy.Description = &p.Description
+	}
+	if len(p.Conditions.RequestorGroups) > 0 {
+		policy.Conditions.RequestorGroups = &p.Conditions.RequestorGroups
+	}
+	if p.Conditions.MaxDurationSeconds > 0 {
+		policy.Conditions.MaxDurationSeconds = &p.Conditions.MaxDurationSeconds
+	}
+	return policy
+}
+
+// ApprovalPoliciesFromAPI converts the API approval policies into ApprovalPolicies.
+func ApprovalPoliciesFromAPI(in *[]types.ApprovalPolicy) []ApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	var res []ApprovalPolicy
+	for _, p := range *in {
+		policy := ApprovalPolicy{
+			ID:     p.Id,
+			Action: p.Action,
+		}
+		if p.Description != nil {
+			policy.Description = *p.Description
+		}
+		if p.Conditions.RequestorGroups != nil {
+			policy.Conditions.RequestorGroups = *p.Conditions.RequestorGroups
+		}
+		if p.Conditions.MaxDurationSeconds != nil {
+			policy.Conditions.MaxDurationSeconds = *p.Conditions.MaxDurationSeconds
+		}
+		res = append(res, policy)
+	}
+	return res
+}
+
+func containsAny(have []string, want []string) bool {
+	for _, h := range have {
+		for _, w := range want {
+			if h == w {
+				return true
+			}
+		}
+	}
+	return false
+}
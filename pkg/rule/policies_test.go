@@ -0,0 +1,75 @@
+package rule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequiresReview(t *testing.T) {
+	approval := Approval{
+		Users: []string{"approver"},
+		Policies: []ApprovalPolicy{
+			{ID: "contractors", Action: types.REQUIREREVIEW, Conditions: ApprovalConditions{RequestorGroups: []string{"contractors"}}},
+			{ID: "oncall", Action: types.AUTOAPPROVE, Conditions: ApprovalConditions{RequestorGroups: []string{"oncall"}}},
+			{ID: "short", Action: types.AUTOAPPROVE, Conditions: ApprovalConditions{MaxDurationSeconds: 1800}},
+		},
+	}
+
+	type testcase struct {
+		name         string
+		approval     Approval
+		give         PolicyInput
+		wantRequired bool
+		wantPolicy   string
+	}
+
+	testcases := []testcase{
+		{
+			name:         "group condition",
+			approval:     approval,
+			give:         PolicyInput{RequestorGroups: []string{"oncall"}, Duration: time.Hour},
+			wantRequired: false,
+			wantPolicy:   "oncall",
+		},
+		{
+			name:         "duration condition",
+			approval:     approval,
+			give:         PolicyInput{Duration: 30 * time.Minute},
+			wantRequired: false,
+			wantPolicy:   "short",
+		},
+		{
+			name:         "first matching policy wins",
+			approval:     approval,
+			give:         PolicyInput{RequestorGroups: []string{"contractors", "oncall"}, Duration: 10 * time.Minute},
+			wantRequired: true,
+			wantPolicy:   "contractors",
+		},
+		{
+			name:         "no policy matches so rule approvers decide",
+			approval:     approval,
+			give:         PolicyInput{Duration: time.Hour},
+			wantRequired: true,
+		},
+		{
+			name:         "no policies and no approvers",
+			give:         PolicyInput{Duration: time.Hour},
+			wantRequired: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotRequired, gotPolicy := tc.approval.RequiresReview(tc.give)
+			assert.Equal(t, tc.wantRequired, gotRequired)
+			var gotID string
+			if gotPolicy != nil {
+				gotID = gotPolicy.ID
+			}
+			assert.Equal(t, tc.wantPolicy, gotID)
+		})
+	}
+}
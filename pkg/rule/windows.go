@@ -0,0 +1,110 @@
+package rule
+
+import (
+	"fmt"
+	"time"
+
+	// the timezone database is embedded, as it may not be available in the Lambda runtime.
+	_ "time/tzdata"
+
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+var weekdays = map[types.Weekday]time.Weekday{
+	types.MONDAY:    time.Monday,
+	types.TUESDAY:   time.Tuesday,
+	types.WEDNESDAY: time.Wednesday,
+	types.THURSDAY:  time.Thursday,
+	types.FRIDAY:    time.Friday,
+	types.SATURDAY:  time.Saturday,
+	types.SUNDAY:    time.Sunday,
+}
+
+// Interval is a span of time from Start up to End.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains returns true if t is within the interval.
+func (i Interval) Contains(t time.Time) bool {
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// AllowedWindowAt returns the occurrence of an allowed window which contains t.
+// If t is within several windows, the occurrence which ends last is returned.
+// ok is false if t isn't within any of the windows.
+func AllowedWindowAt(windows []types.AllowedWindow, t time.Time) (window Interval, ok bool, err error) {
+	for _, w := range windows {
+		occurrences, err := windowOccurrences(w, t)
+		if err != nil {
+			return Interval{}, false, err
+		}
+		for _, o := range occurrences {
+			if o.Contains(t) && (!ok || o.End.After(window.End)) {
+				window = o
+				ok = true
+			}
+		}
+	}
+	return window, ok, nil
+}
+
+// ValidateAllowedWindow returns an error if the timezone or times of the window can't be parsed.
+func ValidateAllowedWindow(w types.AllowedWindow) error {
+	_, err := windowOccurrences(w, time.Now())
+	return err
+}
+
+// windowOccurrences returns the occurrences of the window starting on the day of t and the day before,
+// which are the only occurrences which can contain t.
+func windowOccurrences(w types.AllowedWindow, t time.Time) ([]Interval, error) {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	startHour, startMinute, err := parseClock(w.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endHour, endMinute, err := parseClock(w.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	local := t.In(loc)
+	var res []Interval
+	for _, offset := range []int{-1, 0} {
+		y, m, d := local.AddDate(0, 0, offset).Date()
+		day := time.Date(y, m, d, 0, 0, 0, 0, loc)
+		if !hasWeekday(w.Days, day.Weekday()) {
+			continue
+		}
+		start := time.Date(y, m, d, startHour, startMinute, 0, 0, loc)
+		end := time.Date(y, m, d, endHour, endMinute, 0, 0, loc)
+		// windows which end before they start finish on the following day.
+		if !end.After(start) {
+			end = time.Date(y, m, d+1, endHour, endMinute, 0, 0, loc)
+		}
+		res = append(res, Interval{Start: start, End: end})
+	}
+	return res, nil
+}
+
+func hasWeekday(days []types.Weekday, wd time.Weekday) bool {
+	for _, d := range days {
+		if weekdays[d] == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// parseClock parses a 24 hour HH:MM time of day.
+func parseClock(s string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time of day %q: must be in HH:MM format", s)
+	}
+	return t.Hour(), t.Minute(), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/auth"
 	"github.com/common-fate/granted-approvals/pkg/config"
+	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/common-fate/granted-approvals/pkg/types"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
@@ -60,7 +61,7 @@ func New(ctx context.Context, cfg Config, opts ...func(*Server)) (*Server, error
 		return nil, errors.New("IdentitySyncer must be provided")
 	}
 
-	db, err := ddb.New(ctx, cfg.Config.DynamoTable)
+	db, err := storage.New(ctx, cfg.Config.DynamoTable)
 	if err != nil {
 		return nil, err
 	}
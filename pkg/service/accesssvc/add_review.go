@@ -4,14 +4,18 @@ import (
 	"context"
 	"time"
 
+	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
+	ac_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
 	"github.com/common-fate/granted-approvals/pkg/types"
+	"go.uber.org/zap"
 )
 
 type AddReviewOpts struct {
@@ -36,6 +40,10 @@ type AddReviewResult struct {
 // If the review approves access, access is granted.
 func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpts) (*AddReviewResult, error) {
 	request := opts.Request
+	// break-glass requests have already been granted, and are reviewed after the fact.
+	if request.Status == access.NEEDS_RETROSPECTIVE_REVIEW {
+		return s.addRetrospectiveReview(ctx, opts)
+	}
 	if request.Status != access.PENDING {
 		return nil, InvalidStatusError{Status: request.Status}
 	}
@@ -54,13 +62,62 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 		Decision:        opts.Decision,
 		Comment:         opts.Comment,
 		OverrideTimings: opts.OverrideTiming,
+		OnBehalfOf:      reviewingOnBehalfOf(opts),
 	}
 
+	// audit log events to be saved alongside the review.
+	var events []ddb.Keyer
+
 	// update the request status, based on the review decision
 	switch r.Decision {
 	case access.DecisionApproved:
+		// a delegate approves on behalf of an approver who is away, so the approval counts as the approver's
+		// and only one of them can approve.
+		approverID := opts.ReviewerID
+		if r.OnBehalfOf != nil {
+			approverID = *r.OnBehalfOf
+		}
+		if request.HasApproved(approverID) {
+			return nil, ErrReviewerAlreadyApproved
+		}
+		request.AddApproval(opts.ReviewerID, approverID)
+		if opts.OverrideTiming != nil {
+			request.OverrideTiming = opts.OverrideTiming
+		}
+		stages := opts.AccessRule.Approval.GetStages()
+		// the request remains pending until enough reviewers have approved the current stage.
+		if !stageApproved(stages, request) {
+			break
+		}
+		if request.ApprovalStage < len(stages)-1 {
+			// move on to the next stage and add its approvers as reviewers of the request.
+			fromStage := request.ApprovalStage
+			request.ApprovalStage++
+			request.StageApprovedBy = nil
+			// the reviewers for the next stage are saved along with the updated request below.
+			reviewers, delegateEvents, err := s.addStageReviewers(ctx, request, stages[request.ApprovalStage], opts.Reviewers)
+			if err != nil {
+				return nil, err
+			}
+			opts.Reviewers = reviewers
+			stageEvent := access.NewApprovalStageChangeEvent(request.ID, s.Clock.Now(), &opts.ReviewerID, fromStage, request.ApprovalStage)
+			events = append(events, &stageEvent)
+			for i := range delegateEvents {
+				events = append(events, &delegateEvents[i])
+			}
+			break
+		}
 		request.Status = access.APPROVED
-		request.OverrideTiming = opts.OverrideTiming
+		requested := request.GetTiming()
+		clipped, err := s.applyTimeConstraints(ctx, &request, opts.AccessRule.TimeConstraints)
+		if err != nil {
+			return nil, err
+		}
+		if clipped {
+			// the timing was shortened by the system rather than by the reviewer, so there is no actor.
+			clipEvent := access.NewTimingChangeEvent(request.ID, s.Clock.Now(), nil, requested, *request.OverrideTiming)
+			events = append(events, &clipEvent)
+		}
 		start, end := request.GetInterval(access.WithNow(s.Clock.Now()))
 		// this request must not overlap an existing grant for the user and rule
 		// This fetches all grants which end in the future, these may or may not have a grant associated yet.
@@ -70,22 +127,40 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 			RequestEndComparator: storage.GreaterThanEqual,
 			CompareTo:            end,
 		}
-		_, err := s.DB.Query(ctx, &rq)
+		_, err = s.DB.Query(ctx, &rq)
 		if err != nil && err != ddb.ErrNoItems {
 			return nil, err
 		}
 		// This will check against the requests which do have grants already
-		overlaps := overlapsExistingGrant(start, end, rq.Result)
+		overlaps := overlapsExistingGrant(start, end, withSameArguments(request, rq.Result))
 		if overlaps {
 			return nil, ErrRequestOverlapsExistingGrant
 		}
-		// if the request is approved, attempt to create the grant.
-		updatedRequest, err := s.Granter.CreateGrant(ctx, grantsvc.CreateGrantOpts{Request: request, AccessRule: opts.AccessRule})
+		err = s.checkUsageLimits(ctx, request, opts.AccessRule.UsageLimits)
 		if err != nil {
 			return nil, err
 		}
 		reviewed := types.REVIEWED
 		request.ApprovalMethod = &reviewed
+		// save the request as APPROVED before granting access. Reviews and cancellations only change PENDING requests,
+		// so one which reads the request while access is being granted fails rather than changing it after the grant is created.
+		// The write is conditional on the version which was read, so only one of two reviewers approving at the same time creates a grant.
+		err = dbupdate.UpdateRequest(ctx, s.DB, &request, nil, dbupdate.WithReviewers(opts.Reviewers))
+		if err != nil {
+			return nil, err
+		}
+		// if the request is approved, attempt to create the grant.
+		updatedRequest, err := s.Granter.CreateGrant(ctx, grantsvc.CreateGrantOpts{Request: request, AccessRule: opts.AccessRule})
+		if err != nil {
+			// return the request to PENDING, so that it can be reviewed again.
+			pending := opts.Request
+			pending.Version = request.Version
+			restoreErr := dbupdate.UpdateRequest(ctx, s.DB, &pending, nil, dbupdate.WithReviewers(opts.Reviewers))
+			if restoreErr != nil {
+				logger.Get(ctx).Errorw("failed to return the request to pending after granting access failed", "request", request.ID, zap.Error(restoreErr))
+			}
+			return nil, err
+		}
 		request = *updatedRequest
 
 	case access.DecisionDECLINED:
@@ -94,32 +169,34 @@ func (s *Service) AddReviewAndGrantAccess(ctx context.Context, opts AddReviewOpt
 	request.UpdatedAt = s.Clock.Now()
 
 	// we need to save the Review, the updated Request in the database.
-	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, request, dbupdate.WithReviewers(opts.Reviewers))
-	if err != nil {
-		return nil, err
-	}
-	items = append(items, &r)
+	items := []ddb.Keyer{&r}
+	items = append(items, events...)
 
-	if request.OverrideTiming != nil {
+	if opts.OverrideTiming != nil {
 		// audit log event
-		reqEvent := access.NewTimingChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, request.RequestedTiming, *request.OverrideTiming)
+		reqEvent := access.NewTimingChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, request.RequestedTiming, *opts.OverrideTiming)
+		items = append(items, &reqEvent)
+	}
+	// a partial approval doesn't change the status of the request, so there is no status change to record.
+	if request.Status != originalStatus {
+		// audit log event
+		reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
 		items = append(items, &reqEvent)
 	}
-	// audit log event
-	reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
 
-	items = append(items, &reqEvent)
 	// store the updated items in the database
-	err = s.DB.PutBatch(ctx, items...)
+	err := dbupdate.UpdateRequest(ctx, s.DB, &request, items, dbupdate.WithReviewers(opts.Reviewers))
 	if err != nil {
 		return nil, err
 	}
 
-	switch r.Decision {
-	case access.DecisionApproved:
+	switch {
+	case request.Status == access.APPROVED:
 		err = s.EventPutter.Put(ctx, gevent.RequestApproved{Request: request})
-	case access.DecisionDECLINED:
+	case request.Status == access.DECLINED:
 		err = s.EventPutter.Put(ctx, gevent.RequestDeclined{Request: request})
+	case request.ApprovalStage != opts.Request.ApprovalStage:
+		err = s.EventPutter.Put(ctx, gevent.RequestAdvanced{Request: request})
 	}
 
 	// In a future PR we will shift these events out to be triggered by dynamo db streams
@@ -141,7 +218,8 @@ func overlapsExistingGrant(start, end time.Time, upcomingRequests []access.Reque
 	}
 	// maybe we need to add a buffer here to prevent edge cases where a race condition occurs in the step functions and access is provisioned for a new grant and cancelled for an old one leaving no access.
 	for _, r := range upcomingRequests {
-		if r.Grant != nil {
+		// revoked grants, including grants released by the requestor, no longer give access.
+		if r.Grant != nil && r.Grant.Status != ac_types.REVOKED {
 			if (start.Before(r.Grant.End) || start.Equal(r.Grant.End)) && (end.After(r.Grant.Start) || end.Equal(r.Grant.Start)) {
 				return true
 			}
@@ -151,17 +229,82 @@ func overlapsExistingGrant(start, end time.Time, upcomingRequests []access.Reque
 	return false
 }
 
+// stageApproved returns true if the request has enough approvals to complete its current approval stage.
+// Only the approvals given during the current stage are counted, so an approver of an earlier stage
+// who is also an approver of the current stage has to approve it again.
+func stageApproved(stages []rule.ApprovalStage, request access.Request) bool {
+	return len(request.StageApprovedBy) >= stages[request.ApprovalStage].Threshold()
+}
+
+// addStageReviewers adds the approvers for an approval stage as reviewers of the request.
+// Existing reviewers who are also approvers for the stage are moved to the new stage.
+// The delegates of any approvers who are away are added too, and an audit log event is returned for each delegate.
+func (s *Service) addStageReviewers(ctx context.Context, request access.Request, stage rule.ApprovalStage, existing []access.Reviewer) ([]access.Reviewer, []access.RequestEvent, error) {
+	approvers, err := rulesvc.GetStageApprovers(ctx, s.DB, stage)
+	if err != nil {
+		return nil, nil, err
+	}
+	reviewers := make([]access.Reviewer, len(existing))
+	copy(reviewers, existing)
+
+	var stageApprovers []string
+	for _, u := range approvers {
+		// users cannot approve their own requests, or requests they delegated.
+		if request.IsRequestor(u) {
+			continue
+		}
+		stageApprovers = append(stageApprovers, u)
+		reviewers = upsertStageReviewer(reviewers, access.Reviewer{
+			ReviewerID:    u,
+			Request:       request,
+			ApprovalStage: request.ApprovalStage,
+		})
+	}
+
+	delegates, events, err := s.delegateReviewers(ctx, request, stageApprovers)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, d := range delegates {
+		reviewers = upsertStageReviewer(reviewers, d)
+	}
+	return reviewers, events, nil
+}
+
+// upsertStageReviewer moves an existing reviewer to the approval stage of r, or adds r if it isn't already a reviewer.
+func upsertStageReviewer(reviewers []access.Reviewer, r access.Reviewer) []access.Reviewer {
+	for i := range reviewers {
+		if reviewers[i].ReviewerID == r.ReviewerID {
+			reviewers[i].ApprovalStage = r.ApprovalStage
+			reviewers[i].OnBehalfOf = r.OnBehalfOf
+			return reviewers
+		}
+	}
+	return append(reviewers, r)
+}
+
+// reviewingOnBehalfOf returns the ID of the approver the reviewer is a delegate for, if any.
+func reviewingOnBehalfOf(opts AddReviewOpts) *string {
+	for _, r := range opts.Reviewers {
+		if r.ReviewerID == opts.ReviewerID {
+			return r.OnBehalfOf
+		}
+	}
+	return nil
+}
+
 // users can review requests if they are a Granted administrator,
-// or if they are a Reviewer on the request.
+// or if they are a Reviewer for the current approval stage of the request.
 func canReview(opts AddReviewOpts) bool {
-	if opts.ReviewerID == opts.Request.RequestedBy {
+	// neither the requestor nor the user who delegated the request can review it.
+	if opts.Request.IsRequestor(opts.ReviewerID) {
 		return false
 	}
 	if opts.ReviewerIsAdmin {
 		return true
 	}
 	for _, r := range opts.Reviewers {
-		if opts.ReviewerID == r.ReviewerID {
+		if opts.ReviewerID == r.ReviewerID && r.ApprovalStage == opts.Request.ApprovalStage {
 			return true
 		}
 	}
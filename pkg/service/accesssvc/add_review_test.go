@@ -2,13 +2,19 @@ package accesssvc
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/benbjohnson/clock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
+	"github.com/common-fate/granted-approvals/pkg/types"
 
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
 	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
@@ -28,6 +34,7 @@ func TestOverlapsExistingGrant(t *testing.T) {
 	b := access.Grant{Start: clk.Now(), End: clk.Now().Add(time.Minute)}
 	c := access.Grant{Start: clk.Now().Add(-time.Minute), End: clk.Now().Add(time.Minute)}
 	d := access.Grant{Start: clk.Now().Add(time.Second * 30), End: clk.Now().Add(time.Minute)}
+	revoked := access.Grant{Start: clk.Now(), End: clk.Now().Add(time.Minute), Status: ahTypes.REVOKED}
 
 	testcases := []testcase{
 		{
@@ -60,6 +67,12 @@ func TestOverlapsExistingGrant(t *testing.T) {
 			existingGrants: []access.Request{{Grant: &b}},
 			want:           true,
 		},
+		{
+			name:           "revoked grants don't overlap",
+			grant:          d,
+			existingGrants: []access.Request{{Grant: &revoked}},
+			want:           false,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -86,17 +99,31 @@ func TestAddReview(t *testing.T) {
 	}
 
 	clk := clock.NewMock()
+	reviewed := types.REVIEWED
 
 	now := clk.Now()
 	overrideTiming := &access.Timing{
 		Duration:  time.Minute,
 		StartTime: &now,
 	}
+	delegator := "b"
 	requestWithOverride := access.Request{
 		Status:         access.APPROVED,
 		Grant:          &access.Grant{},
 		OverrideTiming: overrideTiming,
 		UpdatedAt:      clk.Now(),
+		Version:        1,
+	}
+	// the request is saved again once the grant has been created.
+	savedRequestWithOverride := requestWithOverride
+	savedRequestWithOverride.Version = 2
+	// approver "a" is away, and "d" is reviewing on their behalf.
+	awayApprover := "a"
+	quorumRule := rule.AccessRule{
+		Approval: rule.Approval{
+			Users:             []string{"a", "b"},
+			RequiredApprovals: 2,
+		},
 	}
 	testcases := []testcase{
 		{
@@ -124,7 +151,11 @@ func TestAddReview(t *testing.T) {
 			},
 			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
 				Request: access.Request{
-					Status: access.APPROVED,
+					ApprovalMethod:  &reviewed,
+					Status:          access.APPROVED,
+					ApprovedBy:      []string{"a"},
+					StageApprovedBy: []string{"a"},
+					Version:         1,
 				},
 			},
 			withCreateGrantResponse: createGrantResponse{
@@ -133,6 +164,7 @@ func TestAddReview(t *testing.T) {
 					Status:    access.APPROVED, // request should be approved
 					UpdatedAt: clk.Now(),
 					Grant:     &access.Grant{},
+					Version:   1,
 				},
 			},
 			want: &AddReviewResult{
@@ -140,6 +172,7 @@ func TestAddReview(t *testing.T) {
 					Status:    access.APPROVED, // request should be approved
 					UpdatedAt: clk.Now(),
 					Grant:     &access.Grant{},
+					Version:   2,
 				},
 			},
 		},
@@ -169,8 +202,12 @@ func TestAddReview(t *testing.T) {
 			},
 			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
 				Request: access.Request{
-					Status:         access.APPROVED,
-					OverrideTiming: overrideTiming,
+					ApprovalMethod:  &reviewed,
+					Status:          access.APPROVED,
+					OverrideTiming:  overrideTiming,
+					ApprovedBy:      []string{"a"},
+					StageApprovedBy: []string{"a"},
+					Version:         1,
 				},
 			},
 			withCreateGrantResponse: createGrantResponse{
@@ -178,7 +215,7 @@ func TestAddReview(t *testing.T) {
 				request: &requestWithOverride,
 			},
 			want: &AddReviewResult{
-				Request: requestWithOverride,
+				Request: savedRequestWithOverride,
 			},
 		},
 		{
@@ -215,6 +252,36 @@ func TestAddReview(t *testing.T) {
 			},
 			wantErr: ErrUserNotAuthorized,
 		},
+		{
+			name: "user cannot review a request made on their behalf",
+			give: AddReviewOpts{
+				ReviewerID:      "a",
+				Decision:        access.DecisionApproved,
+				ReviewerIsAdmin: true,
+				Reviewers:       []access.Reviewer{{ReviewerID: "a"}},
+				Request: access.Request{
+					Status:      access.PENDING,
+					RequestedBy: "a",
+					DelegatedBy: &delegator,
+				},
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
+		{
+			name: "user cannot review a request they made on behalf of someone else",
+			give: AddReviewOpts{
+				ReviewerID:      "b",
+				Decision:        access.DecisionApproved,
+				ReviewerIsAdmin: true,
+				Reviewers:       []access.Reviewer{{ReviewerID: "b"}},
+				Request: access.Request{
+					Status:      access.PENDING,
+					RequestedBy: "a",
+					DelegatedBy: &delegator,
+				},
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
 		{
 			name: "admin can review not own request",
 			give: AddReviewOpts{
@@ -228,8 +295,12 @@ func TestAddReview(t *testing.T) {
 			},
 			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
 				Request: access.Request{
-					Status:      access.APPROVED,
-					RequestedBy: "b",
+					ApprovalMethod:  &reviewed,
+					Status:          access.APPROVED,
+					RequestedBy:     "b",
+					ApprovedBy:      []string{"a"},
+					StageApprovedBy: []string{"a"},
+					Version:         1,
 				},
 			},
 			withCreateGrantResponse: createGrantResponse{
@@ -239,6 +310,7 @@ func TestAddReview(t *testing.T) {
 					RequestedBy: "b",
 					UpdatedAt:   clk.Now(),
 					Grant:       &access.Grant{},
+					Version:     1,
 				},
 			},
 			want: &AddReviewResult{
@@ -247,9 +319,307 @@ func TestAddReview(t *testing.T) {
 					RequestedBy: "b",
 					UpdatedAt:   clk.Now(),
 					Grant:       &access.Grant{},
+					Version:     2,
+				},
+			},
+		},
+		{
+			name: "partial approval keeps request pending",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "a",
+					},
+					{
+						ReviewerID: "b",
+					},
+				},
+				Request: access.Request{
+					Status: access.PENDING,
+				},
+				AccessRule: rule.AccessRule{
+					Approval: rule.Approval{
+						Users:             []string{"a", "b"},
+						RequiredApprovals: 2,
+					},
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:          access.PENDING,
+					ApprovedBy:      []string{"a"},
+					StageApprovedBy: []string{"a"},
+					UpdatedAt:       clk.Now(),
+					Version:         1,
+				},
+			},
+		},
+		{
+			name: "threshold met by second approval",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "a",
+					},
+					{
+						ReviewerID: "b",
+					},
+				},
+				Request: access.Request{
+					Status:          access.PENDING,
+					ApprovedBy:      []string{"a"},
+					StageApprovedBy: []string{"a"},
+				},
+				AccessRule: rule.AccessRule{
+					Approval: rule.Approval{
+						Users:             []string{"a", "b"},
+						RequiredApprovals: 2,
+					},
+				},
+			},
+			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
+				Request: access.Request{
+					ApprovalMethod:  &reviewed,
+					Status:          access.APPROVED,
+					ApprovedBy:      []string{"a", "b"},
+					StageApprovedBy: []string{"a", "b"},
+					Version:         1,
+				},
+				AccessRule: rule.AccessRule{
+					Approval: rule.Approval{
+						Users:             []string{"a", "b"},
+						RequiredApprovals: 2,
+					},
+				},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					Status:     access.APPROVED,
+					ApprovedBy: []string{"a", "b"},
+					Grant:      &access.Grant{},
+					Version:    1,
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:     access.APPROVED,
+					ApprovedBy: []string{"a", "b"},
+					UpdatedAt:  clk.Now(),
+					Grant:      &access.Grant{},
+					Version:    2,
+				},
+			},
+		},
+		{
+			name: "approving a stage advances to the next stage",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "a",
+					},
+				},
+				Request: access.Request{
+					Status: access.PENDING,
+				},
+				AccessRule: rule.AccessRule{
+					Approval: rule.Approval{
+						Stages: []rule.ApprovalStage{
+							{Users: []string{"a"}},
+							{Users: []string{"c"}},
+						},
+					},
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:        access.PENDING,
+					ApprovedBy:    []string{"a"},
+					ApprovalStage: 1,
+					UpdatedAt:     clk.Now(),
+					Version:       1,
+				},
+			},
+		},
+		{
+			name: "reviewer for an earlier stage cannot review",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID:    "a",
+						ApprovalStage: 0,
+					},
+					{
+						ReviewerID:    "c",
+						ApprovalStage: 1,
+					},
+				},
+				Request: access.Request{
+					Status:        access.PENDING,
+					ApprovedBy:    []string{"a"},
+					ApprovalStage: 1,
+				},
+				AccessRule: rule.AccessRule{
+					Approval: rule.Approval{
+						Stages: []rule.ApprovalStage{
+							{Users: []string{"a"}},
+							{Users: []string{"c"}},
+						},
+					},
+				},
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
+		{
+			name: "approver of an earlier stage can approve a later stage",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID:    "a",
+						ApprovalStage: 1,
+					},
+				},
+				Request: access.Request{
+					Status:        access.PENDING,
+					ApprovedBy:    []string{"a"},
+					ApprovalStage: 1,
+				},
+				AccessRule: rule.AccessRule{
+					Approval: rule.Approval{
+						Stages: []rule.ApprovalStage{
+							{Users: []string{"a"}},
+							{Users: []string{"a", "b"}},
+							{Users: []string{"c"}},
+						},
+					},
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:        access.PENDING,
+					ApprovedBy:    []string{"a"},
+					ApprovalStage: 2,
+					UpdatedAt:     clk.Now(),
+					Version:       1,
 				},
 			},
 		},
+		{
+			name: "delegate's approval counts as the approver's",
+			give: AddReviewOpts{
+				ReviewerID: "d",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+					{ReviewerID: "d", OnBehalfOf: &awayApprover},
+				},
+				Request: access.Request{
+					Status:          access.PENDING,
+					ApprovedBy:      []string{"b"},
+					StageApprovedBy: []string{"b"},
+				},
+				AccessRule: quorumRule,
+			},
+			wantCreateGrantOpts: grantsvc.CreateGrantOpts{
+				Request: access.Request{
+					ApprovalMethod:  &reviewed,
+					Status:          access.APPROVED,
+					ApprovedBy:      []string{"b", "d"},
+					StageApprovedBy: []string{"b", "a"},
+					Version:         1,
+				},
+				AccessRule: quorumRule,
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					Status:     access.APPROVED,
+					ApprovedBy: []string{"b", "d"},
+					Grant:      &access.Grant{},
+					Version:    1,
+				},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{
+					Status:     access.APPROVED,
+					ApprovedBy: []string{"b", "d"},
+					UpdatedAt:  clk.Now(),
+					Grant:      &access.Grant{},
+					Version:    2,
+				},
+			},
+		},
+		{
+			name: "delegate and away approver don't both count towards the quorum",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+					{ReviewerID: "d", OnBehalfOf: &awayApprover},
+				},
+				Request: access.Request{
+					Status:          access.PENDING,
+					ApprovedBy:      []string{"d"},
+					StageApprovedBy: []string{"a"},
+				},
+				AccessRule: quorumRule,
+			},
+			wantErr: ErrReviewerAlreadyApproved,
+		},
+		{
+			name: "delegate cannot approve after the approver they review for",
+			give: AddReviewOpts{
+				ReviewerID: "d",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{ReviewerID: "a"},
+					{ReviewerID: "b"},
+					{ReviewerID: "d", OnBehalfOf: &awayApprover},
+				},
+				Request: access.Request{
+					Status:          access.PENDING,
+					ApprovedBy:      []string{"a"},
+					StageApprovedBy: []string{"a"},
+				},
+				AccessRule: quorumRule,
+			},
+			wantErr: ErrReviewerAlreadyApproved,
+		},
+		{
+			name: "reviewer cannot approve twice",
+			give: AddReviewOpts{
+				ReviewerID: "a",
+				Decision:   access.DecisionApproved,
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "a",
+					},
+				},
+				Request: access.Request{
+					Status:          access.PENDING,
+					ApprovedBy:      []string{"a"},
+					StageApprovedBy: []string{"a"},
+				},
+				AccessRule: rule.AccessRule{
+					Approval: rule.Approval{
+						Users:             []string{"a", "b"},
+						RequiredApprovals: 2,
+					},
+				},
+			},
+			wantErr: ErrReviewerAlreadyApproved,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -265,6 +635,9 @@ func TestAddReview(t *testing.T) {
 
 			c := ddbmock.New(t)
 			c.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
+			// none of the approvers are away.
+			c.MockQueryWithErr(&storage.GetUserDelegation{}, ddb.ErrNoItems)
+			c.MockQuery(&storage.ListBlackouts{})
 
 			// called by dbupdate.GetUpdateRequestItems
 			c.MockQuery(&storage.ListRequestReviewers{})
@@ -287,3 +660,230 @@ func TestAddReview(t *testing.T) {
 	}
 
 }
+
+func TestAddRetrospectiveReview(t *testing.T) {
+	type testcase struct {
+		name       string
+		give       AddReviewOpts
+		withRevoke bool
+		want       *AddReviewResult
+		wantErr    error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	activeGrant := access.Grant{Start: now.Add(-time.Minute), End: now.Add(time.Hour), Status: ahTypes.ACTIVE}
+	expiredGrant := access.Grant{Start: now.Add(-time.Hour), End: now.Add(-time.Minute), Status: ahTypes.EXPIRED}
+	revokedGrant := activeGrant
+	revokedGrant.Status = ahTypes.REVOKED
+	reviewers := []access.Reviewer{{ReviewerID: "b"}}
+
+	testcases := []testcase{
+		{
+			name: "approving marks the request as approved",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionApproved,
+				Reviewers:  reviewers,
+				Request:    access.Request{RequestedBy: "a", Status: access.NEEDS_RETROSPECTIVE_REVIEW, Grant: &activeGrant},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{RequestedBy: "a", Status: access.APPROVED, ApprovedBy: []string{"b"}, Grant: &activeGrant, UpdatedAt: now, Version: 1},
+			},
+		},
+		{
+			name: "declining revokes an active grant",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionDECLINED,
+				Reviewers:  reviewers,
+				Request:    access.Request{RequestedBy: "a", Status: access.NEEDS_RETROSPECTIVE_REVIEW, Grant: &activeGrant},
+			},
+			withRevoke: true,
+			want: &AddReviewResult{
+				Request: access.Request{RequestedBy: "a", Status: access.DECLINED, Grant: &revokedGrant, UpdatedAt: now, Version: 1},
+			},
+		},
+		{
+			name: "declining after the grant has expired",
+			give: AddReviewOpts{
+				ReviewerID: "b",
+				Decision:   access.DecisionDECLINED,
+				Reviewers:  reviewers,
+				Request:    access.Request{RequestedBy: "a", Status: access.NEEDS_RETROSPECTIVE_REVIEW, Grant: &expiredGrant},
+			},
+			want: &AddReviewResult{
+				Request: access.Request{RequestedBy: "a", Status: access.DECLINED, Grant: &expiredGrant, UpdatedAt: now, Version: 1},
+			},
+		},
+		{
+			name: "requestor cannot review their own break-glass request",
+			give: AddReviewOpts{
+				ReviewerID:      "a",
+				ReviewerIsAdmin: true,
+				Decision:        access.DecisionApproved,
+				Reviewers:       reviewers,
+				Request:         access.Request{RequestedBy: "a", Status: access.NEEDS_RETROSPECTIVE_REVIEW, Grant: &activeGrant},
+			},
+			wantErr: ErrUserNotAuthorized,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			g := mocks.NewMockGranter(ctrl)
+			if tc.withRevoke {
+				revoked := tc.give.Request
+				revoked.Grant = &revokedGrant
+				g.EXPECT().RevokeGrant(gomock.Any(), grantsvc.RevokeGrantOpts{Request: tc.give.Request, RevokerID: tc.give.ReviewerID}).Return(&revoked, nil)
+			}
+			ep := mocks.NewMockEventPutter(ctrl)
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			c := ddbmock.New(t)
+			// called by dbupdate.GetUpdateRequestItems
+			c.MockQuery(&storage.ListRequestReviewers{})
+
+			s := Service{
+				Clock:       clk,
+				DB:          c,
+				Granter:     g,
+				EventPutter: ep,
+			}
+			got, err := s.AddReviewAndGrantAccess(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// conflictDB is a mock database where every versioned write fails,
+// as if the request had been changed by someone else.
+type conflictDB struct {
+	*ddbmock.Client
+}
+
+func (conflictDB) PutVersioned(ctx context.Context, item ddb.Keyer, version int, items ...ddb.Keyer) error {
+	return storage.ErrVersionConflict
+}
+
+func TestAddReviewConflict(t *testing.T) {
+	// the Granter mock fails the test if a grant is created.
+	ctrl := gomock.NewController(t)
+	g := mocks.NewMockGranter(ctrl)
+	ep := mocks.NewMockEventPutter(ctrl)
+
+	c := ddbmock.New(t)
+	c.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
+	c.MockQueryWithErr(&storage.GetUserDelegation{}, ddb.ErrNoItems)
+	c.MockQuery(&storage.ListBlackouts{})
+
+	s := Service{
+		Clock:       clock.NewMock(),
+		DB:          conflictDB{Client: c},
+		Granter:     g,
+		EventPutter: ep,
+	}
+	_, err := s.AddReviewAndGrantAccess(context.Background(), AddReviewOpts{
+		ReviewerID: "a",
+		Decision:   access.DecisionApproved,
+		Reviewers:  []access.Reviewer{{ReviewerID: "a"}},
+		Request:    access.Request{Status: access.PENDING},
+	})
+	assert.Equal(t, dbupdate.ErrRequestConflict, err)
+}
+
+// requestStore is a mock database which saves a single request, and only applies versioned writes
+// to it if the version matches the saved request, in the same way as a conditional write in DynamoDB.
+type requestStore struct {
+	*ddbmock.Client
+	request access.Request
+}
+
+func (db *requestStore) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	if q, ok := qb.(*storage.GetRequest); ok {
+		r := db.request
+		q.Result = &r
+		return &ddb.QueryResult{}, nil
+	}
+	return db.Client.Query(ctx, qb, opts...)
+}
+
+func (db *requestStore) PutVersioned(ctx context.Context, item ddb.Keyer, version int, items ...ddb.Keyer) error {
+	if version != db.request.Version {
+		return storage.ErrVersionConflict
+	}
+	db.request = *item.(*access.Request)
+	return nil
+}
+
+func newRequestStore(t *testing.T, r access.Request) *requestStore {
+	c := ddbmock.New(t)
+	c.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
+	c.MockQueryWithErr(&storage.GetUserDelegation{}, ddb.ErrNoItems)
+	c.MockQuery(&storage.ListBlackouts{})
+	c.MockQuery(&storage.ListRequestReviewers{})
+	return &requestStore{Client: c, request: r}
+}
+
+func TestCancelWhileGrantingAccess(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	g := mocks.NewMockGranter(ctrl)
+	ep := mocks.NewMockEventPutter(ctrl)
+	ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	pending := access.Request{ID: "req", RequestedBy: "b", Status: access.PENDING, Version: 1}
+	db := newRequestStore(t, pending)
+	s := Service{Clock: clock.NewMock(), DB: db, Granter: g, EventPutter: ep}
+
+	// the requestor tries to cancel the request while access is being granted.
+	g.EXPECT().CreateGrant(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, opts grantsvc.CreateGrantOpts) (*access.Request, error) {
+		err := s.CancelRequest(ctx, CancelRequestOpts{CancellerID: "b", RequestID: "req"})
+		assert.Equal(t, ErrRequestCannotBeCancelled, err)
+		r := opts.Request
+		r.Grant = &access.Grant{}
+		return &r, nil
+	})
+
+	got, err := s.AddReviewAndGrantAccess(ctx, AddReviewOpts{
+		ReviewerID: "a",
+		Decision:   access.DecisionApproved,
+		Reviewers:  []access.Reviewer{{ReviewerID: "a"}},
+		Request:    pending,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, access.APPROVED, db.request.Status)
+	assert.Equal(t, got.Request, db.request)
+}
+
+func TestAddReviewGrantFails(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	g := mocks.NewMockGranter(ctrl)
+	// no events are sent, as the request isn't approved.
+	ep := mocks.NewMockEventPutter(ctrl)
+
+	pending := access.Request{ID: "req", RequestedBy: "b", Status: access.PENDING, Version: 1}
+	db := newRequestStore(t, pending)
+	s := Service{Clock: clock.NewMock(), DB: db, Granter: g, EventPutter: ep}
+
+	grantErr := errors.New("provider error")
+	g.EXPECT().CreateGrant(gomock.Any(), gomock.Any()).Return(nil, grantErr)
+
+	_, err := s.AddReviewAndGrantAccess(ctx, AddReviewOpts{
+		ReviewerID: "a",
+		Decision:   access.DecisionApproved,
+		Reviewers:  []access.Reviewer{{ReviewerID: "a"}},
+		Request:    pending,
+	})
+	assert.Equal(t, grantErr, err)
+
+	// the request is returned to PENDING so that it can be reviewed again.
+	want := pending
+	want.Version = 3
+	assert.Equal(t, want, db.request)
+}
@@ -0,0 +1,113 @@
+package accesssvc
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+)
+
+// admitRequest evaluates the admission policy of the access rule against a new request.
+// An AdmissionDeniedError is returned if the policy denies the request, or if the policy can't be evaluated.
+// The returned bool is true if the policy requires the request to be reviewed.
+// Requests are allowed if the rule doesn't have an admission policy.
+func (s *Service) admitRequest(ctx context.Context, rul *rule.AccessRule, requestor *identity.User, req access.Request) (bool, error) {
+	if rul.AdmissionPolicy == nil {
+		return false, nil
+	}
+
+	now := s.Clock.Now()
+	q := storage.ListRequestsForUserAndRequestend{
+		UserID:               requestor.ID,
+		RequestEndComparator: storage.GreaterThanEqual,
+		CompareTo:            now,
+	}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return false, err
+	}
+	active := []interface{}{}
+	for _, r := range q.Result {
+		if r.Status != access.PENDING && r.Status != access.APPROVED && r.Status != access.NEEDS_RETROSPECTIVE_REVIEW {
+			continue
+		}
+		_, end := r.GetInterval(access.WithNow(now))
+		ar := map[string]interface{}{
+			"id":       r.ID,
+			"ruleId":   r.Rule,
+			"status":   string(r.Status),
+			"end":      end,
+			"provider": "",
+			"with":     map[string]string{},
+		}
+		if r.Grant != nil {
+			ar["provider"] = r.Grant.Provider
+			ar["with"] = r.Grant.With.AdditionalProperties
+		}
+		active = append(active, ar)
+	}
+
+	timing := req.GetTiming()
+	var startTime interface{}
+	if timing.StartTime != nil {
+		startTime = *timing.StartTime
+	}
+	var reason string
+	if req.Data.Reason != nil {
+		reason = *req.Data.Reason
+	}
+	fields := req.Data.Fields
+	if fields == nil {
+		fields = map[string]string{}
+	}
+	groups := requestor.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	// the policy sees the arguments which will be granted, including the values chosen by the requestor.
+	with := rul.Target.Arguments(req.Data.With)
+	if with == nil {
+		with = map[string]string{}
+	}
+
+	vars := map[string]interface{}{
+		"requestor": map[string]interface{}{
+			"id":     requestor.ID,
+			"email":  requestor.Email,
+			"groups": groups,
+		},
+		"request": map[string]interface{}{
+			"durationSeconds": int64(timing.Duration.Seconds()),
+			"startTime":       startTime,
+			"reason":          reason,
+			"fields":          fields,
+		},
+		"rule": map[string]interface{}{
+			"id":       rul.ID,
+			"name":     rul.Name,
+			"provider": rul.Target.ProviderID,
+			"with":     with,
+		},
+		"activeRequests": active,
+	}
+
+	decision, err := rule.EvaluateAdmissionPolicy(*rul.AdmissionPolicy, vars)
+	if err != nil {
+		logger.Get(ctx).Errorw("error evaluating admission policy", "rule.id", rul.ID, "error", err)
+		return false, AdmissionDeniedError{Message: "the policy could not be evaluated"}
+	}
+	switch decision.Action {
+	case rule.AdmissionDeny:
+		return false, AdmissionDeniedError{Message: decision.Message}
+	case rule.AdmissionReview:
+		if !rul.Approval.IsRequired() {
+			return false, AdmissionDeniedError{Message: "the request needs review, but the access rule doesn't have any approvers"}
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,90 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdmitRequest(t *testing.T) {
+	type testcase struct {
+		name       string
+		policy     *string
+		approval   rule.Approval
+		withActive []access.Request
+		wantReview bool
+		wantErr    error
+	}
+
+	policy := func(p string) *string { return &p }
+	clk := clock.NewMock()
+	end := clk.Now().Add(time.Hour)
+
+	testcases := []testcase{
+		{
+			name: "no policy",
+		},
+		{
+			name:   "allowed",
+			policy: policy(`"developers" in requestor.groups`),
+		},
+		{
+			name:   "denied with message",
+			policy: policy(`activeRequests.exists(r, r.ruleId != rule.id) ? {"decision": "deny", "message": "you already have access"} : {"decision": "allow"}`),
+			withActive: []access.Request{
+				{ID: "req_2", Rule: "rul_2", Status: access.APPROVED, Grant: &access.Grant{Provider: "aws", End: end}},
+			},
+			wantErr: AdmissionDeniedError{Message: "you already have access"},
+		},
+		{
+			name:   "ended requests are ignored",
+			policy: policy(`activeRequests.exists(r, r.ruleId != rule.id) ? {"decision": "deny", "message": "you already have access"} : {"decision": "allow"}`),
+			withActive: []access.Request{
+				{ID: "req_2", Rule: "rul_2", Status: access.CANCELLED},
+			},
+		},
+		{
+			name:       "review",
+			policy:     policy(`request.durationSeconds > 1800 ? {"decision": "review"} : {"decision": "allow"}`),
+			approval:   rule.Approval{Users: []string{"approver"}},
+			wantReview: true,
+		},
+		{
+			name:    "review without approvers",
+			policy:  policy(`{"decision": "review"}`),
+			wantErr: AdmissionDeniedError{Message: "the request needs review, but the access rule doesn't have any approvers"},
+		},
+		{
+			name:    "policy which fails to evaluate denies the request",
+			policy:  policy(`request.missing == "a"`),
+			wantErr: AdmissionDeniedError{Message: "the policy could not be evaluated"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForUserAndRequestend{Result: tc.withActive})
+
+			s := Service{
+				Clock: clk,
+				DB:    db,
+			}
+			rul := rule.AccessRule{ID: "rul_1", AdmissionPolicy: tc.policy, Approval: tc.approval}
+			requestor := identity.User{ID: "usr_1", Groups: []string{"developers"}}
+			req := access.Request{ID: "req_1", Rule: "rul_1", RequestedTiming: access.Timing{Duration: time.Hour}}
+
+			gotReview, err := s.admitRequest(context.Background(), &rul, &requestor, req)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantReview, gotReview)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// selectedArgumentsAreValid checks that the request chooses an allowed value for each selectable argument of the rule's target.
+// Values which aren't one of the argument's values are checked against the options suggested by the provider, if the argument allows any option.
+func (s *Service) selectedArgumentsAreValid(ctx context.Context, request types.CreateRequestRequest, rul *rule.AccessRule) error {
+	var fields []apio.FieldError
+	values := requestSelectedArguments(request)
+	ids := make([]string, 0, len(rul.Target.WithSelectable))
+	for id := range rul.Target.WithSelectable {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		arg := rul.Target.WithSelectable[id]
+		v, ok := values[id]
+		if !ok {
+			fields = append(fields, apio.FieldError{
+				Field: "with." + id,
+				Error: "a value must be chosen for this argument",
+			})
+			continue
+		}
+		if arg.HasValue(v) {
+			continue
+		}
+		allowed := false
+		if arg.AnyOption {
+			options, err := s.argOptions(ctx, rul.Target.ProviderID, id)
+			if err != nil {
+				return err
+			}
+			allowed = options[v]
+		}
+		if !allowed {
+			fields = append(fields, apio.FieldError{
+				Field: "with." + id,
+				Error: fmt.Sprintf("%s is not one of the values allowed by the access rule", v),
+			})
+		}
+	}
+	var unknown []string
+	for id := range values {
+		if _, ok := rul.Target.WithSelectable[id]; !ok {
+			unknown = append(unknown, id)
+		}
+	}
+	sort.Strings(unknown)
+	for _, id := range unknown {
+		fields = append(fields, apio.FieldError{
+			Field: "with." + id,
+			Error: "the access rule does not allow this argument to be chosen",
+		})
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("request validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
+
+// argOptions returns the values of the options suggested by a provider for one of its arguments.
+func (s *Service) argOptions(ctx context.Context, providerID, argID string) (map[string]bool, error) {
+	res, err := s.AHClient.ListProviderArgOptionsWithResponse(ctx, providerID, argID)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("could not list the options for argument %s of provider %s: unhandled response code %d", argID, providerID, res.StatusCode())
+	}
+	options := make(map[string]bool)
+	for _, o := range res.JSON200.Options {
+		options[o.Value] = true
+	}
+	return options, nil
+}
+
+// requestSelectedArguments returns the values chosen for the selectable arguments of the rule's target, keyed by argument ID.
+// Empty values are treated as the argument not being chosen.
+func requestSelectedArguments(request types.CreateRequestRequest) map[string]string {
+	if request.With == nil {
+		return nil
+	}
+	var values map[string]string
+	for id, v := range request.With.AdditionalProperties {
+		if v == "" {
+			continue
+		}
+		if values == nil {
+			values = make(map[string]string)
+		}
+		values[id] = v
+	}
+	return values
+}
+
+// withSameArguments returns the requests which chose the same target arguments as request.
+// Requests for the same rule with different arguments grant different access, so their grants may overlap.
+func withSameArguments(request access.Request, requests []access.Request) []access.Request {
+	var res []access.Request
+	for _, r := range requests {
+		if (len(r.Data.With) == 0 && len(request.Data.With) == 0) || reflect.DeepEqual(r.Data.With, request.Data.With) {
+			res = append(res, r)
+		}
+	}
+	return res
+}
@@ -0,0 +1,116 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/common-fate/apikit/apio"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectedArgumentsAreValid(t *testing.T) {
+	type testcase struct {
+		name        string
+		giveWith    map[string]string
+		wantOptions bool
+		wantFields  []apio.FieldError
+	}
+
+	r := rule.AccessRule{
+		Target: rule.Target{
+			ProviderID: "aws-sso",
+			With:       map[string]string{"permissionSetArn": "arn:aws:sso:::permissionSet/ps-1"},
+			WithSelectable: map[string]rule.SelectableArgument{
+				"accountId": {Values: []string{"111111111111", "222222222222"}, AnyOption: true},
+				"region":    {Values: []string{"us-east-1"}},
+			},
+		},
+	}
+
+	testcases := []testcase{
+		{
+			name:     "ok",
+			giveWith: map[string]string{"accountId": "111111111111", "region": "us-east-1"},
+		},
+		{
+			name:        "value is an option suggested by the provider",
+			giveWith:    map[string]string{"accountId": "333333333333", "region": "us-east-1"},
+			wantOptions: true,
+		},
+		{
+			name:        "value is not an option suggested by the provider",
+			giveWith:    map[string]string{"accountId": "444444444444", "region": "us-east-1"},
+			wantOptions: true,
+			wantFields:  []apio.FieldError{{Field: "with.accountId", Error: "444444444444 is not one of the values allowed by the access rule"}},
+		},
+		{
+			name:       "value not allowed",
+			giveWith:   map[string]string{"accountId": "111111111111", "region": "eu-west-1"},
+			wantFields: []apio.FieldError{{Field: "with.region", Error: "eu-west-1 is not one of the values allowed by the access rule"}},
+		},
+		{
+			name:     "missing argument",
+			giveWith: map[string]string{"accountId": "111111111111", "region": ""},
+			wantFields: []apio.FieldError{
+				{Field: "with.region", Error: "a value must be chosen for this argument"},
+			},
+		},
+		{
+			name:     "fixed arguments can't be chosen",
+			giveWith: map[string]string{"accountId": "111111111111", "region": "us-east-1", "permissionSetArn": "arn:aws:sso:::permissionSet/ps-2"},
+			wantFields: []apio.FieldError{
+				{Field: "with.permissionSetArn", Error: "the access rule does not allow this argument to be chosen"},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			if tc.wantOptions {
+				res := &ahTypes.ListProviderArgOptionsResponse{}
+				res.JSON200 = &struct {
+					HasOptions bool             `json:"hasOptions"`
+					Options    []ahTypes.Option `json:"options"`
+				}{HasOptions: true, Options: []ahTypes.Option{{Label: "prod", Value: "333333333333"}}}
+				m.EXPECT().ListProviderArgOptionsWithResponse(gomock.Any(), "aws-sso", "accountId").Return(res, nil)
+			}
+
+			s := Service{AHClient: m}
+			in := types.CreateRequestRequest{
+				Timing: types.RequestTiming{DurationSeconds: 60},
+				With:   &types.SelectedArguments{AdditionalProperties: tc.giveWith},
+			}
+			err := s.selectedArgumentsAreValid(context.Background(), in, &r)
+			if tc.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, &apio.APIError{
+				Err:    errors.New("request validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: tc.wantFields,
+			}, err)
+		})
+	}
+}
+
+func TestWithSameArguments(t *testing.T) {
+	noArgs := access.Request{ID: "a"}
+	account1 := access.Request{ID: "b", Data: access.RequestData{With: map[string]string{"accountId": "1"}}}
+	account2 := access.Request{ID: "c", Data: access.RequestData{With: map[string]string{"accountId": "2"}}}
+	all := []access.Request{noArgs, account1, account2}
+
+	assert.Equal(t, []access.Request{noArgs}, withSameArguments(access.Request{Data: access.RequestData{With: map[string]string{}}}, all))
+	assert.Equal(t, []access.Request{account1}, withSameArguments(access.Request{Data: access.RequestData{With: map[string]string{"accountId": "1"}}}, all))
+	assert.Nil(t, withSameArguments(access.Request{Data: access.RequestData{With: map[string]string{"accountId": "3"}}}, all))
+}
@@ -3,6 +3,7 @@ package accesssvc
 import (
 	"context"
 
+	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/storage"
@@ -35,29 +36,25 @@ func (s *Service) CancelRequest(ctx context.Context, opts CancelRequestOpts) err
 
 	req.Status = access.CANCELLED
 	req.UpdatedAt = s.Clock.Now()
-	// we need to save the Review, the updated Request in the database.
-	items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, *req)
-	if err != nil {
-		return err
-	}
 	// audit log event
 	reqEvent := access.NewStatusChangeEvent(req.ID, req.UpdatedAt, &opts.CancellerID, originalStatus, req.Status)
 
-	err = s.EventPutter.Put(ctx, gevent.RequestCancelled{Request: *req})
-	// In a future PR we will shift these events out to be triggered by dynamo db streams
-	// This will currently put the app in a strange state if this fails
+	// the request is saved before the event is sent, so that a cancellation which loses a race with a review
+	// doesn't send a cancelled notification.
+	err = dbupdate.UpdateRequest(ctx, s.DB, req, []ddb.Keyer{&reqEvent})
 	if err != nil {
 		return err
 	}
 
-	items = append(items, &reqEvent)
-	return s.DB.PutBatch(ctx, items...)
+	// In a future PR we will shift these events out to be triggered by dynamo db streams
+	// This will currently put the app in a strange state if this fails
+	return s.EventPutter.Put(ctx, gevent.RequestCancelled{Request: *req})
 }
 
-// users can cancel their own requests.
+// users can cancel their own requests, or requests they made on behalf of another user.
 func canCancel(opts CancelRequestOpts, request access.Request) bool {
 	// canceller must be original requestor
-	return opts.CancellerID == request.RequestedBy
+	return request.IsRequestor(opts.CancellerID)
 }
 
 func isCancellable(request access.Request) bool {
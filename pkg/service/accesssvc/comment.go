@@ -0,0 +1,76 @@
+package accesssvc
+
+import (
+	"context"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+type AddCommentOpts struct {
+	UserID      string
+	UserIsAdmin bool
+	RequestID   string
+	Body        string
+}
+
+// AddComment adds a comment to a request and records it in the request history.
+// The requestor, the reviewers of the request and administrators can comment on a request.
+func (s *Service) AddComment(ctx context.Context, opts AddCommentOpts) (*access.Comment, error) {
+	q := storage.GetRequest{ID: opts.RequestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	request := *q.Result
+
+	rq := storage.ListRequestReviewers{RequestID: request.ID}
+	_, err = s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	if !canComment(opts, request, rq.Result) {
+		return nil, ErrUserNotAuthorized
+	}
+
+	c := access.Comment{
+		ID:        types.NewCommentID(),
+		RequestID: request.ID,
+		UserID:    opts.UserID,
+		Body:      opts.Body,
+		CreatedAt: s.Clock.Now(),
+	}
+
+	// audit log event
+	reqEvent := access.NewCommentEvent(request.ID, c.CreatedAt, &opts.UserID, c.Body)
+
+	err = s.DB.PutBatch(ctx, &c, &reqEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	// In a future PR we will shift these events out to be triggered by dynamo db streams
+	// This will currently put the app in a strange state if this fails
+	err = s.EventPutter.Put(ctx, gevent.RequestCommented{Request: request, Comment: c})
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// users can comment on requests if they are a Granted administrator,
+// if they made the request, or if they are a Reviewer of the request.
+func canComment(opts AddCommentOpts, request access.Request, reviewers []access.Reviewer) bool {
+	if opts.UserIsAdmin || request.IsRequestor(opts.UserID) {
+		return true
+	}
+	for _, r := range reviewers {
+		if opts.UserID == r.ReviewerID {
+			return true
+		}
+	}
+	return false
+}
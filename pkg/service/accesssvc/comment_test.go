@@ -0,0 +1,84 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	accessMocks "github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddComment(t *testing.T) {
+	type testcase struct {
+		name      string
+		give      AddCommentOpts
+		request   access.Request
+		reviewers []access.Reviewer
+		wantErr   error
+	}
+
+	delegator := "delegator"
+
+	testcases := []testcase{
+		{
+			name:    "requestor can comment",
+			give:    AddCommentOpts{UserID: "requestor", RequestID: "req", Body: "hello"},
+			request: access.Request{ID: "req", RequestedBy: "requestor"},
+		},
+		{
+			name:    "delegator can comment",
+			give:    AddCommentOpts{UserID: "delegator", RequestID: "req", Body: "hello"},
+			request: access.Request{ID: "req", RequestedBy: "requestor", DelegatedBy: &delegator},
+		},
+		{
+			name:      "reviewer can comment",
+			give:      AddCommentOpts{UserID: "reviewer", RequestID: "req", Body: "hello"},
+			request:   access.Request{ID: "req", RequestedBy: "requestor"},
+			reviewers: []access.Reviewer{{ReviewerID: "reviewer", Request: access.Request{ID: "req"}}},
+		},
+		{
+			name:    "admin can comment",
+			give:    AddCommentOpts{UserID: "admin", UserIsAdmin: true, RequestID: "req", Body: "hello"},
+			request: access.Request{ID: "req", RequestedBy: "requestor"},
+		},
+		{
+			name:      "other user cannot comment",
+			give:      AddCommentOpts{UserID: "other", RequestID: "req", Body: "hello"},
+			request:   access.Request{ID: "req", RequestedBy: "requestor"},
+			reviewers: []access.Reviewer{{ReviewerID: "reviewer", Request: access.Request{ID: "req"}}},
+			wantErr:   ErrUserNotAuthorized,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRequest{Result: &tc.request})
+			db.MockQuery(&storage.ListRequestReviewers{Result: tc.reviewers})
+
+			ctrl := gomock.NewController(t)
+			ep := accessMocks.NewMockEventPutter(ctrl)
+			if tc.wantErr == nil {
+				ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			s := Service{
+				Clock:       clock.NewMock(),
+				DB:          db,
+				EventPutter: ep,
+			}
+			got, err := s.AddComment(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.give.UserID, got.UserID)
+				assert.Equal(t, tc.give.Body, got.Body)
+				assert.Equal(t, tc.request.ID, got.RequestID)
+			}
+		})
+	}
+}
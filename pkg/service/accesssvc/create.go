@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/apikit/logger"
@@ -25,9 +27,91 @@ type CreateRequestResult struct {
 	Reviewers []access.Reviewer
 }
 
+// preparedRequest is a request which has passed all of the checks to be created, but hasn't been saved yet.
+type preparedRequest struct {
+	rule      *rule.AccessRule
+	request   access.Request
+	reviewers []access.Reviewer
+	// events are the audit log events for the request, which are saved along with it.
+	events []access.RequestEvent
+	// expiry is set if the request expires when it isn't reviewed in time.
+	expiry     *access.RequestExpiry
+	breakGlass bool
+	// grantNow is true if access is granted as soon as the request is saved.
+	grantNow bool
+}
+
 // CreateRequest creates a new request and saves it in the database.
 // Returns an error if the request is invalid.
+//
+// Administrators can request access on behalf of another user in one of the groups of the Access Rule.
+// The request is made for that user, and the administrator is recorded as the user who delegated it.
 func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in types.CreateRequestRequest) (*CreateRequestResult, error) {
+	log := logger.Get(ctx).With("user.id", user.ID)
+	p, err := s.prepareRequest(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+	req := p.request
+	reviewers := p.reviewers
+
+	// track items to insert in the database.
+	items := []ddb.Keyer{&req, &access.RequestIndex{Request: req}}
+	for i := range reviewers {
+		items = append(items, &reviewers[i])
+	}
+	for i := range p.events {
+		items = append(items, &p.events[i])
+	}
+	if p.expiry != nil {
+		items = append(items, p.expiry)
+	}
+
+	log.Debugw("saving request", "request", req, "reviewers", reviewers)
+
+	// save the request.
+	err = s.DB.PutBatch(ctx, items...)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.breakGlass {
+		err = s.EventPutter.Put(ctx, gevent.RequestBreakGlass{Request: req})
+	} else {
+		err = s.EventPutter.Put(ctx, gevent.RequestCreated{Request: req})
+	}
+	// in a future PR we will shift these events out to be triggered by dynamo db streams
+	// This will currently put the app in a strange state if this fails
+	if err != nil {
+		return nil, err
+	}
+
+	// check to see if it valid for instant approval
+	if p.grantNow {
+
+		log.Debugw("granting access immediately", "request", req, "reviewers", reviewers, "breakGlass", p.breakGlass)
+		updatedReq, err := s.Granter.CreateGrant(ctx, grantsvc.CreateGrantOpts{Request: req, AccessRule: *p.rule})
+		if err != nil {
+			return nil, err
+		}
+		req = *updatedReq
+		err = dbupdate.UpdateRequest(ctx, s.DB, &req, nil, dbupdate.WithReviewers(reviewers))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := CreateRequestResult{
+		Request:   req,
+		Reviewers: reviewers,
+	}
+
+	return &res, nil
+}
+
+// prepareRequest runs all of the checks for creating a request, and builds the request and its reviewers.
+// It doesn't write anything to the database.
+func (s *Service) prepareRequest(ctx context.Context, user *identity.User, in types.CreateRequestRequest) (*preparedRequest, error) {
 	log := logger.Get(ctx).With("user.id", user.ID)
 	q := storage.GetAccessRuleCurrent{ID: in.AccessRuleId}
 	_, err := s.DB.Query(ctx, &q)
@@ -46,17 +130,56 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 		return nil, err
 	}
 
+	// requestor is the user who access is being requested for.
+	// For delegated requests, this is a different user to the one submitting the request.
+	requestor := user
+	var delegatedBy *string
+	if in.OnBehalfOf != nil && *in.OnBehalfOf != user.ID {
+		// being able to request access for a rule doesn't allow a user to request it for someone else.
+		if !user.BelongsToGroup(s.AdminGroup) {
+			return nil, ErrOnBehalfOfNotAllowed
+		}
+		uq := storage.GetUser{ID: *in.OnBehalfOf}
+		_, err = s.DB.Query(ctx, &uq)
+		if err == ddb.ErrNoItems {
+			return nil, ErrOnBehalfOfUserNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		requestor = uq.Result
+		delegatedBy = &user.ID
+
+		log.Debugw("verifying delegated user belongs to access rule groups", "rule.groups", rule.Groups, "delegated.user.id", requestor.ID, "delegated.user.groups", requestor.Groups)
+		err = groupMatches(rule.Groups, requestor.Groups)
+		if err != nil {
+			return nil, ErrOnBehalfOfUserNoMatchingGroup
+		}
+	}
+
+	breakGlass := in.BreakGlass != nil && *in.BreakGlass
+	if breakGlass && !rule.BreakGlass {
+		return nil, ErrBreakGlassNotAllowed
+	}
+
 	now := s.Clock.Now()
 	err = requestIsValid(in, rule)
 	if err != nil {
 		return nil, err
 	}
+	err = s.selectedArgumentsAreValid(ctx, in, rule)
+	if err != nil {
+		return nil, err
+	}
 	// the request is valid, so create it.
 	req := access.Request{
 		ID:          types.NewRequestID(),
-		RequestedBy: user.ID,
+		RequestedBy: requestor.ID,
+		DelegatedBy: delegatedBy,
 		Data: access.RequestData{
 			Reason: in.Reason,
+			Fields: requestFieldValues(in),
+			With:   requestSelectedArguments(in),
 		},
 		CreatedAt:       now,
 		UpdatedAt:       now,
@@ -69,47 +192,116 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 	// If the approval is not required, auto-approve the request
 	auto := types.AUTOMATIC
 	revd := types.REVIEWED
+	bg := types.BREAKGLASS
+
+	// the approval policies of the rule decide whether the request needs review, based on who access is for and how long it is for.
+	reviewRequired, policy := rule.Approval.RequiresReview(policyInput(requestor, req.RequestedTiming.Duration))
 
-	if !rule.Approval.IsRequired() {
+	admissionReview, err := s.admitRequest(ctx, rule, requestor, req)
+	if err != nil {
+		return nil, err
+	}
+	if admissionReview {
+		// the admission policy overrides the approval policies of the rule.
+		reviewRequired = true
+		policy = nil
+	}
+	if policy != nil && !breakGlass {
+		req.ApprovalPolicyID = &policy.ID
+	}
+
+	// break-glass requests are granted straight away and reviewed afterwards.
+	grantNow := breakGlass || !reviewRequired
+
+	if breakGlass {
+		req.Status = access.NEEDS_RETROSPECTIVE_REVIEW
+		req.ApprovalMethod = &bg
+	} else if !reviewRequired {
 		req.Status = access.APPROVED
 		req.ApprovalMethod = &auto
 	} else {
 		req.ApprovalMethod = &revd
 	}
 
-	approvers, err := rulesvc.GetApprovers(ctx, s.DB, *rule)
+	// audit log events for the request, saved along with it.
+	var events []access.RequestEvent
+
+	// break-glass access is for emergencies, so it isn't limited by allowed windows or blackout periods.
+	if grantNow && !breakGlass {
+		requested := req.GetTiming()
+		clipped, err := s.applyTimeConstraints(ctx, &req, rule.TimeConstraints)
+		if err != nil {
+			return nil, err
+		}
+		if clipped {
+			events = append(events, access.NewTimingChangeEvent(req.ID, now, nil, requested, *req.OverrideTiming))
+		}
+	} else if req.IsScheduled() && !breakGlass {
+		// scheduled requests which can't be granted at their start time are rejected straight away.
+		// The timing is only shortened when the request is approved, as blackout periods may change before then.
+		check := req
+		_, err = s.applyTimeConstraints(ctx, &check, rule.TimeConstraints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// usage limits are checked again when the request is approved, as other grants may have started by then.
+	if !breakGlass {
+		err = s.checkUsageLimits(ctx, req, rule.UsageLimits)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// requests which are approved automatically don't have any reviewers.
+	var approvers []string
+	if breakGlass {
+		// any approver on the rule can retrospectively review a break-glass request, regardless of approval stage.
+		approvers, err = rulesvc.GetApprovers(ctx, s.DB, *rule)
+	} else if reviewRequired {
+		// only the approvers for the first approval stage are added as reviewers when the request is created.
+		// Approvers for later stages are added as each stage is approved.
+		approvers, err = rulesvc.GetStageApprovers(ctx, s.DB, rule.Approval.GetStages()[0])
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// track items to insert in the database.
-	items := []ddb.Keyer{&req}
-
 	// create Reviewers for each approver in the Access Rule. Reviewers will see the request in the End User portal.
 	var reviewers []access.Reviewer
+	var reviewerIDs []string
 	for _, u := range approvers {
-		// users cannot approve their own requests.
+		// users cannot approve their own requests, or requests they made on behalf of someone else.
 		// We don't create a Reviewer for them, even if they are an approver on the Access Rule.
-		if u == req.RequestedBy {
+		if req.IsRequestor(u) {
 			continue
 		}
 
-		r := access.Reviewer{
+		reviewers = append(reviewers, access.Reviewer{
 			ReviewerID: u,
 			Request:    req,
-		}
-
-		reviewers = append(reviewers, r)
-		items = append(items, &r)
+		})
+		reviewerIDs = append(reviewerIDs, u)
 	}
 
-	log.Debugw("saving request", "request", req, "reviewers", reviewers)
+	// approvers who are away may have delegated their reviews to someone else.
+	delegates, delegateEvents, err := s.delegateReviewers(ctx, req, reviewerIDs)
+	if err != nil {
+		return nil, err
+	}
+	reviewers = append(reviewers, delegates...)
+	events = append(events, delegateEvents...)
 
 	// audit log event
 	reqEvent := access.NewRequestCreatedEvent(req.ID, req.CreatedAt, &req.RequestedBy)
+	if delegatedBy != nil {
+		reqEvent = access.NewDelegatedRequestCreatedEvent(req.ID, req.CreatedAt, delegatedBy, req.RequestedBy)
+	}
+	events = append(events, reqEvent)
 
 	//before saving the request check to see if there already is a active approved rule
-	if !rule.Approval.IsRequired() {
+	if grantNow {
 		start, end := req.GetInterval(access.WithNow(s.Clock.Now()))
 
 		rq := storage.ListRequestsForUserAndRuleAndRequestend{
@@ -123,52 +315,31 @@ func (s *Service) CreateRequest(ctx context.Context, user *identity.User, in typ
 			return nil, err
 		}
 		// This will check against the requests which do have grants already
-		overlaps := overlapsExistingGrant(start, end, rq.Result)
+		overlaps := overlapsExistingGrant(start, end, withSameArguments(req, rq.Result))
 		if overlaps {
 			return nil, ErrRequestOverlapsExistingGrant
 		}
 
 	}
 
-	items = append(items, &reqEvent)
-	// save the request.
-	err = s.DB.PutBatch(ctx, items...)
-	if err != nil {
-		return nil, err
-	}
-
-	err = s.EventPutter.Put(ctx, gevent.RequestCreated{Request: req})
-	// in a future PR we will shift these events out to be triggered by dynamo db streams
-	// This will currently put the app in a strange state if this fails
-	if err != nil {
-		return nil, err
+	p := preparedRequest{
+		rule:       rule,
+		request:    req,
+		reviewers:  reviewers,
+		events:     events,
+		breakGlass: breakGlass,
+		grantNow:   grantNow,
 	}
 
-	// check to see if it valid for instant approval
-	if !rule.Approval.IsRequired() {
-
-		log.Debugw("auto-approving", "request", req, "reviewers", reviewers)
-		updatedReq, err := s.Granter.CreateGrant(ctx, grantsvc.CreateGrantOpts{Request: req, AccessRule: *rule})
-		if err != nil {
-			return nil, err
-		}
-		req = *updatedReq
-		items, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, req, dbupdate.WithReviewers(reviewers))
-		if err != nil {
-			return nil, err
-		}
-		err = s.DB.PutBatch(ctx, items...)
-		if err != nil {
-			return nil, err
+	// pending requests expire if they aren't reviewed within the pending timeout of the rule.
+	if req.Status == access.PENDING && rule.TimeConstraints.PendingTimeoutSeconds != nil {
+		p.expiry = &access.RequestExpiry{
+			RequestID: req.ID,
+			ExpiresAt: now.Add(time.Duration(*rule.TimeConstraints.PendingTimeoutSeconds) * time.Second),
 		}
 	}
 
-	res := CreateRequestResult{
-		Request:   req,
-		Reviewers: reviewers,
-	}
-
-	return &res, nil
+	return &p, nil
 }
 
 func groupMatches(ruleGroups []string, userGroups []string) error {
@@ -185,17 +356,74 @@ func groupMatches(ruleGroups []string, userGroups []string) error {
 // requestIsValid checks that the request meets the constraints of the rule
 // Add additional constraint checks here in this method.
 func requestIsValid(request types.CreateRequestRequest, rule *rule.AccessRule) error {
+	var fields []apio.FieldError
 	if request.Timing.DurationSeconds > rule.TimeConstraints.MaxDurationSeconds {
+		fields = append(fields, apio.FieldError{
+			Field: "timing.durationSeconds",
+			Error: fmt.Sprintf("durationSeconds: %d exceeds the maximum duration seconds: %d", request.Timing.DurationSeconds, rule.TimeConstraints.MaxDurationSeconds),
+		})
+	}
+
+	values := requestFieldValues(request)
+	known := make(map[string]bool)
+	for _, f := range rule.Fields {
+		known[f.ID] = true
+		err := f.Validate(values[f.ID])
+		if err != nil {
+			fields = append(fields, apio.FieldError{
+				Field: "fields." + f.ID,
+				Error: err.Error(),
+			})
+		}
+	}
+	var unknown []string
+	for id := range values {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	sort.Strings(unknown)
+	for _, id := range unknown {
+		fields = append(fields, apio.FieldError{
+			Field: "fields." + id,
+			Error: "the access rule does not have this field",
+		})
+	}
+
+	if len(fields) > 0 {
 		return &apio.APIError{
 			Err:    errors.New("request validation failed"),
 			Status: http.StatusBadRequest,
-			Fields: []apio.FieldError{
-				{
-					Field: "timing.durationSeconds",
-					Error: fmt.Sprintf("durationSeconds: %d exceeds the maximum duration seconds: %d", request.Timing.DurationSeconds, rule.TimeConstraints.MaxDurationSeconds),
-				},
-			},
+			Fields: fields,
 		}
 	}
 	return nil
 }
+
+// policyInput returns the information which approval policies are evaluated against,
+// for a request for the user with the given duration.
+func policyInput(requestor *identity.User, duration time.Duration) rule.PolicyInput {
+	return rule.PolicyInput{
+		RequestorGroups: requestor.Groups,
+		Duration:        duration,
+	}
+}
+
+// requestFieldValues returns the request field values given in the request, keyed by field ID.
+// Empty values are treated as the field not being filled in.
+func requestFieldValues(request types.CreateRequestRequest) map[string]string {
+	if request.Fields == nil {
+		return nil
+	}
+	var values map[string]string
+	for id, v := range request.Fields.AdditionalProperties {
+		if v == "" {
+			continue
+		}
+		if values == nil {
+			values = make(map[string]string)
+		}
+		values[id] = v
+	}
+	return values
+}
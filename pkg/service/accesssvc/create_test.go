@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/apikit/apio"
@@ -36,11 +37,24 @@ func TestNewRequest(t *testing.T) {
 		want                    *CreateRequestResult
 		withCreateGrantResponse createGrantResponse
 		withGetGroupResponse    *storage.GetGroup
+		withGetUserResponse     *storage.GetUser
+		withDelegation          *identity.Delegation
 	}
 
 	clk := clock.NewMock()
 	autoApproval := types.AUTOMATIC
 	reviewed := types.REVIEWED
+	breakGlass := types.BREAKGLASS
+	useBreakGlass := true
+	delegator := "a"
+	onBehalfOf := "c"
+	awayApprover := "b"
+	oncallPolicy := "oncall"
+	contractorPolicy := "contractors"
+	policies := []rule.ApprovalPolicy{
+		{ID: "contractors", Action: types.REQUIREREVIEW, Conditions: rule.ApprovalConditions{RequestorGroups: []string{"contractors"}}},
+		{ID: "oncall", Action: types.AUTOAPPROVE, Conditions: rule.ApprovalConditions{RequestorGroups: []string{"oncall"}}},
+	}
 	testcases := []testcase{
 		{
 			name: "ok, no approvers so should auto approve",
@@ -57,6 +71,7 @@ func TestNewRequest(t *testing.T) {
 					UpdatedAt:      clk.Now(),
 					Grant:          &access.Grant{},
 					ApprovalMethod: &autoApproval,
+					Version:        1,
 				},
 			},
 			withCreateGrantResponse: createGrantResponse{
@@ -95,6 +110,43 @@ func TestNewRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "request fields are saved on the request",
+			giveUser: identity.User{Groups: []string{"a"}},
+			giveInput: types.CreateRequestRequest{
+				Fields: &types.RequestFieldInput{AdditionalProperties: map[string]string{"ticket": "OPS-123"}},
+			},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+				Fields: []rule.RequestField{{ID: "ticket", Label: "Ticket", Required: true}},
+			},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+					Data:           access.RequestData{Fields: map[string]string{"ticket": "OPS-123"}},
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "b",
+						Request: access.Request{
+							ID:             "-",
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+							Data:           access.RequestData{Fields: map[string]string{"ticket": "OPS-123"}},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:     "user not in correct group",
 			giveUser: identity.User{Groups: []string{"a"}},
@@ -140,6 +192,81 @@ func TestNewRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "approver who is away adds their delegate as a reviewer",
+			giveUser: identity.User{Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			withDelegation: &identity.Delegation{UserID: "b", DelegateID: "d", Start: clk.Now().Add(-time.Hour), End: clk.Now().Add(time.Hour)},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "b",
+						Request: access.Request{
+							ID:             "-",
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+						},
+					},
+					{
+						ReviewerID: "d",
+						Request: access.Request{
+							ID:             "-",
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+						},
+						OnBehalfOf: &awayApprover,
+					},
+				},
+			},
+		},
+		{
+			name:     "delegation which has ended is ignored",
+			giveUser: identity.User{Groups: []string{"a"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			withDelegation: &identity.Delegation{UserID: "b", DelegateID: "d", Start: clk.Now().Add(-time.Hour * 2), End: clk.Now().Add(-time.Hour)},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "b",
+						Request: access.Request{
+							ID:             "-",
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+						},
+					},
+				},
+			},
+		},
 		{
 			name:     "requestor is approver on access rule",
 			giveUser: identity.User{ID: "a", Groups: []string{"a"}},
@@ -216,6 +343,202 @@ func TestNewRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "break-glass grants access immediately and needs a retrospective review",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.CreateRequestRequest{BreakGlass: &useBreakGlass},
+			rule: &rule.AccessRule{
+				Groups:     []string{"a"},
+				BreakGlass: true,
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.NEEDS_RETROSPECTIVE_REVIEW,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &breakGlass,
+					Version:        1,
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "b",
+						Request: access.Request{
+							ID:             "-",
+							RequestedBy:    "a",
+							Status:         access.NEEDS_RETROSPECTIVE_REVIEW,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &breakGlass,
+						},
+					},
+				},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					ID:             "-",
+					RequestedBy:    "a",
+					Status:         access.NEEDS_RETROSPECTIVE_REVIEW,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					Grant:          &access.Grant{},
+					ApprovalMethod: &breakGlass,
+				},
+			},
+		},
+		{
+			name:      "break-glass not enabled on access rule",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.CreateRequestRequest{BreakGlass: &useBreakGlass},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"b"},
+				},
+			},
+			wantErr: ErrBreakGlassNotAllowed,
+		},
+		{
+			name:      "delegated request on behalf of another user",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a", "admins"}},
+			giveInput: types.CreateRequestRequest{OnBehalfOf: &onBehalfOf},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users: []string{"a", "b"},
+				},
+			},
+			withGetUserResponse: &storage.GetUser{
+				Result: &identity.User{ID: "c", Groups: []string{"a"}},
+			},
+			// the request is made for user 'c', and user 'a' who submitted it should not be a reviewer.
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:             "-",
+					RequestedBy:    "c",
+					DelegatedBy:    &delegator,
+					Status:         access.PENDING,
+					CreatedAt:      clk.Now(),
+					UpdatedAt:      clk.Now(),
+					ApprovalMethod: &reviewed,
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "b",
+						Request: access.Request{
+							ID:             "-",
+							RequestedBy:    "c",
+							DelegatedBy:    &delegator,
+							Status:         access.PENDING,
+							CreatedAt:      clk.Now(),
+							UpdatedAt:      clk.Now(),
+							ApprovalMethod: &reviewed,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "only administrators can request access on behalf of another user",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a"}},
+			giveInput: types.CreateRequestRequest{OnBehalfOf: &onBehalfOf},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+			},
+			withGetUserResponse: &storage.GetUser{
+				Result: &identity.User{ID: "c", Groups: []string{"a"}},
+			},
+			wantErr: ErrOnBehalfOfNotAllowed,
+		},
+		{
+			name:      "delegated user not in correct group",
+			giveUser:  identity.User{ID: "a", Groups: []string{"a", "admins"}},
+			giveInput: types.CreateRequestRequest{OnBehalfOf: &onBehalfOf},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+			},
+			withGetUserResponse: &storage.GetUser{
+				Result: &identity.User{ID: "c", Groups: []string{"b"}},
+			},
+			wantErr: ErrOnBehalfOfUserNoMatchingGroup,
+		},
+		{
+			name:     "approval policy auto-approves requests from a group",
+			giveUser: identity.User{ID: "a", Groups: []string{"a", "oncall"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users:    []string{"b"},
+					Policies: policies,
+				},
+			},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:               "-",
+					RequestedBy:      "a",
+					Status:           access.APPROVED,
+					CreatedAt:        clk.Now(),
+					UpdatedAt:        clk.Now(),
+					Grant:            &access.Grant{},
+					ApprovalMethod:   &autoApproval,
+					ApprovalPolicyID: &oncallPolicy,
+					Version:          1,
+				},
+			},
+			withCreateGrantResponse: createGrantResponse{
+				request: &access.Request{
+					ID:               "-",
+					RequestedBy:      "a",
+					Status:           access.APPROVED,
+					CreatedAt:        clk.Now(),
+					UpdatedAt:        clk.Now(),
+					Grant:            &access.Grant{},
+					ApprovalMethod:   &autoApproval,
+					ApprovalPolicyID: &oncallPolicy,
+				},
+			},
+		},
+		{
+			name:     "first matching approval policy decides the approval method",
+			giveUser: identity.User{ID: "a", Groups: []string{"a", "oncall", "contractors"}},
+			rule: &rule.AccessRule{
+				Groups: []string{"a"},
+				Approval: rule.Approval{
+					Users:    []string{"b"},
+					Policies: policies,
+				},
+			},
+			want: &CreateRequestResult{
+				Request: access.Request{
+					ID:               "-",
+					RequestedBy:      "a",
+					Status:           access.PENDING,
+					CreatedAt:        clk.Now(),
+					UpdatedAt:        clk.Now(),
+					ApprovalMethod:   &reviewed,
+					ApprovalPolicyID: &contractorPolicy,
+				},
+				Reviewers: []access.Reviewer{
+					{
+						ReviewerID: "b",
+						Request: access.Request{
+							ID:               "-",
+							RequestedBy:      "a",
+							Status:           access.PENDING,
+							CreatedAt:        clk.Now(),
+							UpdatedAt:        clk.Now(),
+							ApprovalMethod:   &reviewed,
+							ApprovalPolicyID: &contractorPolicy,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -223,7 +546,14 @@ func TestNewRequest(t *testing.T) {
 			db := ddbmock.New(t)
 			db.MockQueryWithErr(&storage.GetAccessRuleCurrent{Result: tc.rule}, tc.ruleErr)
 			db.MockQuery(tc.withGetGroupResponse)
+			db.MockQuery(tc.withGetUserResponse)
+			if tc.withDelegation != nil {
+				db.MockQuery(&storage.GetUserDelegation{Result: tc.withDelegation})
+			} else {
+				db.MockQueryWithErr(&storage.GetUserDelegation{}, ddb.ErrNoItems)
+			}
 			db.MockQuery(&storage.ListRequestReviewers{})
+			db.MockQuery(&storage.ListBlackouts{})
 			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
 			ctrl := gomock.NewController(t)
 
@@ -241,6 +571,7 @@ func TestNewRequest(t *testing.T) {
 				DB:          db,
 				Granter:     g,
 				EventPutter: ep,
+				AdminGroup:  "admins",
 			}
 			got, err := s.CreateRequest(context.Background(), &tc.giveUser, tc.giveInput)
 			if got != nil {
@@ -258,3 +589,75 @@ func TestNewRequest(t *testing.T) {
 	}
 
 }
+
+func TestRequestIsValid(t *testing.T) {
+	type testcase struct {
+		name       string
+		giveFields map[string]string
+		wantFields []apio.FieldError
+	}
+
+	ticketPattern := "[A-Z]+-[0-9]+"
+	r := rule.AccessRule{
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600},
+		Fields: []rule.RequestField{
+			{ID: "ticket", Label: "Ticket", Required: true, Regex: &ticketPattern},
+			{ID: "environment", Label: "Environment", Options: []string{"staging", "production"}},
+		},
+	}
+
+	testcases := []testcase{
+		{
+			name:       "ok",
+			giveFields: map[string]string{"ticket": "OPS-123", "environment": "staging"},
+		},
+		{
+			name:       "optional field can be omitted",
+			giveFields: map[string]string{"ticket": "OPS-123"},
+		},
+		{
+			name:       "missing required field",
+			giveFields: map[string]string{"environment": "staging"},
+			wantFields: []apio.FieldError{{Field: "fields.ticket", Error: "this field is required"}},
+		},
+		{
+			name:       "empty required field",
+			giveFields: map[string]string{"ticket": ""},
+			wantFields: []apio.FieldError{{Field: "fields.ticket", Error: "this field is required"}},
+		},
+		{
+			name:       "regex must match the whole value",
+			giveFields: map[string]string{"ticket": "see OPS-123"},
+			wantFields: []apio.FieldError{{Field: "fields.ticket", Error: "must match the pattern: [A-Z]+-[0-9]+"}},
+		},
+		{
+			name:       "value not in options",
+			giveFields: map[string]string{"ticket": "OPS-123", "environment": "dev"},
+			wantFields: []apio.FieldError{{Field: "fields.environment", Error: "must be one of: staging, production"}},
+		},
+		{
+			name:       "unknown field",
+			giveFields: map[string]string{"ticket": "OPS-123", "team": "platform"},
+			wantFields: []apio.FieldError{{Field: "fields.team", Error: "the access rule does not have this field"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := types.CreateRequestRequest{
+				Timing: types.RequestTiming{DurationSeconds: 60},
+				Fields: &types.RequestFieldInput{AdditionalProperties: tc.giveFields},
+			}
+			err := requestIsValid(in, &r)
+			if tc.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, &apio.APIError{
+				Err:    errors.New("request validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: tc.wantFields,
+			}, err)
+		})
+	}
+}
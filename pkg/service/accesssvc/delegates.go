@@ -0,0 +1,36 @@
+package accesssvc
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+)
+
+// delegateReviewers returns Reviewers for the delegates of any approvers who are currently away,
+// along with the audit log events recording who each delegate is reviewing on behalf of.
+//
+// approvers should only contain the approvers who are able to review the request.
+func (s *Service) delegateReviewers(ctx context.Context, request access.Request, approvers []string) ([]access.Reviewer, []access.RequestEvent, error) {
+	delegates, err := rulesvc.GetDelegates(ctx, s.DB, approvers, s.Clock.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	var reviewers []access.Reviewer
+	var events []access.RequestEvent
+	for _, d := range delegates {
+		// delegates can't review a request they made, or that was made for them.
+		if request.IsRequestor(d.UserID) {
+			continue
+		}
+		onBehalfOf := d.OnBehalfOf
+		reviewers = append(reviewers, access.Reviewer{
+			ReviewerID:    d.UserID,
+			Request:       request,
+			ApprovalStage: request.ApprovalStage,
+			OnBehalfOf:    &onBehalfOf,
+		})
+		events = append(events, access.NewReviewerDelegatedEvent(request.ID, s.Clock.Now(), d.UserID, d.OnBehalfOf))
+	}
+	return reviewers, events, nil
+}
@@ -24,8 +24,63 @@ var (
 
 	// ErrRequestOverlapsExistingGrant is returned if the request overlaps an existing grant
 	ErrRequestOverlapsExistingGrant = errors.New("this request overlaps an existing grant")
+
+	// ErrRequestCannotBeExtended is returned if the request is not approved or its grant is not active
+	ErrRequestCannotBeExtended = errors.New("only approved requests with an active grant can be extended")
+
+	// ErrRequestCannotBeReleased is returned if the requestor tries to release a request which doesn't have an active grant
+	ErrRequestCannotBeReleased = errors.New("only requests with an active grant can be released")
+
+	// ErrExtensionAlreadyPending is returned if the requestor asks for an extension while another extension is waiting on a review
+	ErrExtensionAlreadyPending = errors.New("this request already has a pending extension")
+
+	// ErrNoPendingExtension is returned when reviewing an extension for a request which has no pending extension
+	ErrNoPendingExtension = errors.New("this request has no pending extension")
+
+	// ErrBreakGlassNotAllowed is returned if a user requests break-glass access on an Access Rule which doesn't allow it
+	ErrBreakGlassNotAllowed = errors.New("break-glass access is not enabled for this access rule")
+
+	// ErrOnBehalfOfNotAllowed is returned if a user who isn't an administrator requests access on behalf of another user
+	ErrOnBehalfOfNotAllowed = errors.New("only administrators can request access on behalf of another user")
+
+	// ErrOnBehalfOfUserNotFound is returned if a user requests access on behalf of a user which doesn't exist
+	ErrOnBehalfOfUserNotFound = errors.New("the user to request access for was not found")
+
+	// ErrOnBehalfOfUserNoMatchingGroup is returned if a user requests access on behalf of a user who is not in a matching group for the access rule
+	ErrOnBehalfOfUserNoMatchingGroup = errors.New("the user to request access for was not in a matching group for the access rule")
+
+	// ErrReviewerAlreadyApproved is returned if a reviewer tries to approve a request which they have already approved.
+	// A delegate and the approver they are reviewing on behalf of can't both approve a request.
+	ErrReviewerAlreadyApproved = errors.New("you have already approved this request, or it has been approved on behalf of the same approver")
+
+	// ErrOutsideAllowedWindow is returned if access would start outside of the allowed windows of the Access Rule
+	ErrOutsideAllowedWindow = errors.New("access can only start within the allowed windows of the access rule")
+
+	// ErrExtensionOutsideTimeConstraints is returned if a grant already ends at the end of its allowed window or at the start of a blackout period, so it can't be extended
+	ErrExtensionOutsideTimeConstraints = errors.New("the grant can't be extended past the end of its allowed window or into a blackout period")
 )
 
+// AdmissionDeniedError is returned if the admission policy of the Access Rule denies a request.
+type AdmissionDeniedError struct {
+	Message string
+}
+
+func (e AdmissionDeniedError) Error() string {
+	if e.Message == "" {
+		return "the request was denied by the admission policy of the access rule"
+	}
+	return fmt.Sprintf("the request was denied by the admission policy of the access rule: %s", e.Message)
+}
+
+// BlackoutError is returned if access would start during a blackout period.
+type BlackoutError struct {
+	Reason string
+}
+
+func (e BlackoutError) Error() string {
+	return fmt.Sprintf("access can't be granted during a blackout period: %s", e.Reason)
+}
+
 // InvalidStatusError is returned if a user tries to review a request which wasn't PENDING.
 type InvalidStatusError struct {
 	Status access.Status
@@ -0,0 +1,92 @@
+package accesssvc
+
+import (
+	"context"
+
+	"github.com/common-fate/apikit/logger"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
+)
+
+// ExpirePendingRequests moves pending requests which have passed their expiry time to the EXPIRED status.
+// It is called on a schedule by the sweeper.
+//
+// Expiries for requests which are no longer pending are removed without changing the request.
+// If expiring a request fails, the error is logged and the expiry is kept so that it is retried on the next sweep.
+func (s *Service) ExpirePendingRequests(ctx context.Context) error {
+	log := logger.Get(ctx)
+	now := s.Clock.Now()
+	var count int
+	// pagination in case there are more expiries than fit in a single page.
+	hasMore := true
+	var next string
+	for hasMore {
+		q := storage.ListRequestExpiriesBefore{Before: now}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := s.DB.Query(ctx, &q, opts...)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		next = res.NextPage
+		hasMore = next != ""
+
+		count += len(q.Result)
+		for i := range q.Result {
+			e := &q.Result[i]
+			err = s.expireRequest(ctx, e.RequestID)
+			if err != nil {
+				log.Errorw("failed to expire request", "request.id", e.RequestID, "error", err)
+				continue
+			}
+			err = s.DB.Delete(ctx, e)
+			if err != nil {
+				log.Errorw("failed to delete request expiry", "request.id", e.RequestID, "error", err)
+			}
+		}
+	}
+
+	log.Infow("swept expired requests", "count", count)
+	return nil
+}
+
+// expireRequest sets the status of a request to EXPIRED if it is still pending.
+func (s *Service) expireRequest(ctx context.Context, requestID string) error {
+	q := storage.GetRequest{ID: requestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err == ddb.ErrNoItems {
+		// nothing to expire
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	request := *q.Result
+	if request.Status != access.PENDING {
+		// the request was reviewed or cancelled before it expired.
+		return nil
+	}
+
+	originalStatus := request.Status
+	request.Status = access.EXPIRED
+	request.UpdatedAt = s.Clock.Now()
+
+	// audit log event. Requests are expired by the system so there is no actor.
+	reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, nil, originalStatus, request.Status)
+
+	err = dbupdate.UpdateRequest(ctx, s.DB, &request, []ddb.Keyer{&reqEvent})
+	if err == dbupdate.ErrRequestConflict {
+		// the request was reviewed or cancelled while it was being expired.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.EventPutter.Put(ctx, gevent.RequestExpired{Request: request})
+}
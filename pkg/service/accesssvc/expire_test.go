@@ -0,0 +1,71 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpirePendingRequests(t *testing.T) {
+	type testcase struct {
+		name        string
+		withRequest access.Request
+		// wantEvent is the event expected to be emitted, if the request is expired.
+		wantEvent *gevent.RequestExpired
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+
+	testcases := []testcase{
+		{
+			name:        "pending request is expired",
+			withRequest: access.Request{ID: "req", Status: access.PENDING},
+			wantEvent: &gevent.RequestExpired{
+				Request: access.Request{ID: "req", Status: access.EXPIRED, UpdatedAt: now, Version: 1},
+			},
+		},
+		{
+			name:        "approved request is not changed",
+			withRequest: access.Request{ID: "req", Status: access.APPROVED},
+		},
+		{
+			name:        "cancelled request is not changed",
+			withRequest: access.Request{ID: "req", Status: access.CANCELLED},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErrWithResult(&storage.ListRequestExpiriesBefore{Result: []access.RequestExpiry{{RequestID: "req", ExpiresAt: now.Add(-time.Minute)}}}, &ddb.QueryResult{}, nil)
+			db.MockQuery(&storage.GetRequest{Result: &tc.withRequest})
+			// called by dbupdate.GetUpdateRequestItems
+			db.MockQuery(&storage.ListRequestReviewers{})
+
+			ctrl := gomock.NewController(t)
+			ep := mocks.NewMockEventPutter(ctrl)
+			if tc.wantEvent != nil {
+				ep.EXPECT().Put(gomock.Any(), *tc.wantEvent).Return(nil)
+			}
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				EventPutter: ep,
+			}
+			err := s.ExpirePendingRequests(context.Background())
+			assert.NoError(t, err)
+		})
+	}
+}
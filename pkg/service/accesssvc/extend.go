@@ -0,0 +1,418 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
+)
+
+type ExtendRequestOpts struct {
+	// RequestorID is the ID of the user asking for the extension.
+	RequestorID string
+	RequestID   string
+	// Duration is the amount of time to add to the grant.
+	Duration time.Duration
+	// Reason is optional on an extension
+	Reason *string
+}
+
+// ExtendRequest asks for more time on the active grant of an approved request.
+// The extension follows the approval policies of the Access Rule. If the extended grant doesn't need review,
+// the grant is extended straight away, otherwise the extension is saved as pending until it is reviewed.
+func (s *Service) ExtendRequest(ctx context.Context, opts ExtendRequestOpts) (*access.Request, error) {
+	q := storage.GetRequest{ID: opts.RequestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	request := *q.Result
+
+	// only the requestor can extend their own access.
+	if opts.RequestorID != request.RequestedBy {
+		return nil, ErrUserNotAuthorized
+	}
+	if !isExtendable(request, s.Clock.Now()) {
+		return nil, ErrRequestCannotBeExtended
+	}
+	if request.Extension != nil && request.Extension.IsPending() {
+		return nil, ErrExtensionAlreadyPending
+	}
+
+	rq := storage.GetAccessRuleCurrent{ID: request.Rule}
+	_, err = s.DB.Query(ctx, &rq)
+	if err == ddb.ErrNoItems {
+		return nil, ErrRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	rule := rq.Result
+
+	err = extensionIsValid(request, opts.Duration, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	request.Extension = &access.Extension{
+		Duration:    opts.Duration,
+		Reason:      opts.Reason,
+		Status:      access.ExtensionPending,
+		RequestedAt: now,
+	}
+	request.UpdatedAt = now
+
+	// audit log event
+	extEvent := access.NewExtensionEvent(request.ID, now, &opts.RequestorID, access.ExtensionPending)
+
+	reviewRequired := rule.Approval.IsRequired()
+	if len(rule.Approval.Policies) > 0 {
+		// approval policies are evaluated against the total duration of the grant once it has been extended.
+		uq := storage.GetUser{ID: request.RequestedBy}
+		_, err = s.DB.Query(ctx, &uq)
+		if err != nil {
+			return nil, err
+		}
+		duration := request.Grant.End.Sub(request.Grant.Start) + opts.Duration
+		reviewRequired, _ = rule.Approval.RequiresReview(policyInput(uq.Result, duration))
+	}
+
+	if !reviewRequired {
+		// the extension is approved automatically, so there is no reviewer.
+		return s.approveExtension(ctx, request, *rule, nil, nil, &extEvent)
+	}
+
+	// the extension is reviewed through the approval stages of the rule, starting from the first.
+	revq := storage.ListRequestReviewers{RequestID: request.ID}
+	_, err = s.DB.Query(ctx, &revq)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	stageReviewers, err := s.extensionStageReviewers(ctx, request, *rule, revq.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dbupdate.UpdateRequest(ctx, s.DB, &request, []ddb.Keyer{&extEvent})
+	if err != nil {
+		return nil, err
+	}
+	// In a future PR we will shift these events out to be triggered by dynamo db streams
+	// This will currently put the app in a strange state if this fails
+	err = s.EventPutter.Put(ctx, gevent.RequestExtensionPending{Request: request, Reviewers: reviewerIDs(stageReviewers)})
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+type ReviewExtensionOpts struct {
+	ReviewerID      string
+	ReviewerIsAdmin bool
+	RequestID       string
+	Decision        access.Decision
+}
+
+// ReviewExtension approves or declines the pending extension of a request.
+// Extensions are reviewed through the approval stages of the Access Rule in the same way as requests: each stage must be
+// approved by the required number of its approvers before the extension moves on to the next one.
+// Once the last stage is approved, the grant is extended in the Access Handler. A single decline declines the extension.
+func (s *Service) ReviewExtension(ctx context.Context, opts ReviewExtensionOpts) (*access.Request, error) {
+	q := storage.GetRequest{ID: opts.RequestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	request := *q.Result
+
+	if request.Extension == nil || !request.Extension.IsPending() {
+		return nil, ErrNoPendingExtension
+	}
+
+	ruleq := storage.GetAccessRuleCurrent{ID: request.Rule}
+	_, err = s.DB.Query(ctx, &ruleq)
+	if err == ddb.ErrNoItems {
+		return nil, ErrRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	accessRule := *ruleq.Result
+
+	rq := storage.ListRequestReviewers{RequestID: request.ID}
+	_, err = s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	stageReviewers, err := s.extensionStageReviewers(ctx, request, accessRule, rq.Result)
+	if err != nil {
+		return nil, err
+	}
+	approverID, ok := canReviewExtension(opts, request, stageReviewers)
+	if !ok {
+		return nil, ErrUserNotAuthorized
+	}
+
+	if opts.Decision == access.DecisionApproved {
+		// the grant may have ended while the extension was waiting on a review.
+		if !isExtendable(request, s.Clock.Now()) {
+			return nil, ErrRequestCannotBeExtended
+		}
+		if request.Extension.HasApproved(approverID) {
+			return nil, ErrReviewerAlreadyApproved
+		}
+		ext := *request.Extension
+		ext.StageApprovedBy = append(append([]string{}, ext.StageApprovedBy...), approverID)
+		request.Extension = &ext
+
+		stages := accessRule.Approval.GetStages()
+		stage := extensionStage(ext, stages)
+		stageApproved := len(ext.StageApprovedBy) >= stages[stage].Threshold()
+		if stageApproved && stage == len(stages)-1 {
+			return s.approveExtension(ctx, request, accessRule, &opts.ReviewerID, rq.Result)
+		}
+
+		// the extension remains pending until enough reviewers have approved the current stage, and then moves on to the next one.
+		if stageApproved {
+			ext.ApprovalStage = stage + 1
+			ext.StageApprovedBy = nil
+		}
+		request.UpdatedAt = s.Clock.Now()
+		err = dbupdate.UpdateRequest(ctx, s.DB, &request, nil, dbupdate.WithReviewers(rq.Result))
+		if err != nil {
+			return nil, err
+		}
+		if stageApproved {
+			next, err := s.extensionStageReviewers(ctx, request, accessRule, rq.Result)
+			if err != nil {
+				return nil, err
+			}
+			err = s.EventPutter.Put(ctx, gevent.RequestExtensionPending{Request: request, Reviewers: reviewerIDs(next)})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &request, nil
+	}
+
+	now := s.Clock.Now()
+	ext := *request.Extension
+	ext.Status = access.ExtensionDeclined
+	ext.ReviewedBy = &opts.ReviewerID
+	request.Extension = &ext
+	request.UpdatedAt = now
+
+	// audit log event
+	extEvent := access.NewExtensionEvent(request.ID, now, &opts.ReviewerID, access.ExtensionDeclined)
+	err = dbupdate.UpdateRequest(ctx, s.DB, &request, []ddb.Keyer{&extEvent}, dbupdate.WithReviewers(rq.Result))
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// approveExtension extends the grant in the Access Handler and saves the extended request.
+// If the extended grant would run past the end of its allowed window or into a blackout period, the extension is shortened.
+// The extension is rejected if the extended grant would exceed the usage limits of the access rule.
+// reviewerID is nil if the extension was approved automatically.
+// If reviewers is nil, the reviewers of the request are fetched from the database.
+func (s *Service) approveExtension(ctx context.Context, request access.Request, accessRule rule.AccessRule, reviewerID *string, reviewers []access.Reviewer, events ...ddb.Keyer) (*access.Request, error) {
+	end := request.Grant.End
+	newEnd, clippedReason, err := s.constrainExtension(ctx, *request.Grant, end.Add(request.Extension.Duration), accessRule.TimeConstraints)
+	if err != nil {
+		return nil, err
+	}
+
+	// the extended grant counts towards the usage limits of the rule for its whole duration.
+	err = s.checkUsageLimitsForInterval(ctx, request, request.Grant.Start, newEnd, accessRule.UsageLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	// the extended grant must not overlap another grant for the user and rule.
+	rq := storage.ListRequestsForUserAndRuleAndRequestend{
+		UserID:               request.RequestedBy,
+		RuleID:               request.Rule,
+		RequestEndComparator: storage.GreaterThanEqual,
+		CompareTo:            end,
+	}
+	_, err = s.DB.Query(ctx, &rq)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	var others []access.Request
+	for _, r := range rq.Result {
+		if r.ID != request.ID {
+			others = append(others, r)
+		}
+	}
+	if overlapsExistingGrant(end, newEnd, withSameArguments(request, others)) {
+		return nil, ErrRequestOverlapsExistingGrant
+	}
+
+	var opts []func(*dbupdate.UpdateRequestOpts)
+	if reviewers != nil {
+		opts = append(opts, dbupdate.WithReviewers(reviewers))
+	}
+
+	// claim the request before extending the grant, so that the grant isn't extended twice
+	// if the extension is approved by two reviewers at the same time.
+	claimed := request
+	err = dbupdate.ClaimRequest(ctx, s.DB, &claimed, opts...)
+	if err != nil {
+		return nil, err
+	}
+	request.Version = claimed.Version
+
+	// if the grants for some of the additional targets couldn't be extended, the request is returned with the grants which were,
+	// so that they are saved before the error is returned.
+	updatedRequest, extendErr := s.Granter.ExtendGrant(ctx, grantsvc.ExtendGrantOpts{Request: request, End: newEnd})
+	if updatedRequest == nil {
+		return nil, extendErr
+	}
+	request = *updatedRequest
+
+	now := s.Clock.Now()
+	oldTiming := request.GetTiming()
+	newTiming := oldTiming
+	newTiming.Duration += newEnd.Sub(end)
+	request.OverrideTiming = &newTiming
+	if clippedReason != nil {
+		request.TimingClippedReason = clippedReason
+	}
+
+	ext := *request.Extension
+	ext.Status = access.ExtensionApproved
+	ext.ReviewedBy = reviewerID
+	request.Extension = &ext
+	request.UpdatedAt = now
+
+	// audit log events
+	extEvent := access.NewExtensionEvent(request.ID, now, reviewerID, access.ExtensionApproved)
+	timingEvent := access.NewTimingChangeEvent(request.ID, now, reviewerID, oldTiming, newTiming)
+	items := append([]ddb.Keyer{&extEvent, &timingEvent}, events...)
+
+	err = dbupdate.UpdateRequest(ctx, s.DB, &request, items, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := request.RequestedBy
+	if reviewerID != nil {
+		actor = *reviewerID
+	}
+	// In a future PR we will shift these events out to be triggered by dynamo db streams
+	// This will currently put the app in a strange state if this fails
+	err = s.EventPutter.Put(ctx, gevent.GrantExtended{Grant: request.Grant.ToAHGrant(request.ID), Actor: actor})
+	if err != nil {
+		return nil, err
+	}
+	if extendErr != nil {
+		return nil, extendErr
+	}
+	return &request, nil
+}
+
+// requests can be extended while they are approved and their grant is active.
+func isExtendable(request access.Request, now time.Time) bool {
+	return request.Status == access.APPROVED && request.Grant != nil && request.Grant.Status == ahTypes.ACTIVE && request.Grant.End.After(now)
+}
+
+// extensionIsValid checks that the extended grant meets the time constraints of the rule.
+func extensionIsValid(request access.Request, duration time.Duration, rule *rule.AccessRule) error {
+	total := int((request.Grant.End.Sub(request.Grant.Start) + duration).Seconds())
+	if total > rule.TimeConstraints.MaxDurationSeconds {
+		return &apio.APIError{
+			Err:    errors.New("extension validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: "durationSeconds",
+					Error: fmt.Sprintf("the extended grant duration of %d seconds exceeds the maximum duration seconds: %d", total, rule.TimeConstraints.MaxDurationSeconds),
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// extensionStage returns the approval stage the extension is waiting on.
+// The stages of the access rule may have been changed since the extension was requested, so the last stage is used
+// if the extension has moved past the stages the rule now has.
+func extensionStage(ext access.Extension, stages []rule.ApprovalStage) int {
+	if ext.ApprovalStage >= len(stages) {
+		return len(stages) - 1
+	}
+	return ext.ApprovalStage
+}
+
+// extensionStageReviewers returns the users who can review the current approval stage of the extension, mapped to the approver
+// whose authority their approval counts towards. These are the approvers for the stage in the access rule, and the delegates
+// of the request who review on behalf of one of them.
+// Neither the requestor nor the user who delegated the request can review the extension.
+func (s *Service) extensionStageReviewers(ctx context.Context, request access.Request, accessRule rule.AccessRule, reviewers []access.Reviewer) (map[string]string, error) {
+	stages := accessRule.Approval.GetStages()
+	approvers, err := rulesvc.GetStageApprovers(ctx, s.DB, stages[extensionStage(*request.Extension, stages)])
+	if err != nil {
+		return nil, err
+	}
+	stageReviewers := make(map[string]string)
+	for _, u := range approvers {
+		if !request.IsRequestor(u) {
+			stageReviewers[u] = u
+		}
+	}
+	for _, r := range reviewers {
+		if r.OnBehalfOf == nil || request.IsRequestor(r.ReviewerID) {
+			continue
+		}
+		if _, ok := stageReviewers[r.ReviewerID]; ok {
+			continue
+		}
+		if _, ok := stageReviewers[*r.OnBehalfOf]; ok {
+			stageReviewers[r.ReviewerID] = *r.OnBehalfOf
+		}
+	}
+	return stageReviewers, nil
+}
+
+// reviewerIDs returns the sorted IDs of the reviewers returned by extensionStageReviewers.
+func reviewerIDs(stageReviewers map[string]string) []string {
+	ids := make([]string, 0, len(stageReviewers))
+	for id := range stageReviewers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// users can review extensions if they are a Granted administrator,
+// or if they can review the current approval stage of the extension.
+// It returns the approver whose authority the review counts towards, which is the reviewer
+// unless they are reviewing as a delegate.
+func canReviewExtension(opts ReviewExtensionOpts, request access.Request, stageReviewers map[string]string) (string, bool) {
+	if request.IsRequestor(opts.ReviewerID) {
+		return "", false
+	}
+	if approverID, ok := stageReviewers[opts.ReviewerID]; ok {
+		return approverID, true
+	}
+	if opts.ReviewerIsAdmin {
+		return opts.ReviewerID, true
+	}
+	return "", false
+}
@@ -0,0 +1,460 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendRequest(t *testing.T) {
+	type testcase struct {
+		name               string
+		give               ExtendRequestOpts
+		withRequest        access.Request
+		withRule           rule.AccessRule
+		withExistingGrants []access.Request
+		withBlackouts      []access.Blackout
+		withExtendedGrant  *access.Grant
+		withExtendErr      error
+		want               *access.Request
+		// wantReviewers are the users who are asked to review a pending extension.
+		wantReviewers []string
+		wantErr       error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	grant := access.Grant{Start: now.Add(-time.Minute), End: now.Add(time.Minute), Status: ahTypes.ACTIVE}
+	approved := access.Request{
+		ID:              "req",
+		RequestedBy:     "a",
+		Status:          access.APPROVED,
+		RequestedTiming: access.Timing{Duration: 2 * time.Minute},
+		Grant:           &grant,
+	}
+	autoRule := rule.AccessRule{TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 600}}
+	reviewedRule := rule.AccessRule{TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 600}, Approval: rule.Approval{Users: []string{"b"}}}
+	quotaRule := rule.AccessRule{
+		TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 600},
+		UsageLimits:     &types.UsageLimits{Quota: &types.UsageQuota{MaxDurationSeconds: 150, WindowSeconds: int((24 * time.Hour).Seconds())}},
+	}
+
+	extendedGrant := grant
+	extendedGrant.End = grant.End.Add(time.Minute)
+	clippedGrant := grant
+	clippedGrant.End = grant.End.Add(30 * time.Second)
+	clippedReason := "access ends at 1970-01-01T00:01:30Z, when a blackout period starts: change freeze"
+
+	testcases := []testcase{
+		{
+			name:        "pending when the rule requires approval",
+			give:        ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest: approved,
+			withRule:    reviewedRule,
+			want: &access.Request{
+				ID:              "req",
+				RequestedBy:     "a",
+				Status:          access.APPROVED,
+				RequestedTiming: access.Timing{Duration: 2 * time.Minute},
+				Grant:           &grant,
+				Extension:       &access.Extension{Duration: time.Minute, Status: access.ExtensionPending, RequestedAt: now},
+				UpdatedAt:       now,
+				Version:         1,
+			},
+			wantReviewers: []string{"b"},
+		},
+		{
+			name:              "extended automatically when the rule doesn't require approval",
+			give:              ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest:       approved,
+			withRule:          autoRule,
+			withExtendedGrant: &extendedGrant,
+			want: &access.Request{
+				ID:              "req",
+				RequestedBy:     "a",
+				Status:          access.APPROVED,
+				RequestedTiming: access.Timing{Duration: 2 * time.Minute},
+				OverrideTiming:  &access.Timing{Duration: 3 * time.Minute},
+				Grant:           &extendedGrant,
+				Extension:       &access.Extension{Duration: time.Minute, Status: access.ExtensionApproved, RequestedAt: now},
+				UpdatedAt:       now,
+				Version:         2,
+			},
+		},
+		{
+			name:        "only the requestor can extend",
+			give:        ExtendRequestOpts{RequestorID: "b", RequestID: "req", Duration: time.Minute},
+			withRequest: approved,
+			withRule:    autoRule,
+			wantErr:     ErrUserNotAuthorized,
+		},
+		{
+			name:        "pending requests cannot be extended",
+			give:        ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest: access.Request{ID: "req", RequestedBy: "a", Status: access.PENDING},
+			withRule:    autoRule,
+			wantErr:     ErrRequestCannotBeExtended,
+		},
+		{
+			name: "extension already pending",
+			give: ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest: access.Request{
+				ID:          "req",
+				RequestedBy: "a",
+				Status:      access.APPROVED,
+				Grant:       &grant,
+				Extension:   &access.Extension{Duration: time.Minute, Status: access.ExtensionPending},
+			},
+			withRule: reviewedRule,
+			wantErr:  ErrExtensionAlreadyPending,
+		},
+		{
+			name:        "exceeds max duration",
+			give:        ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: 10 * time.Minute},
+			withRequest: approved,
+			withRule:    autoRule,
+			wantErr: &apio.APIError{
+				Err:    errors.New("extension validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "durationSeconds",
+						Error: "the extended grant duration of 720 seconds exceeds the maximum duration seconds: 600",
+					},
+				},
+			},
+		},
+		{
+			name:              "extension is shortened to end at a blackout",
+			give:              ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest:       approved,
+			withRule:          autoRule,
+			withBlackouts:     []access.Blackout{{Start: now.Add(90 * time.Second), End: now.Add(time.Hour), Reason: "change freeze"}},
+			withExtendedGrant: &clippedGrant,
+			want: &access.Request{
+				ID:                  "req",
+				RequestedBy:         "a",
+				Status:              access.APPROVED,
+				RequestedTiming:     access.Timing{Duration: 2 * time.Minute},
+				OverrideTiming:      &access.Timing{Duration: 150 * time.Second},
+				TimingClippedReason: &clippedReason,
+				Grant:               &clippedGrant,
+				Extension:           &access.Extension{Duration: time.Minute, Status: access.ExtensionApproved, RequestedAt: now},
+				UpdatedAt:           now,
+				Version:             2,
+			},
+		},
+		{
+			name:          "grant already ends at a blackout",
+			give:          ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest:   approved,
+			withRule:      autoRule,
+			withBlackouts: []access.Blackout{{Start: now.Add(30 * time.Second), End: now.Add(time.Hour), Reason: "change freeze"}},
+			wantErr:       ErrExtensionOutsideTimeConstraints,
+		},
+		{
+			name:              "additional target fails to extend",
+			give:              ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest:       approved,
+			withRule:          autoRule,
+			withExtendedGrant: &extendedGrant,
+			withExtendErr:     errors.New("extended 1 of 2 grants for the request, the others could not be extended"),
+			wantErr:           errors.New("extended 1 of 2 grants for the request, the others could not be extended"),
+		},
+		{
+			name:        "extended grant exceeds the usage quota",
+			give:        ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest: approved,
+			withRule:    quotaRule,
+			wantErr: &apio.APIError{
+				Err:    errors.New("request exceeds the usage limits of the access rule"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "timing.durationSeconds",
+						Error: "this request would give you 3m0s of access in the past 24h0m0s, which exceeds the limit of 2m30s",
+					},
+				},
+			},
+		},
+		{
+			name:               "overlaps another grant",
+			give:               ExtendRequestOpts{RequestorID: "a", RequestID: "req", Duration: time.Minute},
+			withRequest:        approved,
+			withRule:           autoRule,
+			withExistingGrants: []access.Request{approved, {ID: "other", Grant: &access.Grant{Start: now.Add(90 * time.Second), End: now.Add(time.Hour)}}},
+			wantErr:            ErrRequestOverlapsExistingGrant,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRequest{Result: &tc.withRequest})
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &tc.withRule})
+			db.MockQuery(&storage.ListRequestReviewers{Result: []access.Reviewer{}})
+			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{Result: tc.withExistingGrants})
+			db.MockQuery(&storage.ListBlackouts{Result: tc.withBlackouts})
+
+			ctrl := gomock.NewController(t)
+			g := mocks.NewMockGranter(ctrl)
+			if tc.withExtendedGrant != nil {
+				pending := tc.withRequest
+				pending.Extension = &access.Extension{Duration: tc.give.Duration, Reason: tc.give.Reason, Status: access.ExtensionPending, RequestedAt: now}
+				pending.UpdatedAt = now
+				// the request is claimed before the grant is extended.
+				pending.Version = 1
+				extended := pending
+				extended.Grant = tc.withExtendedGrant
+				g.EXPECT().ExtendGrant(gomock.Any(), grantsvc.ExtendGrantOpts{Request: pending, End: tc.withExtendedGrant.End}).Return(&extended, tc.withExtendErr)
+			}
+			ep := mocks.NewMockEventPutter(ctrl)
+			if tc.withExtendErr != nil {
+				// the grants which were extended are saved before the error is returned.
+				ep.EXPECT().Put(gomock.Any(), gomock.AssignableToTypeOf(gevent.GrantExtended{})).Return(nil)
+			}
+			if tc.wantReviewers != nil {
+				ep.EXPECT().Put(gomock.Any(), gevent.RequestExtensionPending{Request: *tc.want, Reviewers: tc.wantReviewers}).Return(nil)
+			}
+			ep.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				Granter:     g,
+				EventPutter: ep,
+			}
+			got, err := s.ExtendRequest(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestReviewExtension(t *testing.T) {
+	type testcase struct {
+		name              string
+		give              ReviewExtensionOpts
+		withRequest       access.Request
+		withRule          rule.AccessRule
+		withReviewers     []access.Reviewer
+		withExtendedGrant *access.Grant
+		// wantApprovedBy are the approvals of the current stage of the extension when the grant is extended.
+		wantApprovedBy []string
+		wantExtension  access.Extension
+		wantEvent      *gevent.RequestExtensionPending
+		wantErr        error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	grant := access.Grant{Start: now.Add(-time.Minute), End: now.Add(time.Minute), Status: ahTypes.ACTIVE}
+	extendedGrant := grant
+	extendedGrant.End = grant.End.Add(time.Minute)
+	ext := access.Extension{Duration: time.Minute, Status: access.ExtensionPending}
+	pending := access.Request{
+		ID:              "req",
+		RequestedBy:     "a",
+		Status:          access.APPROVED,
+		RequestedTiming: access.Timing{Duration: 2 * time.Minute},
+		Grant:           &grant,
+		Extension:       &ext,
+	}
+	constraints := types.TimeConstraints{MaxDurationSeconds: 600}
+	reviewedRule := rule.AccessRule{TimeConstraints: constraints, Approval: rule.Approval{Users: []string{"b"}}}
+	twoApprovalsRule := rule.AccessRule{TimeConstraints: constraints, Approval: rule.Approval{Users: []string{"b", "c"}, RequiredApprovals: 2}}
+	stagedRule := rule.AccessRule{TimeConstraints: constraints, Approval: rule.Approval{Stages: []rule.ApprovalStage{{Users: []string{"b"}}, {Users: []string{"c"}}}}}
+	reviewers := []access.Reviewer{{ReviewerID: "b", Request: pending}}
+	b, c, d := "b", "c", "d"
+
+	approvedByB := ext
+	approvedByB.StageApprovedBy = []string{"b"}
+	secondStage := ext
+	secondStage.ApprovalStage = 1
+
+	withExtension := func(e access.Extension) access.Request {
+		r := pending
+		r.Extension = &e
+		return r
+	}
+
+	testcases := []testcase{
+		{
+			name:              "approved",
+			give:              ReviewExtensionOpts{ReviewerID: "b", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:       pending,
+			withRule:          reviewedRule,
+			withReviewers:     reviewers,
+			withExtendedGrant: &extendedGrant,
+			wantApprovedBy:    []string{"b"},
+			wantExtension:     access.Extension{Duration: time.Minute, Status: access.ExtensionApproved, ReviewedBy: &b, StageApprovedBy: []string{"b"}},
+		},
+		{
+			name:          "declined",
+			give:          ReviewExtensionOpts{ReviewerID: "b", RequestID: "req", Decision: access.DecisionDECLINED},
+			withRequest:   pending,
+			withRule:      reviewedRule,
+			withReviewers: reviewers,
+			wantExtension: access.Extension{Duration: time.Minute, Status: access.ExtensionDeclined, ReviewedBy: &b},
+		},
+		{
+			name:              "admin can review",
+			give:              ReviewExtensionOpts{ReviewerID: "c", ReviewerIsAdmin: true, RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:       pending,
+			withRule:          reviewedRule,
+			withReviewers:     reviewers,
+			withExtendedGrant: &extendedGrant,
+			wantApprovedBy:    []string{"c"},
+			wantExtension:     access.Extension{Duration: time.Minute, Status: access.ExtensionApproved, ReviewedBy: &c, StageApprovedBy: []string{"c"}},
+		},
+		{
+			name:              "delegate approves on behalf of an approver",
+			give:              ReviewExtensionOpts{ReviewerID: "d", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:       pending,
+			withRule:          reviewedRule,
+			withReviewers:     []access.Reviewer{{ReviewerID: "d", Request: pending, OnBehalfOf: &b}},
+			withExtendedGrant: &extendedGrant,
+			wantApprovedBy:    []string{"b"},
+			wantExtension:     access.Extension{Duration: time.Minute, Status: access.ExtensionApproved, ReviewedBy: &d, StageApprovedBy: []string{"b"}},
+		},
+		{
+			name:          "not a reviewer",
+			give:          ReviewExtensionOpts{ReviewerID: "c", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:   pending,
+			withRule:      reviewedRule,
+			withReviewers: reviewers,
+			wantErr:       ErrUserNotAuthorized,
+		},
+		{
+			name:          "requestor cannot review their own extension",
+			give:          ReviewExtensionOpts{ReviewerID: "a", ReviewerIsAdmin: true, RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:   pending,
+			withRule:      reviewedRule,
+			withReviewers: reviewers,
+			wantErr:       ErrUserNotAuthorized,
+		},
+		{
+			name:          "no pending extension",
+			give:          ReviewExtensionOpts{ReviewerID: "b", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:   access.Request{ID: "req", RequestedBy: "a", Status: access.APPROVED, Grant: &grant},
+			withRule:      reviewedRule,
+			withReviewers: reviewers,
+			wantErr:       ErrNoPendingExtension,
+		},
+		{
+			name:          "pending until the required number of approvals",
+			give:          ReviewExtensionOpts{ReviewerID: "b", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:   pending,
+			withRule:      twoApprovalsRule,
+			withReviewers: reviewers,
+			wantExtension: approvedByB,
+		},
+		{
+			name:              "approved by the second approver",
+			give:              ReviewExtensionOpts{ReviewerID: "c", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:       withExtension(approvedByB),
+			withRule:          twoApprovalsRule,
+			withReviewers:     reviewers,
+			withExtendedGrant: &extendedGrant,
+			wantApprovedBy:    []string{"b", "c"},
+			wantExtension:     access.Extension{Duration: time.Minute, Status: access.ExtensionApproved, ReviewedBy: &c, StageApprovedBy: []string{"b", "c"}},
+		},
+		{
+			name:          "approver cannot approve twice",
+			give:          ReviewExtensionOpts{ReviewerID: "b", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:   withExtension(approvedByB),
+			withRule:      twoApprovalsRule,
+			withReviewers: reviewers,
+			wantErr:       ErrReviewerAlreadyApproved,
+		},
+		{
+			name:          "moves on to the next approval stage",
+			give:          ReviewExtensionOpts{ReviewerID: "b", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:   pending,
+			withRule:      stagedRule,
+			withReviewers: reviewers,
+			wantExtension: secondStage,
+			wantEvent:     &gevent.RequestExtensionPending{Request: withExtension(secondStage), Reviewers: []string{"c"}},
+		},
+		{
+			name:          "approver for a later stage cannot review the current stage",
+			give:          ReviewExtensionOpts{ReviewerID: "c", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:   pending,
+			withRule:      stagedRule,
+			withReviewers: reviewers,
+			wantErr:       ErrUserNotAuthorized,
+		},
+		{
+			name:              "approved at the last stage",
+			give:              ReviewExtensionOpts{ReviewerID: "c", RequestID: "req", Decision: access.DecisionApproved},
+			withRequest:       withExtension(secondStage),
+			withRule:          stagedRule,
+			withReviewers:     reviewers,
+			withExtendedGrant: &extendedGrant,
+			wantApprovedBy:    []string{"c"},
+			wantExtension:     access.Extension{Duration: time.Minute, Status: access.ExtensionApproved, ReviewedBy: &c, ApprovalStage: 1, StageApprovedBy: []string{"c"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRequest{Result: &tc.withRequest})
+			db.MockQuery(&storage.ListRequestReviewers{Result: tc.withReviewers})
+			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{Result: []access.Request{}})
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &tc.withRule})
+			db.MockQuery(&storage.ListBlackouts{})
+
+			ctrl := gomock.NewController(t)
+			g := mocks.NewMockGranter(ctrl)
+			if tc.withExtendedGrant != nil {
+				// the request is claimed before the grant is extended.
+				claimed := tc.withRequest
+				claimedExt := *claimed.Extension
+				claimedExt.StageApprovedBy = tc.wantApprovedBy
+				claimed.Extension = &claimedExt
+				claimed.Version = 1
+				extended := claimed
+				extended.Grant = tc.withExtendedGrant
+				g.EXPECT().ExtendGrant(gomock.Any(), grantsvc.ExtendGrantOpts{Request: claimed, End: tc.withExtendedGrant.End}).Return(&extended, nil)
+			}
+			ep := mocks.NewMockEventPutter(ctrl)
+			if tc.wantEvent != nil {
+				want := *tc.wantEvent
+				want.Request.UpdatedAt = now
+				want.Request.Version = 1
+				ep.EXPECT().Put(gomock.Any(), want).Return(nil)
+			} else {
+				ep.EXPECT().Put(gomock.Any(), gomock.Not(gomock.AssignableToTypeOf(gevent.RequestExtensionPending{}))).Return(nil).AnyTimes()
+			}
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				Granter:     g,
+				EventPutter: ep,
+			}
+			got, err := s.ReviewExtension(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tc.wantExtension, *got.Extension)
+			}
+		})
+	}
+}
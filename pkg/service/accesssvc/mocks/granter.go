@@ -51,6 +51,21 @@ func (mr *MockGranterMockRecorder) CreateGrant(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGrant", reflect.TypeOf((*MockGranter)(nil).CreateGrant), arg0, arg1)
 }
 
+// ExtendGrant mocks base method.
+func (m *MockGranter) ExtendGrant(arg0 context.Context, arg1 grantsvc.ExtendGrantOpts) (*access.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendGrant", arg0, arg1)
+	ret0, _ := ret[0].(*access.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendGrant indicates an expected call of ExtendGrant.
+func (mr *MockGranterMockRecorder) ExtendGrant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendGrant", reflect.TypeOf((*MockGranter)(nil).ExtendGrant), arg0, arg1)
+}
+
 // RevokeGrant mocks base method.
 func (m *MockGranter) RevokeGrant(arg0 context.Context, arg1 grantsvc.RevokeGrantOpts) (*access.Request, error) {
 	m.ctrl.T.Helper()
@@ -65,3 +80,17 @@ func (mr *MockGranterMockRecorder) RevokeGrant(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeGrant", reflect.TypeOf((*MockGranter)(nil).RevokeGrant), arg0, arg1)
 }
+
+// ValidateGrant mocks base method.
+func (m *MockGranter) ValidateGrant(arg0 context.Context, arg1 grantsvc.CreateGrantOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateGrant", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateGrant indicates an expected call of ValidateGrant.
+func (mr *MockGranterMockRecorder) ValidateGrant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGrant", reflect.TypeOf((*MockGranter)(nil).ValidateGrant), arg0, arg1)
+}
@@ -0,0 +1,38 @@
+package accesssvc
+
+import (
+	"context"
+
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+type PreviewRequestResult struct {
+	// Request is the request as it would be created.
+	Request   access.Request
+	Reviewers []access.Reviewer
+	// GrantError is set if the Access Handler would fail to grant access for the request.
+	GrantError error
+}
+
+// PreviewRequest runs the same checks as CreateRequest, and returns the request that would be created.
+// Nothing is saved to the database and no access is granted.
+// Returns an error if the request is invalid.
+func (s *Service) PreviewRequest(ctx context.Context, user *identity.User, in types.CreateRequestRequest) (*PreviewRequestResult, error) {
+	p, err := s.prepareRequest(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	// requests which need review are validated too, as they would fail to be granted once they are approved.
+	grantErr := s.Granter.ValidateGrant(ctx, grantsvc.CreateGrantOpts{Request: p.request, AccessRule: *p.rule})
+
+	res := PreviewRequestResult{
+		Request:    p.request,
+		Reviewers:  p.reviewers,
+		GrantError: grantErr,
+	}
+	return &res, nil
+}
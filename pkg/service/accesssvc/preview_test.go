@@ -0,0 +1,99 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	accessMocks "github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewRequest(t *testing.T) {
+	type testcase struct {
+		name                      string
+		giveUser                  identity.User
+		rule                      *rule.AccessRule
+		withValidateGrantResponse error
+		wantErr                   error
+		wantStatus                access.Status
+		wantReviewers             []string
+		wantGrantErr              error
+	}
+
+	groupErr := errors.New("group Developers was not found")
+
+	testcases := []testcase{
+		{
+			name:       "auto approved",
+			giveUser:   identity.User{ID: "a", Groups: []string{"a"}},
+			rule:       &rule.AccessRule{Groups: []string{"a"}},
+			wantStatus: access.APPROVED,
+		},
+		{
+			name:          "requires review",
+			giveUser:      identity.User{ID: "a", Groups: []string{"a"}},
+			rule:          &rule.AccessRule{Groups: []string{"a"}, Approval: rule.Approval{Users: []string{"a", "b"}}},
+			wantStatus:    access.PENDING,
+			wantReviewers: []string{"b"},
+		},
+		{
+			name:                      "access handler rejects the grant",
+			giveUser:                  identity.User{ID: "a", Groups: []string{"a"}},
+			rule:                      &rule.AccessRule{Groups: []string{"a"}},
+			withValidateGrantResponse: groupErr,
+			wantStatus:                access.APPROVED,
+			wantGrantErr:              groupErr,
+		},
+		{
+			name:     "user not in rule groups",
+			giveUser: identity.User{ID: "a", Groups: []string{"b"}},
+			rule:     &rule.AccessRule{Groups: []string{"a"}},
+			wantErr:  ErrNoMatchingGroup,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			clk := clock.NewMock()
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: tc.rule})
+			db.MockQueryWithErr(&storage.GetUserDelegation{}, ddb.ErrNoItems)
+			db.MockQuery(&storage.ListBlackouts{})
+			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{})
+
+			// the Granter mock fails the test if a grant is created.
+			ctrl := gomock.NewController(t)
+			g := accessMocks.NewMockGranter(ctrl)
+			g.EXPECT().ValidateGrant(gomock.Any(), gomock.Any()).Return(tc.withValidateGrantResponse).AnyTimes()
+
+			s := Service{
+				Clock:   clk,
+				DB:      db,
+				Granter: g,
+			}
+			got, err := s.PreviewRequest(context.Background(), &tc.giveUser, types.CreateRequestRequest{AccessRuleId: "rule"})
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, tc.wantStatus, got.Request.Status)
+			var reviewers []string
+			for _, r := range got.Reviewers {
+				reviewers = append(reviewers, r.ReviewerID)
+			}
+			assert.Equal(t, tc.wantReviewers, reviewers)
+			assert.Equal(t, tc.wantGrantErr, got.GrantError)
+		})
+	}
+}
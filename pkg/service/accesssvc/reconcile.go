@@ -0,0 +1,141 @@
+package accesssvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"go.uber.org/zap"
+)
+
+// reconcileBatchSize is the number of grants sent to the Access Handler at once.
+// Checking a grant calls the provider's API, so batches are kept small to stay within the Access Handler's request timeout.
+const reconcileBatchSize = 10
+
+type ReconcileGrantsOpts struct {
+	// Remediate provisions access again for active grants which are missing from their provider.
+	// If false, drift is only reported.
+	Remediate bool
+	// Revoke removes access for expired grants which are still provisioned in their provider.
+	// It is separate from Remediate, as the access may have been provisioned outside of Granted.
+	Revoke bool
+	// ExpiredSince limits the EXPIRED grants which are checked to those which ended after it.
+	ExpiredSince time.Time
+}
+
+// ReconcileGrants checks that the ACTIVE and EXPIRED grants in the database match their providers, using the Access Handler.
+// A GrantDrifted event is emitted for each grant which has drifted. It is called on a schedule by the grant reconciler.
+//
+// If a batch of grants can't be checked, the error is logged and the remaining grants are still checked.
+func (s *Service) ReconcileGrants(ctx context.Context, opts ReconcileGrantsOpts) error {
+	log := logger.Get(ctx).With("remediate", opts.Remediate, "revoke", opts.Revoke)
+	grants, err := s.listGrantsToReconcile(ctx, opts.ExpiredSince)
+	if err != nil {
+		return err
+	}
+	log.Infow("reconciling grants", "count", len(grants))
+
+	byID := map[string]ahTypes.Grant{}
+	for _, g := range grants {
+		byID[g.ID] = g
+	}
+
+	var drifted int
+	for start := 0; start < len(grants); start += reconcileBatchSize {
+		end := start + reconcileBatchSize
+		if end > len(grants) {
+			end = len(grants)
+		}
+		results, err := s.reconcileBatch(ctx, grants[start:end], opts)
+		if err != nil {
+			log.Errorw("failed to reconcile grants", "batch.start", start, zap.Error(err))
+			continue
+		}
+		for _, r := range results {
+			switch r.Drift {
+			case ahTypes.UNCHECKED:
+				log.Warnw("grant could not be reconciled", "grant.id", r.GrantId, "error", r.Error)
+			case ahTypes.MISSING, ahTypes.NOTREMOVED:
+				drifted++
+				err = s.EventPutter.Put(ctx, gevent.GrantDrifted{Grant: byID[r.GrantId], Drift: r.Drift, Remediated: r.Remediated, Error: r.Error})
+				if err != nil {
+					log.Errorw("failed to emit grant drifted event", "grant.id", r.GrantId, zap.Error(err))
+				}
+			}
+		}
+	}
+	log.Infow("reconciled grants", "count", len(grants), "drifted", drifted)
+	return nil
+}
+
+func (s *Service) reconcileBatch(ctx context.Context, grants []ahTypes.Grant, opts ReconcileGrantsOpts) ([]ahTypes.GrantReconciliation, error) {
+	res, err := s.AHClient.ReconcileGrantsWithResponse(ctx, ahTypes.ReconcileGrantsJSONRequestBody{Grants: grants, Remediate: &opts.Remediate, Revoke: &opts.Revoke})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("access handler returned status %d: %s", res.StatusCode(), string(res.Body))
+	}
+	return res.JSON200.Results, nil
+}
+
+// listGrantsToReconcile returns the grants for every target of approved requests which are ACTIVE, or which are EXPIRED and ended after expiredSince.
+// The requests are read from the request index by their end time, so requests which ended before expiredSince aren't read.
+//
+// ACTIVE grants which have passed their end time are skipped, as the Access Handler may be removing them.
+// EXPIRED grants for the same access as an ACTIVE grant are skipped, as the access has been requested again since they expired.
+func (s *Service) listGrantsToReconcile(ctx context.Context, expiredSince time.Time) ([]ahTypes.Grant, error) {
+	now := s.Clock.Now()
+	var active, expired []ahTypes.Grant
+	for _, status := range []access.Status{access.APPROVED, access.NEEDS_RETROSPECTIVE_REVIEW} {
+		requests, err := storage.ListAllRequestsForStatusAndRequestend(ctx, s.DB, status, storage.GreaterThanEqual, expiredSince)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range requests {
+			if r.Status != status {
+				continue
+			}
+			for target := 0; target <= len(r.AdditionalGrants); target++ {
+				g := r.GrantForTarget(target)
+				if g == nil {
+					continue
+				}
+				switch {
+				case g.Status == ahTypes.ACTIVE:
+					active = append(active, g.ToAHGrant(access.GrantID(r.ID, target)))
+				case g.Status == ahTypes.EXPIRED && g.End.After(expiredSince):
+					expired = append(expired, g.ToAHGrant(access.GrantID(r.ID, target)))
+				}
+			}
+		}
+	}
+
+	var grants []ahTypes.Grant
+	for _, g := range active {
+		if g.End.After(now) {
+			grants = append(grants, g)
+		}
+	}
+	for _, g := range expired {
+		if !sameAccessAsAny(g, active) {
+			grants = append(grants, g)
+		}
+	}
+	return grants, nil
+}
+
+// sameAccessAsAny returns true if any of the grants are for the same access as the grant.
+func sameAccessAsAny(grant ahTypes.Grant, grants []ahTypes.Grant) bool {
+	for _, g := range grants {
+		if g.SameAccess(grant) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcileGrants(t *testing.T) {
+	type testcase struct {
+		name        string
+		remediate   bool
+		revoke      bool
+		mockResults []ahTypes.GrantReconciliation
+		mockErr     error
+		wantEvents  []gevent.GrantDrifted
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+
+	grant := func(status ahTypes.GrantStatus, group string, end time.Time) access.Grant {
+		return access.Grant{
+			Provider: "okta",
+			Subject:  "test@commonfate.io",
+			With:     ahTypes.Grant_With{AdditionalProperties: map[string]string{"groupId": group}},
+			Status:   status,
+			Start:    end.Add(-time.Hour),
+			End:      end,
+		}
+	}
+	active := grant(ahTypes.ACTIVE, "admins", now.Add(time.Hour))
+	expired := grant(ahTypes.EXPIRED, "developers", now.Add(-time.Hour))
+	requests := []access.Request{
+		{ID: "req_1", Status: access.APPROVED, Grant: &active, AdditionalGrants: []access.Grant{expired}},
+		// grants which expired before the lookback, or which have just ended, aren't checked.
+		{ID: "req_2", Status: access.APPROVED, Grant: grantPtr(grant(ahTypes.EXPIRED, "admins", now.Add(-48*time.Hour)))},
+		{ID: "req_3", Status: access.APPROVED, Grant: grantPtr(grant(ahTypes.ACTIVE, "operators", now.Add(-time.Minute)))},
+		{ID: "req_4", Status: access.APPROVED, Grant: grantPtr(grant(ahTypes.REVOKED, "admins", now.Add(time.Hour)))},
+		{ID: "req_5", Status: access.APPROVED},
+		// the access was requested again in req_1 after this grant expired, so it is expected to still be provisioned.
+		{ID: "req_6", Status: access.APPROVED, Grant: grantPtr(grant(ahTypes.EXPIRED, "admins", now.Add(-2*time.Hour)))},
+		// the access is still held by req_3, which the Access Handler may be removing.
+		{ID: "req_7", Status: access.APPROVED, Grant: grantPtr(grant(ahTypes.EXPIRED, "operators", now.Add(-2*time.Hour)))},
+	}
+	activeGrant := active.ToAHGrant("req_1")
+	expiredGrant := expired.ToAHGrant(access.GrantID("req_1", 1))
+
+	testcases := []testcase{
+		{
+			name: "in sync",
+			mockResults: []ahTypes.GrantReconciliation{
+				{GrantId: activeGrant.ID, Drift: ahTypes.INSYNC},
+				{GrantId: expiredGrant.ID, Drift: ahTypes.INSYNC},
+			},
+		},
+		{
+			name: "drift is reported",
+			mockResults: []ahTypes.GrantReconciliation{
+				{GrantId: activeGrant.ID, Drift: ahTypes.MISSING},
+				{GrantId: expiredGrant.ID, Drift: ahTypes.UNCHECKED},
+			},
+			wantEvents: []gevent.GrantDrifted{
+				{Grant: activeGrant, Drift: ahTypes.MISSING},
+			},
+		},
+		{
+			name:      "drift is remediated",
+			remediate: true,
+			mockResults: []ahTypes.GrantReconciliation{
+				{GrantId: activeGrant.ID, Drift: ahTypes.MISSING, Remediated: true},
+				{GrantId: expiredGrant.ID, Drift: ahTypes.NOTREMOVED},
+			},
+			wantEvents: []gevent.GrantDrifted{
+				{Grant: activeGrant, Drift: ahTypes.MISSING, Remediated: true},
+				{Grant: expiredGrant, Drift: ahTypes.NOTREMOVED},
+			},
+		},
+		{
+			name:   "expired grants are revoked",
+			revoke: true,
+			mockResults: []ahTypes.GrantReconciliation{
+				{GrantId: activeGrant.ID, Drift: ahTypes.MISSING},
+				{GrantId: expiredGrant.ID, Drift: ahTypes.NOTREMOVED, Remediated: true},
+			},
+			wantEvents: []gevent.GrantDrifted{
+				{Grant: activeGrant, Drift: ahTypes.MISSING},
+				{Grant: expiredGrant, Drift: ahTypes.NOTREMOVED, Remediated: true},
+			},
+		},
+		{
+			name:    "access handler error is logged",
+			mockErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQueryWithErrWithResult(&storage.ListRequestsForStatusAndRequestend{Result: requests}, &ddb.QueryResult{}, nil)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			res := &ahTypes.ReconcileGrantsResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}
+			res.JSON200 = &struct {
+				Results []ahTypes.GrantReconciliation `json:"results"`
+			}{Results: tc.mockResults}
+			if tc.mockErr != nil {
+				res = nil
+			}
+			remediate, revoke := tc.remediate, tc.revoke
+			m.EXPECT().ReconcileGrantsWithResponse(gomock.Any(), ahTypes.ReconcileGrantsJSONRequestBody{
+				Grants:    []ahTypes.Grant{activeGrant, expiredGrant},
+				Remediate: &remediate,
+				Revoke:    &revoke,
+			}).Return(res, tc.mockErr)
+
+			ep := mocks.NewMockEventPutter(ctrl)
+			for _, e := range tc.wantEvents {
+				ep.EXPECT().Put(gomock.Any(), e).Return(nil)
+			}
+
+			s := Service{
+				Clock:       clk,
+				DB:          db,
+				AHClient:    m,
+				EventPutter: ep,
+			}
+			err := s.ReconcileGrants(context.Background(), ReconcileGrantsOpts{Remediate: tc.remediate, Revoke: tc.revoke, ExpiredSince: now.Add(-24 * time.Hour)})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func grantPtr(g access.Grant) *access.Grant {
+	return &g
+}
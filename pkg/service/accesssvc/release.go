@@ -0,0 +1,50 @@
+package accesssvc
+
+import (
+	"context"
+	"time"
+
+	ac_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+)
+
+type ReleaseRequestOpts struct {
+	// RequestorID is the ID of the user giving up their access.
+	RequestorID string
+	RequestID   string
+}
+
+// ReleaseRequest ends the active grant of a request early, when the requestor no longer needs access.
+// The grant is revoked, so the requestor can make a new request for the same Access Rule straight away.
+func (s *Service) ReleaseRequest(ctx context.Context, opts ReleaseRequestOpts) (*access.Request, error) {
+	q := storage.GetRequest{ID: opts.RequestID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	request := *q.Result
+
+	// only the requestor can give up their own access.
+	if opts.RequestorID != request.RequestedBy {
+		return nil, ErrUserNotAuthorized
+	}
+	if !isReleasable(request, s.Clock.Now()) {
+		return nil, ErrRequestCannotBeReleased
+	}
+
+	return s.Granter.RevokeGrant(ctx, grantsvc.RevokeGrantOpts{
+		Request:   request,
+		RevokerID: opts.RequestorID,
+		Released:  true,
+	})
+}
+
+// break-glass grants can be released while they wait for retrospective review, as well as approved grants.
+func isReleasable(request access.Request, now time.Time) bool {
+	if request.Status != access.APPROVED && request.Status != access.NEEDS_RETROSPECTIVE_REVIEW {
+		return false
+	}
+	return request.Grant != nil && request.Grant.Status == ac_types.ACTIVE && request.Grant.End.After(now)
+}
@@ -0,0 +1,93 @@
+package accesssvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/service/accesssvc/mocks"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseRequest(t *testing.T) {
+	type testcase struct {
+		name        string
+		give        ReleaseRequestOpts
+		withRequest access.Request
+		wantRevoke  bool
+		wantErr     error
+	}
+
+	clk := clock.NewMock()
+	now := clk.Now()
+	active := access.Grant{Start: now.Add(-time.Minute), End: now.Add(time.Minute), Status: ahTypes.ACTIVE}
+	pending := access.Grant{Start: now.Add(time.Minute), End: now.Add(time.Hour), Status: ahTypes.PENDING}
+
+	testcases := []testcase{
+		{
+			name:        "ok",
+			give:        ReleaseRequestOpts{RequestorID: "a", RequestID: "req"},
+			withRequest: access.Request{ID: "req", RequestedBy: "a", Status: access.APPROVED, Grant: &active},
+			wantRevoke:  true,
+		},
+		{
+			name:        "break-glass grant waiting for review",
+			give:        ReleaseRequestOpts{RequestorID: "a", RequestID: "req"},
+			withRequest: access.Request{ID: "req", RequestedBy: "a", Status: access.NEEDS_RETROSPECTIVE_REVIEW, Grant: &active},
+			wantRevoke:  true,
+		},
+		{
+			name:        "only the requestor can release",
+			give:        ReleaseRequestOpts{RequestorID: "b", RequestID: "req"},
+			withRequest: access.Request{ID: "req", RequestedBy: "a", Status: access.APPROVED, Grant: &active},
+			wantErr:     ErrUserNotAuthorized,
+		},
+		{
+			name:        "grant has not started",
+			give:        ReleaseRequestOpts{RequestorID: "a", RequestID: "req"},
+			withRequest: access.Request{ID: "req", RequestedBy: "a", Status: access.APPROVED, Grant: &pending},
+			wantErr:     ErrRequestCannotBeReleased,
+		},
+		{
+			name:        "pending requests cannot be released",
+			give:        ReleaseRequestOpts{RequestorID: "a", RequestID: "req"},
+			withRequest: access.Request{ID: "req", RequestedBy: "a", Status: access.PENDING},
+			wantErr:     ErrRequestCannotBeReleased,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetRequest{Result: &tc.withRequest})
+
+			ctrl := gomock.NewController(t)
+			g := mocks.NewMockGranter(ctrl)
+			var want *access.Request
+			if tc.wantRevoke {
+				revoked := tc.withRequest
+				grant := *revoked.Grant
+				grant.Status = ahTypes.REVOKED
+				revoked.Grant = &grant
+				want = &revoked
+				g.EXPECT().RevokeGrant(gomock.Any(), grantsvc.RevokeGrantOpts{Request: tc.withRequest, RevokerID: tc.give.RequestorID, Released: true}).Return(&revoked, nil)
+			}
+
+			s := Service{
+				Clock:   clk,
+				DB:      db,
+				Granter: g,
+			}
+			got, err := s.ReleaseRequest(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
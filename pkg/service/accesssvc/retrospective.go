@@ -0,0 +1,80 @@
+package accesssvc
+
+import (
+	"context"
+	"time"
+
+	"github.com/common-fate/ddb"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// addRetrospectiveReview reviews a break-glass request after access has already been granted.
+// Approving the request marks it as APPROVED. Declining the request revokes the grant if it is still active.
+func (s *Service) addRetrospectiveReview(ctx context.Context, opts AddReviewOpts) (*AddReviewResult, error) {
+	request := opts.Request
+	originalStatus := request.Status
+
+	if !canReview(opts) {
+		return nil, ErrUserNotAuthorized
+	}
+
+	// timing overrides are not supported on retrospective reviews, as the grant has already been provisioned.
+	r := access.Review{
+		ID:         types.NewRequestReviewID(),
+		RequestID:  request.ID,
+		ReviewerID: opts.ReviewerID,
+		Decision:   opts.Decision,
+		Comment:    opts.Comment,
+		OnBehalfOf: reviewingOnBehalfOf(opts),
+	}
+
+	switch r.Decision {
+	case access.DecisionApproved:
+		request.ApprovedBy = append(request.ApprovedBy, opts.ReviewerID)
+		request.Status = access.APPROVED
+	case access.DecisionDECLINED:
+		if isRevocable(request, s.Clock.Now()) {
+			updatedRequest, err := s.Granter.RevokeGrant(ctx, grantsvc.RevokeGrantOpts{Request: request, RevokerID: opts.ReviewerID})
+			if err != nil {
+				return nil, err
+			}
+			request = *updatedRequest
+		}
+		request.Status = access.DECLINED
+	}
+	request.UpdatedAt = s.Clock.Now()
+
+	// audit log event
+	reqEvent := access.NewStatusChangeEvent(request.ID, request.UpdatedAt, &opts.ReviewerID, originalStatus, request.Status)
+
+	err := dbupdate.UpdateRequest(ctx, s.DB, &request, []ddb.Keyer{&r, &reqEvent}, dbupdate.WithReviewers(opts.Reviewers))
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Status == access.APPROVED {
+		err = s.EventPutter.Put(ctx, gevent.RequestApproved{Request: request})
+	} else {
+		err = s.EventPutter.Put(ctx, gevent.RequestDeclined{Request: request})
+	}
+	// In a future PR we will shift these events out to be triggered by dynamo db streams
+	// This will currently put the app in a strange state if this fails
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddReviewResult{Request: request}, nil
+}
+
+// isRevocable returns true if the request has a grant which is pending or active and hasn't ended yet.
+func isRevocable(request access.Request, now time.Time) bool {
+	if request.Grant == nil {
+		return false
+	}
+	return (request.Grant.Status == ahTypes.ACTIVE || request.Grant.Status == ahTypes.PENDING) && request.Grant.End.After(now)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/benbjohnson/clock"
 
 	"github.com/common-fate/ddb"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
@@ -17,6 +18,11 @@ type Service struct {
 	DB          ddb.Storage
 	Granter     Granter
 	EventPutter EventPutter
+	// AHClient is used to check the values chosen for selectable target arguments against the options suggested by the provider,
+	// and to reconcile grants with their providers.
+	AHClient ahTypes.ClientWithResponsesInterface
+	// AdminGroup is the ID of the Granted administrators group. Only administrators can request access on behalf of another user.
+	AdminGroup string
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/granter.go -package=mocks . Granter
@@ -25,6 +31,8 @@ type Service struct {
 type Granter interface {
 	CreateGrant(ctx context.Context, opts grantsvc.CreateGrantOpts) (*access.Request, error)
 	RevokeGrant(ctx context.Context, opts grantsvc.RevokeGrantOpts) (*access.Request, error)
+	ExtendGrant(ctx context.Context, opts grantsvc.ExtendGrantOpts) (*access.Request, error)
+	ValidateGrant(ctx context.Context, opts grantsvc.CreateGrantOpts) error
 }
 
 //go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
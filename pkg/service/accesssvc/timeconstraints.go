@@ -0,0 +1,96 @@
+package accesssvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// applyTimeConstraints checks the timing of a request which is about to be granted against the allowed windows
+// of the access rule and any blackout periods.
+//
+// If access would start outside of an allowed window or during a blackout, an error is returned.
+// If access would run past the end of its window or into a blackout, the request timing is shortened so that it ends in time,
+// and the reason is recorded on the request. The returned bool is true if the timing was shortened.
+func (s *Service) applyTimeConstraints(ctx context.Context, request *access.Request, tc types.TimeConstraints) (bool, error) {
+	q := storage.ListBlackouts{}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return false, err
+	}
+
+	start, end := request.GetInterval(access.WithNow(s.Clock.Now()))
+	clippedEnd, reason, err := constrainTiming(start, end, tc, q.Result)
+	if err != nil {
+		return false, err
+	}
+	if clippedEnd == nil {
+		return false, nil
+	}
+
+	timing := request.GetTiming()
+	timing.Duration = clippedEnd.Sub(start)
+	request.OverrideTiming = &timing
+	request.TimingClippedReason = &reason
+	return true, nil
+}
+
+// constrainExtension checks the extended end of a grant against the allowed windows of the access rule and any blackout periods.
+// If the extended grant would run past the end of its window or into a blackout, the end is brought forward and the reason is returned.
+// ErrExtensionOutsideTimeConstraints is returned if the grant can't be extended at all.
+func (s *Service) constrainExtension(ctx context.Context, grant access.Grant, newEnd time.Time, tc types.TimeConstraints) (time.Time, *string, error) {
+	q := storage.ListBlackouts{}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return time.Time{}, nil, err
+	}
+	clippedEnd, reason, err := constrainTiming(grant.Start, newEnd, tc, q.Result)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if clippedEnd == nil {
+		return newEnd, nil, nil
+	}
+	if !clippedEnd.After(grant.End) {
+		return time.Time{}, nil, ErrExtensionOutsideTimeConstraints
+	}
+	return *clippedEnd, &reason, nil
+}
+
+// constrainTiming checks the interval of a grant against the allowed windows of an access rule and against blackout periods.
+// If the grant would run past the end of its window or into a blackout, the time it should end at is returned along with the reason.
+func constrainTiming(start, end time.Time, tc types.TimeConstraints, blackouts []access.Blackout) (clippedEnd *time.Time, reason string, err error) {
+	if tc.AllowedWindows != nil && len(*tc.AllowedWindows) > 0 {
+		window, ok, err := rule.AllowedWindowAt(*tc.AllowedWindows, start)
+		if err != nil {
+			return nil, "", err
+		}
+		if !ok {
+			return nil, "", ErrOutsideAllowedWindow
+		}
+		if end.After(window.End) {
+			end = window.End
+			clippedEnd = &end
+			reason = fmt.Sprintf("access ends at %s, the end of the allowed window of the access rule", window.End.Format(time.RFC3339))
+		}
+	}
+
+	for _, b := range blackouts {
+		bi := rule.Interval{Start: b.Start, End: b.End}
+		if bi.Contains(start) {
+			return nil, "", BlackoutError{Reason: b.Reason}
+		}
+		if b.Start.After(start) && b.Start.Before(end) {
+			end = b.Start
+			clippedEnd = &end
+			reason = fmt.Sprintf("access ends at %s, when a blackout period starts: %s", b.Start.Format(time.RFC3339), b.Reason)
+		}
+	}
+	return clippedEnd, reason, nil
+}
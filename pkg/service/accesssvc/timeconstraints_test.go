@@ -0,0 +1,144 @@
+package accesssvc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstrainTiming(t *testing.T) {
+	// 2022-06-06 is a Monday.
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2022, 6, 6, hour, minute, 0, 0, time.UTC)
+	}
+	businessHours := types.TimeConstraints{
+		AllowedWindows: &[]types.AllowedWindow{
+			{Timezone: "UTC", Days: []types.Weekday{types.MONDAY, types.TUESDAY}, StartTime: "09:00", EndTime: "17:00"},
+		},
+	}
+	overnight := types.TimeConstraints{
+		AllowedWindows: &[]types.AllowedWindow{
+			{Timezone: "UTC", Days: []types.Weekday{types.SUNDAY}, StartTime: "22:00", EndTime: "02:00"},
+		},
+	}
+	sydney := types.TimeConstraints{
+		AllowedWindows: &[]types.AllowedWindow{
+			// 09:00-17:00 in Sydney is 23:00-07:00 UTC the day before, during June.
+			{Timezone: "Australia/Sydney", Days: []types.Weekday{types.MONDAY}, StartTime: "09:00", EndTime: "17:00"},
+		},
+	}
+	freeze := access.Blackout{ID: "blk", Start: monday(12, 0), End: monday(14, 0), Reason: "change freeze"}
+
+	type testcase struct {
+		name          string
+		start         time.Time
+		end           time.Time
+		tc            types.TimeConstraints
+		blackouts     []access.Blackout
+		wantEnd       *time.Time
+		wantReasonSet bool
+		wantErr       error
+	}
+
+	clipWindow := monday(17, 0)
+	clipBlackout := monday(12, 0)
+	clipSydney := monday(7, 0)
+
+	testcases := []testcase{
+		{
+			name:  "no constraints",
+			start: monday(3, 0),
+			end:   monday(4, 0),
+		},
+		{
+			name:  "within window",
+			start: monday(10, 0),
+			end:   monday(11, 0),
+			tc:    businessHours,
+		},
+		{
+			name:    "starts outside window",
+			start:   monday(8, 0),
+			end:     monday(10, 0),
+			tc:      businessHours,
+			wantErr: ErrOutsideAllowedWindow,
+		},
+		{
+			name:    "window not on this day",
+			start:   time.Date(2022, 6, 8, 10, 0, 0, 0, time.UTC),
+			end:     time.Date(2022, 6, 8, 11, 0, 0, 0, time.UTC),
+			tc:      businessHours,
+			wantErr: ErrOutsideAllowedWindow,
+		},
+		{
+			name:          "runs past end of window",
+			start:         monday(16, 0),
+			end:           monday(18, 0),
+			tc:            businessHours,
+			wantEnd:       &clipWindow,
+			wantReasonSet: true,
+		},
+		{
+			name:  "overnight window on the following day",
+			start: monday(1, 0),
+			end:   monday(1, 30),
+			tc:    overnight,
+		},
+		{
+			name:    "window in another timezone",
+			start:   monday(8, 0),
+			end:     monday(9, 0),
+			tc:      sydney,
+			wantErr: ErrOutsideAllowedWindow,
+		},
+		{
+			name:          "window in another timezone runs past end",
+			start:         monday(6, 0),
+			end:           monday(8, 0),
+			tc:            sydney,
+			wantEnd:       &clipSydney,
+			wantReasonSet: true,
+		},
+		{
+			name:      "starts during blackout",
+			start:     monday(13, 0),
+			end:       monday(15, 0),
+			blackouts: []access.Blackout{freeze},
+			wantErr:   BlackoutError{Reason: "change freeze"},
+		},
+		{
+			name:          "runs into blackout",
+			start:         monday(11, 0),
+			end:           monday(13, 0),
+			blackouts:     []access.Blackout{freeze},
+			wantEnd:       &clipBlackout,
+			wantReasonSet: true,
+		},
+		{
+			name:      "ends when blackout starts",
+			start:     monday(11, 0),
+			end:       monday(12, 0),
+			blackouts: []access.Blackout{freeze},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotEnd, gotReason, err := constrainTiming(tc.start, tc.end, tc.tc, tc.blackouts)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.wantEnd == nil {
+				assert.Nil(t, gotEnd)
+			} else if assert.NotNil(t, gotEnd) {
+				assert.True(t, tc.wantEnd.Equal(*gotEnd), "want %s, got %s", tc.wantEnd, gotEnd)
+			}
+			assert.Equal(t, tc.wantReasonSet, gotReason != "")
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	ac_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// checkUsageLimits returns an error if granting access for the request would exceed the usage limits of the access rule.
+// Each limit which would be exceeded is returned as a field error.
+//
+// The limits are checked against the grants in the database before the request is saved, rather than atomically with the write.
+// Requests which are created or approved at the same time can each pass the check, so they can exceed the limits together.
+func (s *Service) checkUsageLimits(ctx context.Context, request access.Request, limits *types.UsageLimits) error {
+	start, end := request.GetInterval(access.WithNow(s.Clock.Now()))
+	return s.checkUsageLimitsForInterval(ctx, request, start, end, limits)
+}
+
+// checkUsageLimitsForInterval returns an error if granting access for the request from start to end would exceed the usage limits of the access rule.
+// It is used to check extensions, where the grant's interval differs from the request's timing.
+func (s *Service) checkUsageLimitsForInterval(ctx context.Context, request access.Request, start, end time.Time, limits *types.UsageLimits) error {
+	if limits == nil {
+		return nil
+	}
+
+	var fields []apio.FieldError
+	if limits.MaxActiveGrants != nil {
+		grants, err := s.listActiveRuleGrants(ctx, request, start)
+		if err != nil {
+			return err
+		}
+		active := countOverlappingGrants(start, end, grants)
+		if active >= *limits.MaxActiveGrants {
+			fields = append(fields, apio.FieldError{
+				Field: "accessRuleId",
+				Error: fmt.Sprintf("the access rule already has the maximum of %d active grants", *limits.MaxActiveGrants),
+			})
+		}
+	}
+
+	if limits.Quota != nil || limits.CooldownSeconds != nil {
+		// fetch the user's grants which end late enough to count towards the quota or cooldown.
+		since := start
+		if limits.Quota != nil {
+			since = end.Add(-time.Duration(limits.Quota.WindowSeconds) * time.Second)
+		}
+		if limits.CooldownSeconds != nil {
+			cooldownStart := start.Add(-time.Duration(*limits.CooldownSeconds) * time.Second)
+			if cooldownStart.Before(since) {
+				since = cooldownStart
+			}
+		}
+		rq := storage.ListRequestsForUserAndRuleAndRequestend{
+			UserID:               request.RequestedBy,
+			RuleID:               request.Rule,
+			RequestEndComparator: storage.GreaterThanEqual,
+			CompareTo:            since,
+		}
+		_, err := s.DB.Query(ctx, &rq)
+		if err != nil && err != ddb.ErrNoItems {
+			return err
+		}
+		var grants []access.Request
+		for _, r := range rq.Result {
+			if r.ID != request.ID && r.Grant != nil {
+				grants = append(grants, r)
+			}
+		}
+
+		if limits.Quota != nil {
+			window := time.Duration(limits.Quota.WindowSeconds) * time.Second
+			limit := time.Duration(limits.Quota.MaxDurationSeconds) * time.Second
+			used := usedDuration(end.Add(-window), end, grants) + end.Sub(start)
+			if used > limit {
+				fields = append(fields, apio.FieldError{
+					Field: "timing.durationSeconds",
+					Error: fmt.Sprintf("this request would give you %s of access in the past %s, which exceeds the limit of %s", used, window, limit),
+				})
+			}
+		}
+
+		if limits.CooldownSeconds != nil {
+			cooldown := time.Duration(*limits.CooldownSeconds) * time.Second
+			if lastEnd, ok := lastGrantEndBefore(start, grants); ok && start.Before(lastEnd.Add(cooldown)) {
+				fields = append(fields, apio.FieldError{
+					Field: "timing",
+					Error: fmt.Sprintf("access can't start until %s, %s after your last grant ended", lastEnd.Add(cooldown).Format(time.RFC3339), cooldown),
+				})
+			}
+		}
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("request exceeds the usage limits of the access rule"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
+
+// listActiveRuleGrants lists the requests for the access rule, from any user, which have a grant which is pending or active and ends after start.
+// Only requests which end after start are read, so the number read doesn't grow with the history of the rule.
+func (s *Service) listActiveRuleGrants(ctx context.Context, request access.Request, start time.Time) ([]access.Request, error) {
+	var grants []access.Request
+	// break-glass grants are active while they wait for retrospective review, so they count towards the limit too.
+	for _, status := range []access.Status{access.APPROVED, access.NEEDS_RETROSPECTIVE_REVIEW} {
+		requests, err := storage.ListAllRequestsForRuleAndStatusAndRequestend(ctx, s.DB, request.Rule, status, storage.GreaterThan, start)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range requests {
+			if r.ID == request.ID || r.Grant == nil {
+				continue
+			}
+			if r.Grant.Status == ac_types.PENDING || r.Grant.Status == ac_types.ACTIVE {
+				grants = append(grants, r)
+			}
+		}
+	}
+	return grants, nil
+}
+
+// countOverlappingGrants counts the grants which are active at some point between start and end.
+// This is an upper bound on the number of grants active at the same time as a new grant from start to end.
+func countOverlappingGrants(start, end time.Time, grants []access.Request) int {
+	var count int
+	for _, r := range grants {
+		if r.Grant.Start.Before(end) && r.Grant.End.After(start) {
+			count++
+		}
+	}
+	return count
+}
+
+// usedDuration sums the time that the grants were active for between from and to.
+func usedDuration(from, to time.Time, grants []access.Request) time.Duration {
+	var used time.Duration
+	for _, r := range grants {
+		start, end := grantActiveInterval(r.Grant)
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			used += end.Sub(start)
+		}
+	}
+	return used
+}
+
+// lastGrantEndBefore returns the latest time at which one of the grants ended, out of the grants which ended by t.
+func lastGrantEndBefore(t time.Time, grants []access.Request) (last time.Time, ok bool) {
+	for _, r := range grants {
+		_, end := grantActiveInterval(r.Grant)
+		if !end.After(t) && (!ok || end.After(last)) {
+			last = end
+			ok = true
+		}
+	}
+	return last, ok
+}
+
+// grantActiveInterval returns the time that the grant was active for.
+// Revoked grants stopped being active when they were revoked, rather than at their scheduled end.
+func grantActiveInterval(g *access.Grant) (start, end time.Time) {
+	end = g.End
+	if g.Status == ac_types.REVOKED && g.UpdatedAt.Before(end) {
+		end = g.UpdatedAt
+	}
+	return g.Start, end
+}
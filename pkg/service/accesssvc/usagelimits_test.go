@@ -0,0 +1,143 @@
+package accesssvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb/ddbmock"
+	ac_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUsageLimits(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+
+	grant := func(id, rule string, start, end time.Time, status ac_types.GrantStatus) access.Request {
+		return access.Request{
+			ID:          id,
+			RequestedBy: "user",
+			Rule:        rule,
+			Status:      access.APPROVED,
+			Grant:       &access.Grant{Start: start, End: end, Status: status},
+		}
+	}
+	request := access.Request{
+		ID:              "req",
+		RequestedBy:     "user",
+		Rule:            "rule",
+		RequestedTiming: access.Timing{Duration: time.Hour},
+	}
+	one := 1
+	cooldown := int(time.Hour.Seconds())
+	quota := &types.UsageQuota{MaxDurationSeconds: int(3 * time.Hour.Seconds()), WindowSeconds: int(24 * time.Hour.Seconds())}
+
+	type testcase struct {
+		name              string
+		limits            *types.UsageLimits
+		withActiveGrants  []access.Request
+		withUserGrants    []access.Request
+		wantErrFieldNames []string
+	}
+
+	testcases := []testcase{
+		{
+			name: "no limits",
+		},
+		{
+			name:             "max active grants reached",
+			limits:           &types.UsageLimits{MaxActiveGrants: &one},
+			withActiveGrants: []access.Request{grant("other", "rule", now.Add(-time.Hour), now.Add(time.Hour), ac_types.ACTIVE)},
+			wantErrFieldNames: []string{
+				"accessRuleId",
+			},
+		},
+		{
+			name:   "inactive grants don't count towards max active grants",
+			limits: &types.UsageLimits{MaxActiveGrants: &one},
+			withActiveGrants: []access.Request{
+				grant("revoked", "rule", now.Add(-time.Hour), now.Add(time.Hour), ac_types.REVOKED),
+			},
+		},
+		{
+			name:   "within quota",
+			limits: &types.UsageLimits{Quota: quota},
+			withUserGrants: []access.Request{
+				grant("earlier", "rule", now.Add(-4*time.Hour), now.Add(-2*time.Hour), ac_types.EXPIRED),
+			},
+		},
+		{
+			name:   "quota exceeded",
+			limits: &types.UsageLimits{Quota: quota},
+			withUserGrants: []access.Request{
+				grant("earlier", "rule", now.Add(-6*time.Hour), now.Add(-3*time.Hour), ac_types.EXPIRED),
+			},
+			wantErrFieldNames: []string{
+				"timing.durationSeconds",
+			},
+		},
+		{
+			name:   "revoked grants count until they were revoked",
+			limits: &types.UsageLimits{Quota: quota},
+			withUserGrants: []access.Request{
+				{
+					ID:    "revoked",
+					Rule:  "rule",
+					Grant: &access.Grant{Start: now.Add(-6 * time.Hour), End: now.Add(-3 * time.Hour), Status: ac_types.REVOKED, UpdatedAt: now.Add(-5 * time.Hour)},
+				},
+			},
+		},
+		{
+			name:   "within cooldown",
+			limits: &types.UsageLimits{CooldownSeconds: &cooldown},
+			withUserGrants: []access.Request{
+				grant("earlier", "rule", now.Add(-2*time.Hour), now.Add(-30*time.Minute), ac_types.EXPIRED),
+			},
+			wantErrFieldNames: []string{
+				"timing",
+			},
+		},
+		{
+			name:   "after cooldown",
+			limits: &types.UsageLimits{CooldownSeconds: &cooldown},
+			withUserGrants: []access.Request{
+				grant("earlier", "rule", now.Add(-3*time.Hour), now.Add(-time.Hour), ac_types.EXPIRED),
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListRequestsForRuleAndStatusAndRequestend{Result: tc.withActiveGrants})
+			db.MockQuery(&storage.ListRequestsForUserAndRuleAndRequestend{Result: tc.withUserGrants})
+
+			s := Service{
+				Clock: clk,
+				DB:    db,
+			}
+			err := s.checkUsageLimits(context.Background(), request, tc.limits)
+			if tc.wantErrFieldNames == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var apiErr *apio.APIError
+			if assert.True(t, errors.As(err, &apiErr)) {
+				assert.Equal(t, http.StatusBadRequest, apiErr.Status)
+				var got []string
+				for _, f := range apiErr.Fields {
+					got = append(got, f.Field)
+				}
+				assert.Equal(t, tc.wantErrFieldNames, got)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
 	"github.com/common-fate/granted-approvals/pkg/access"
 	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -152,3 +153,147 @@ func TestCreateGrant(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateGrantWithAdditionalTargets(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	badRequest := "group Admins was not found"
+
+	request := access.Request{
+		ID:              "req_1",
+		Status:          access.APPROVED,
+		RequestedTiming: access.Timing{Duration: time.Minute, StartTime: &now},
+	}
+	accessRule := rule.AccessRule{
+		Target:            rule.Target{ProviderID: "aws-sso", With: map[string]string{"permissionSetArn": "arn"}},
+		AdditionalTargets: []rule.Target{{ProviderID: "okta", With: map[string]string{"groupId": "Admins"}}},
+	}
+	body := func(id string, target rule.Target) ah_types.PostGrantsJSONRequestBody {
+		return ah_types.PostGrantsJSONRequestBody{
+			Id:       id,
+			Provider: target.ProviderID,
+			With:     ah_types.CreateGrant_With{AdditionalProperties: target.With},
+			Subject:  "test@test.com",
+			Start:    iso8601.New(now),
+			End:      iso8601.New(now.Add(time.Minute)),
+		}
+	}
+	created := func(provider string) *ah_types.PostGrantsResponse {
+		return &ah_types.PostGrantsResponse{
+			JSON201: &struct {
+				Grant *ah_types.Grant "json:\"grant,omitempty\""
+			}{Grant: &ah_types.Grant{
+				Provider: provider,
+				Start:    iso8601.New(now),
+				End:      iso8601.New(now.Add(time.Minute)),
+				Subject:  "test@test.com",
+				Status:   ah_types.PENDING,
+			}},
+		}
+	}
+	grant := func(provider string) access.Grant {
+		return access.Grant{
+			Provider:  provider,
+			Subject:   "test@test.com",
+			Start:     now,
+			End:       now.Add(time.Minute),
+			Status:    ah_types.PENDING,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	t.Run("grants every target", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1", accessRule.Target)).Return(created("aws-sso"), nil)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1-target-1", accessRule.AdditionalTargets[0])).Return(created("okta"), nil)
+		c := ddbmock.New(t)
+		c.MockQuery(&storage.GetUser{Result: &identity.User{Email: "test@test.com"}})
+
+		s := Granter{AHClient: g, DB: c, Clock: clk}
+		got, err := s.CreateGrant(context.Background(), CreateGrantOpts{Request: request, AccessRule: accessRule})
+		assert.NoError(t, err)
+
+		primary := grant("aws-sso")
+		assert.Equal(t, &primary, got.Grant)
+		assert.Equal(t, []access.Grant{grant("okta")}, got.AdditionalGrants)
+	})
+
+	t.Run("revokes the other grants if a target fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1", accessRule.Target)).Return(created("aws-sso"), nil)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1-target-1", accessRule.AdditionalTargets[0])).Return(&ah_types.PostGrantsResponse{
+			JSON400: &struct {
+				Error *string `json:"error,omitempty"`
+			}{Error: &badRequest},
+		}, nil)
+		g.EXPECT().PostGrantsRevokeWithResponse(gomock.Any(), "req_1", ah_types.PostGrantsRevokeJSONRequestBody{RevokerId: rollbackRevokerID}).Return(&ah_types.PostGrantsRevokeResponse{
+			JSON200: &struct {
+				Grant *ah_types.Grant "json:\"grant,omitempty\""
+			}{},
+		}, nil)
+		c := ddbmock.New(t)
+		c.MockQuery(&storage.GetUser{Result: &identity.User{Email: "test@test.com"}})
+
+		s := Granter{AHClient: g, DB: c, Clock: clk}
+		got, err := s.CreateGrant(context.Background(), CreateGrantOpts{Request: request, AccessRule: accessRule})
+		assert.Nil(t, got)
+		assert.EqualError(t, err, "failed to grant access to target 1 (okta): group Admins was not found. The grants for the other targets were revoked")
+	})
+
+	t.Run("tries to revoke every other grant if revoking one fails", func(t *testing.T) {
+		threeTargets := accessRule
+		threeTargets.AdditionalTargets = []rule.Target{
+			{ProviderID: "okta", With: map[string]string{"groupId": "Developers"}},
+			{ProviderID: "okta", With: map[string]string{"groupId": "Admins"}},
+		}
+		revokeFailed := func(msg string) *ah_types.PostGrantsRevokeResponse {
+			return &ah_types.PostGrantsRevokeResponse{
+				JSON500: &struct {
+					Error *string `json:"error,omitempty"`
+				}{Error: &msg},
+			}
+		}
+
+		ctrl := gomock.NewController(t)
+		g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1", threeTargets.Target)).Return(created("aws-sso"), nil)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1-target-1", threeTargets.AdditionalTargets[0])).Return(created("okta"), nil)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1-target-2", threeTargets.AdditionalTargets[1])).Return(&ah_types.PostGrantsResponse{
+			JSON400: &struct {
+				Error *string `json:"error,omitempty"`
+			}{Error: &badRequest},
+		}, nil)
+		g.EXPECT().PostGrantsRevokeWithResponse(gomock.Any(), "req_1", ah_types.PostGrantsRevokeJSONRequestBody{RevokerId: rollbackRevokerID}).Return(revokeFailed("aws-sso is unavailable"), nil)
+		g.EXPECT().PostGrantsRevokeWithResponse(gomock.Any(), "req_1-target-1", ah_types.PostGrantsRevokeJSONRequestBody{RevokerId: rollbackRevokerID}).Return(revokeFailed("okta is unavailable"), nil)
+		c := ddbmock.New(t)
+		c.MockQuery(&storage.GetUser{Result: &identity.User{Email: "test@test.com"}})
+
+		s := Granter{AHClient: g, DB: c, Clock: clk}
+		got, err := s.CreateGrant(context.Background(), CreateGrantOpts{Request: request, AccessRule: threeTargets})
+		assert.Nil(t, got)
+		assert.ErrorContains(t, err, "failed to grant access to target 2 (okta): group Admins was not found. Revoking the grants for the other targets also failed, they must be revoked manually")
+		assert.ErrorContains(t, err, "target 0 (aws-sso): aws-sso is unavailable")
+		assert.ErrorContains(t, err, "target 1 (okta): okta is unavailable")
+	})
+	t.Run("sends the arguments chosen by the requestor", func(t *testing.T) {
+		selected := request
+		selected.Data.With = map[string]string{"accountId": "123456789012"}
+		selectable := accessRule
+		selectable.Target.WithSelectable = map[string]rule.SelectableArgument{"accountId": {Values: []string{"123456789012"}}}
+		want := rule.Target{ProviderID: "aws-sso", With: map[string]string{"permissionSetArn": "arn", "accountId": "123456789012"}}
+
+		ctrl := gomock.NewController(t)
+		g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1", want)).Return(created("aws-sso"), nil)
+		g.EXPECT().PostGrantsWithResponse(gomock.Any(), body("req_1-target-1", accessRule.AdditionalTargets[0])).Return(created("okta"), nil)
+		c := ddbmock.New(t)
+		c.MockQuery(&storage.GetUser{Result: &identity.User{Email: "test@test.com"}})
+
+		s := Granter{AHClient: g, DB: c, Clock: clk}
+		_, err := s.CreateGrant(context.Background(), CreateGrantOpts{Request: selected, AccessRule: selectable})
+		assert.NoError(t, err)
+	})
+}
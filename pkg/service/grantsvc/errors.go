@@ -5,6 +5,8 @@ import "errors"
 var (
 	//ErrGrantInactive is returned when a inactive grant is attempted to be revoked
 	ErrGrantInactive = errors.New("only active grants can be revoked")
+	// ErrGrantCannotBeExtended is returned when extending a grant which is not active
+	ErrGrantCannotBeExtended = errors.New("only active grants can be extended")
 	// ErrNoGrant is returned when attempting to revoke a request which has no grant yet
 	ErrNoGrant = errors.New("request has no grant")
 )
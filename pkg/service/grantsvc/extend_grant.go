@@ -0,0 +1,101 @@
+package grantsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/common-fate/apikit/logger"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/iso8601"
+	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
+)
+
+type ExtendGrantOpts struct {
+	Request access.Request
+	// End is the new end time of the grant.
+	End time.Time
+}
+
+// ExtendGrant moves the end time of an active Grant in the Access Handler, it does not update the approvals app database.
+// For rules with additional targets, the active grants for the other targets are extended too.
+// the returned Request will contain the extended grants.
+// If the grants for some of the additional targets can't be extended, the others are still extended, and the returned
+// Request contains the grants which were extended along with an error, so that the caller can save them.
+func (g *Granter) ExtendGrant(ctx context.Context, opts ExtendGrantOpts) (*access.Request, error) {
+	if opts.Request.Grant == nil {
+		return nil, ErrNoGrant
+	}
+	if opts.Request.Grant.Status != ahTypes.ACTIVE || opts.Request.Grant.End.Before(g.Clock.Now()) {
+		return nil, ErrGrantCannotBeExtended
+	}
+
+	// copy the grants so that the caller's request isn't modified
+	grant := *opts.Request.Grant
+	opts.Request.Grant = &grant
+	opts.Request.AdditionalGrants = append([]access.Grant{}, opts.Request.AdditionalGrants...)
+
+	var targets, extended int
+	var extendErr error
+	for i := 0; i <= len(opts.Request.AdditionalGrants); i++ {
+		target := opts.Request.GrantForTarget(i)
+		if i > 0 && (target.Status != ahTypes.ACTIVE || target.End.Before(g.Clock.Now())) {
+			// grants which have already ended, or which failed, can't be extended.
+			continue
+		}
+		targets++
+		end, err := g.extendTargetGrant(ctx, access.GrantID(opts.Request.ID, i), opts.End)
+		if err != nil && i == 0 {
+			return nil, err
+		}
+		if err != nil {
+			logger.Get(ctx).Errorw("failed to extend grant", "request", opts.Request.ID, "target", i, zap.Error(err))
+			extendErr = multierror.Append(extendErr, fmt.Errorf("target %d (%s): %w", i, target.Provider, err))
+			continue
+		}
+		target.End = end
+		target.UpdatedAt = g.Clock.Now()
+		extended++
+	}
+	if extendErr != nil {
+		return &opts.Request, fmt.Errorf("extended %d of %d grants for the request, the others could not be extended: %w", extended, targets, extendErr)
+	}
+	return &opts.Request, nil
+}
+
+// extendTargetGrant extends a single grant in the Access Handler, returning its new end time.
+func (g *Granter) extendTargetGrant(ctx context.Context, grantID string, end time.Time) (time.Time, error) {
+	res, err := g.AHClient.PostGrantsExtendWithResponse(ctx, grantID, ahTypes.PostGrantsExtendJSONRequestBody{
+		End: iso8601.New(end),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if res.JSON200 != nil && res.JSON200.Grant != nil {
+		return res.JSON200.Grant.End.Time, nil
+	}
+
+	if res.JSON400 != nil {
+		logger.Get(ctx).Errorw("Invalid request", "body", string(res.Body))
+
+		return time.Time{}, fmt.Errorf(*res.JSON400.Error)
+	}
+
+	if res.JSON404 != nil {
+		logger.Get(ctx).Errorw("Grant not found", "body", string(res.Body))
+
+		return time.Time{}, fmt.Errorf(*res.JSON404.Error)
+	}
+
+	if res.JSON500 != nil {
+		logger.Get(ctx).Errorw("Internal server error", "body", string(res.Body))
+
+		return time.Time{}, fmt.Errorf(*res.JSON500.Error)
+	}
+	logger.Get(ctx).Errorw("unhandled Access Handler response", "body", string(res.Body))
+	return time.Time{}, errors.New("unhandled response code")
+}
@@ -0,0 +1,131 @@
+package grantsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	ah_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/iso8601"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendGrant(t *testing.T) {
+	type testcase struct {
+		name                    string
+		give                    ExtendGrantOpts
+		withExtendGrantResponse *ah_types.PostGrantsExtendResponse
+		wantEnd                 time.Time
+		wantErr                 error
+	}
+	clk := clock.NewMock()
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	clk.Set(now)
+
+	start := now.Add(-time.Hour)
+	end := now.Add(time.Hour)
+	newEnd := now.Add(2 * time.Hour)
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: ExtendGrantOpts{
+				Request: access.Request{ID: "123", Grant: &access.Grant{Start: start, End: end, Status: ah_types.ACTIVE}},
+				End:     newEnd,
+			},
+			withExtendGrantResponse: &ah_types.PostGrantsExtendResponse{JSON200: &struct {
+				Grant *ah_types.Grant "json:\"grant,omitempty\""
+			}{Grant: &ah_types.Grant{
+				ID:     "123",
+				Start:  iso8601.New(start),
+				End:    iso8601.New(newEnd),
+				Status: ah_types.ACTIVE,
+			}}},
+			wantEnd: newEnd,
+		},
+		{
+			name: "no grant",
+			give: ExtendGrantOpts{
+				Request: access.Request{ID: "123"},
+				End:     newEnd,
+			},
+			wantErr: ErrNoGrant,
+		},
+		{
+			name: "pending grant",
+			give: ExtendGrantOpts{
+				Request: access.Request{ID: "123", Grant: &access.Grant{Start: start, End: end, Status: ah_types.PENDING}},
+				End:     newEnd,
+			},
+			wantErr: ErrGrantCannotBeExtended,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			if tc.withExtendGrantResponse != nil {
+				g.EXPECT().PostGrantsExtendWithResponse(gomock.Any(), "123", ah_types.PostGrantsExtendJSONRequestBody{
+					End: iso8601.New(tc.give.End),
+				}).Return(tc.withExtendGrantResponse, nil)
+			}
+
+			s := Granter{AHClient: g, Clock: clk}
+			got, err := s.ExtendGrant(context.Background(), tc.give)
+
+			assert.Equal(t, tc.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tc.wantEnd, got.Grant.End)
+				// the original request should not be modified
+				assert.Equal(t, end, tc.give.Request.Grant.End)
+			}
+		})
+	}
+}
+
+func TestExtendGrantAdditionalTargetFails(t *testing.T) {
+	clk := clock.NewMock()
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	clk.Set(now)
+
+	start := now.Add(-time.Hour)
+	end := now.Add(time.Hour)
+	newEnd := now.Add(2 * time.Hour)
+	request := access.Request{
+		ID:    "123",
+		Grant: &access.Grant{Provider: "okta", Start: start, End: end, Status: ah_types.ACTIVE},
+		AdditionalGrants: []access.Grant{
+			{Provider: "aws-sso", Start: start, End: end, Status: ah_types.ACTIVE},
+			{Provider: "azure-ad", Start: start, End: end, Status: ah_types.ACTIVE},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+	body := ah_types.PostGrantsExtendJSONRequestBody{End: iso8601.New(newEnd)}
+	extended := &ah_types.PostGrantsExtendResponse{JSON200: &struct {
+		Grant *ah_types.Grant "json:\"grant,omitempty\""
+	}{Grant: &ah_types.Grant{End: iso8601.New(newEnd)}}}
+	msg := "grant not found"
+	g.EXPECT().PostGrantsExtendWithResponse(gomock.Any(), "123", body).Return(extended, nil)
+	g.EXPECT().PostGrantsExtendWithResponse(gomock.Any(), access.GrantID("123", 1), body).Return(&ah_types.PostGrantsExtendResponse{JSON404: &struct {
+		Error *string "json:\"error,omitempty\""
+	}{Error: &msg}}, nil)
+	g.EXPECT().PostGrantsExtendWithResponse(gomock.Any(), access.GrantID("123", 2), body).Return(extended, nil)
+
+	s := Granter{AHClient: g, Clock: clk}
+	got, err := s.ExtendGrant(context.Background(), ExtendGrantOpts{Request: request, End: newEnd})
+	assert.EqualError(t, err, "extended 2 of 3 grants for the request, the others could not be extended: 1 error occurred:\n\t* target 1 (aws-sso): grant not found\n\n")
+
+	// the request is returned with the grants which were extended, so that they can be saved.
+	if assert.NotNil(t, got) {
+		assert.Equal(t, newEnd, got.Grant.End)
+		assert.Equal(t, end, got.AdditionalGrants[0].End)
+		assert.Equal(t, newEnd, got.AdditionalGrants[1].End)
+	}
+}
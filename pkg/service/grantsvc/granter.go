@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/benbjohnson/clock"
 
@@ -19,6 +20,8 @@ import (
 	"github.com/common-fate/granted-approvals/pkg/types"
 	"github.com/common-fate/iso8601"
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
 )
 
 type UserGetter interface {
@@ -43,9 +46,15 @@ type CreateGrantOpts struct {
 	AccessRule rule.AccessRule
 }
 
+// rollbackRevokerID is recorded as the revoker of grants which are revoked because
+// the grant for another target of the same request couldn't be created.
+const rollbackRevokerID = "granted-approvals"
+
 type RevokeGrantOpts struct {
 	Request   access.Request
 	RevokerID string
+	// Released is true if the requestor is giving up their own access before the grant ends.
+	Released bool
 }
 
 // NewGranter creates a new Granter instance
@@ -58,89 +67,159 @@ func (g *Granter) RevokeGrant(ctx context.Context, opts RevokeGrantOpts) (*acces
 	if opts.Request.Grant == nil {
 		return nil, ErrNoGrant
 	}
-	//Cannot request to revoke/cancel grant if it is not active or pending (state function has been created and executed)
-	canRevoke := opts.Request.Grant.Status == ahTypes.ACTIVE || opts.Request.Grant.Status == ahTypes.PENDING
-
-	if !canRevoke || opts.Request.Grant.End.Before(g.Clock.Now()) {
+	// the grants for every target of the rule are revoked together.
+	var targets []int
+	for i := 0; i <= len(opts.Request.AdditionalGrants); i++ {
+		if g.canRevoke(opts.Request.GrantForTarget(i)) {
+			targets = append(targets, i)
+		}
+	}
+	if len(targets) == 0 {
 		return nil, ErrGrantInactive
 	}
-	res, err := g.AHClient.PostGrantsRevokeWithResponse(ctx, opts.Request.ID, ahTypes.PostGrantsRevokeJSONRequestBody{
-		RevokerId: opts.RevokerID,
-	})
+
+	// claim the request before revoking the grant, so that a grant isn't revoked
+	// while the request is being changed by someone else.
+	claimed := opts.Request
+	err := dbupdate.ClaimRequest(ctx, g.DB, &claimed)
 	if err != nil {
 		return nil, err
 	}
+	opts.Request.Version = claimed.Version
+	// copy the additional grants so that the caller's request isn't modified
+	opts.Request.AdditionalGrants = append([]access.Grant{}, opts.Request.AdditionalGrants...)
 
-	if res.JSON200 != nil {
-		oldStatus := opts.Request.Grant.Status
-		opts.Request.Grant.Status = ahTypes.REVOKED
-		opts.Request.Grant.UpdatedAt = g.Clock.Now()
-		items, err := dbupdate.GetUpdateRequestItems(ctx, g.DB, opts.Request)
+	var events []ddb.Keyer
+	var revoked []int
+	var revokeErr error
+	for _, target := range targets {
+		err = g.revokeTargetGrant(ctx, access.GrantID(opts.Request.ID, target), opts.RevokerID)
 		if err != nil {
-			return nil, err
+			if len(targets) == 1 {
+				return nil, err
+			}
+			logger.Get(ctx).Errorw("failed to revoke grant", "request", opts.Request.ID, "target", target, zap.Error(err))
+			revokeErr = multierror.Append(revokeErr, fmt.Errorf("target %d (%s): %w", target, opts.Request.GrantForTarget(target).Provider, err))
+			continue
 		}
+		grant := opts.Request.GrantForTarget(target)
+		oldStatus := grant.Status
+		grant.Status = ahTypes.REVOKED
+		grant.UpdatedAt = g.Clock.Now()
 
 		//create a request event for audit loggging request change
-		requestEvent := access.NewGrantStatusChangeEvent(opts.Request.ID, opts.Request.Grant.UpdatedAt, &opts.RevokerID, oldStatus, opts.Request.Grant.Status)
-
-		items = append(items, &requestEvent)
+		requestEvent := access.NewGrantStatusChangeEvent(opts.Request.ID, grant.UpdatedAt, &opts.RevokerID, oldStatus, grant.Status)
+		if opts.Released {
+			requestEvent = access.NewGrantReleasedEvent(opts.Request.ID, grant.UpdatedAt, &opts.RevokerID, oldStatus)
+		}
+		requestEvent = requestEvent.ForTarget(target)
+		events = append(events, &requestEvent)
+		revoked = append(revoked, target)
+	}
 
-		err = g.DB.PutBatch(ctx, items...)
+	if len(revoked) > 0 {
+		err = dbupdate.UpdateRequest(ctx, g.DB, &opts.Request, events)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		// Emit an event for the grant revoke
-		// We have chosen to emit events from the approvals app for grant revocation rather than from the access handler because we are using a syncronous API.
-		// All effects from revoking will be implemented in this syncronous api rather than triggered from the events.
-		// So we update the grant status here and save the grant before emitting the event
-		err = g.EventBus.Put(ctx, gevent.GrantRevoked{Grant: opts.Request.Grant.ToAHGrant(opts.Request.ID)})
+	// Emit an event for the grant revoke
+	// We have chosen to emit events from the approvals app for grant revocation rather than from the access handler because we are using a syncronous API.
+	// All effects from revoking will be implemented in this syncronous api rather than triggered from the events.
+	// So we update the grant status here and save the grant before emitting the event
+	for _, target := range revoked {
+		err = g.EventBus.Put(ctx, gevent.GrantRevoked{Grant: opts.Request.GrantForTarget(target).ToAHGrant(access.GrantID(opts.Request.ID, target))})
 		if err != nil {
 			return nil, err
 		}
-		return &opts.Request, nil
+	}
+	if revokeErr != nil {
+		return nil, fmt.Errorf("revoked %d of %d grants for the request, the others could not be revoked: %w", len(revoked), len(targets), revokeErr)
+	}
+	return &opts.Request, nil
+}
+
+// canRevoke returns true if the grant is pending or active.
+// Grants can't be revoked before the state function for them has been created and executed.
+func (g *Granter) canRevoke(grant *access.Grant) bool {
+	if grant == nil {
+		return false
+	}
+	return (grant.Status == ahTypes.ACTIVE || grant.Status == ahTypes.PENDING) && !grant.End.Before(g.Clock.Now())
+}
+
+// revokeTargetGrant revokes a grant in the Access Handler.
+func (g *Granter) revokeTargetGrant(ctx context.Context, grantID string, revokerID string) error {
+	res, err := g.AHClient.PostGrantsRevokeWithResponse(ctx, grantID, ahTypes.PostGrantsRevokeJSONRequestBody{
+		RevokerId: revokerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.JSON200 != nil {
+		return nil
 	}
 
 	if res.JSON400 != nil {
 		logger.Get(ctx).Errorw("Invalid request", "body", string(res.Body))
 
-		return nil, fmt.Errorf(*res.JSON400.Error)
+		return fmt.Errorf(*res.JSON400.Error)
 	}
 
 	if res.JSON500 != nil {
 		logger.Get(ctx).Errorw("Internal server error", "body", string(res.Body))
 
-		return nil, fmt.Errorf(*res.JSON500.Error)
+		return fmt.Errorf(*res.JSON500.Error)
 	}
 	logger.Get(ctx).Errorw("unhandled Access Handler response", "body", string(res.Body))
-	return nil, errors.New("unhandled response code")
-
+	return errors.New("unhandled response code")
 }
 
-// CreateGrant creates a Grant in the Access Handler, it does not update the approvals app database.
-// the returned Request will contain the newly created grant
+// CreateGrant creates a Grant in the Access Handler for each target of the rule, it does not update the approvals app database.
+// the returned Request will contain the newly created grants.
+// If a grant can't be created, the grants which were created for the other targets are revoked, so that the user doesn't get part of the bundle.
 func (g *Granter) CreateGrant(ctx context.Context, opts CreateGrantOpts) (*access.Request, error) {
-
-	q := &storage.GetUser{
-		ID: opts.Request.RequestedBy,
-	}
-	_, err := g.DB.Query(ctx, q)
+	reqs, err := g.prepareCreateGrants(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	start, end := opts.Request.GetInterval(access.WithNow(g.Clock.Now()))
-	req := ahTypes.PostGrantsJSONRequestBody{
-		Id:       opts.Request.ID,
-		Provider: opts.AccessRule.Target.ProviderID,
-		With: ahTypes.CreateGrant_With{
-			AdditionalProperties: opts.AccessRule.Target.With,
-		},
-		Subject: openapi_types.Email(q.Result.Email),
-		Start:   iso8601.New(start),
-		End:     iso8601.New(end),
+	var grants []access.Grant
+	for i, req := range reqs {
+		grant, err := g.createTargetGrant(ctx, req)
+		if err != nil && i == 0 {
+			return nil, err
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to grant access to target %d (%s): %w", i, req.Provider, err)
+			// every grant which was created is revoked, even if revoking one of them fails, so that as little access as possible is left behind.
+			var rbErr error
+			for j := range grants {
+				revokeErr := g.revokeTargetGrant(ctx, access.GrantID(opts.Request.ID, j), rollbackRevokerID)
+				if revokeErr != nil {
+					logger.Get(ctx).Errorw("failed to revoke grant after a partial failure", "request", opts.Request.ID, "target", j, zap.Error(revokeErr))
+					rbErr = multierror.Append(rbErr, fmt.Errorf("target %d (%s): %w", j, grants[j].Provider, revokeErr))
+				}
+			}
+			if rbErr != nil {
+				return nil, fmt.Errorf("%w. Revoking the grants for the other targets also failed, they must be revoked manually: %s", err, rbErr)
+			}
+			return nil, fmt.Errorf("%w. The grants for the other targets were revoked", err)
+		}
+		grants = append(grants, *grant)
 	}
 
+	opts.Request.Grant = &grants[0]
+	if len(grants) > 1 {
+		opts.Request.AdditionalGrants = grants[1:]
+	}
+	return &opts.Request, nil
+}
+
+// createTargetGrant creates a single grant in the Access Handler.
+func (g *Granter) createTargetGrant(ctx context.Context, req ahTypes.CreateGrant) (*access.Grant, error) {
 	res, err := g.AHClient.PostGrantsWithResponse(ctx, req)
 	if err != nil {
 		return nil, err
@@ -149,7 +228,7 @@ func (g *Granter) CreateGrant(ctx context.Context, opts CreateGrantOpts) (*acces
 	// on success we create a grant item in dynamo db
 	if res.JSON201 != nil {
 		now := g.Clock.Now()
-		opts.Request.Grant = &access.Grant{
+		return &access.Grant{
 			Provider:  res.JSON201.Grant.Provider,
 			Subject:   string(res.JSON201.Grant.Subject),
 			Start:     res.JSON201.Grant.Start.Time,
@@ -158,9 +237,7 @@ func (g *Granter) CreateGrant(ctx context.Context, opts CreateGrantOpts) (*acces
 			With:      res.JSON201.Grant.With,
 			CreatedAt: now,
 			UpdatedAt: now,
-		}
-
-		return &opts.Request, nil
+		}, nil
 	}
 
 	if res.JSON400.Error != nil {
@@ -169,3 +246,74 @@ func (g *Granter) CreateGrant(ctx context.Context, opts CreateGrantOpts) (*acces
 	logger.Get(ctx).Errorw("unhandled Access Handler response", "body", string(res.Body))
 	return nil, errors.New("unhandled response code")
 }
+
+// ValidateGrant checks with the Access Handler that a Grant could be created for each target of the request, without creating them.
+// Returns an error describing why a Grant is invalid, if it is.
+func (g *Granter) ValidateGrant(ctx context.Context, opts CreateGrantOpts) error {
+	reqs, err := g.prepareCreateGrants(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	for i, req := range reqs {
+		err = g.validateTargetGrant(ctx, req)
+		if err != nil && i > 0 {
+			return fmt.Errorf("target %d (%s): %w", i, req.Provider, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Granter) validateTargetGrant(ctx context.Context, req ahTypes.CreateGrant) error {
+	res, err := g.AHClient.ValidateGrantWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode() == http.StatusOK {
+		return nil
+	}
+	if res.JSON400 != nil && res.JSON400.Error != nil {
+		return errors.New(*res.JSON400.Error)
+	}
+	if res.JSON404 != nil && res.JSON404.Error != nil {
+		return errors.New(*res.JSON404.Error)
+	}
+	logger.Get(ctx).Errorw("unhandled Access Handler response", "body", string(res.Body))
+	return errors.New("unhandled response code")
+}
+
+// prepareCreateGrants builds the Access Handler grants for a request, one for each target of the rule.
+// The grants are for the user who requested access.
+func (g *Granter) prepareCreateGrants(ctx context.Context, opts CreateGrantOpts) ([]ahTypes.CreateGrant, error) {
+	q := &storage.GetUser{
+		ID: opts.Request.RequestedBy,
+	}
+	_, err := g.DB.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := opts.Request.GetInterval(access.WithNow(g.Clock.Now()))
+	var reqs []ahTypes.CreateGrant
+	for i, t := range opts.AccessRule.Targets() {
+		with := t.With
+		if i == 0 {
+			with = t.Arguments(opts.Request.Data.With)
+		}
+		reqs = append(reqs, ahTypes.CreateGrant{
+			Id:       access.GrantID(opts.Request.ID, i),
+			Provider: t.ProviderID,
+			With: ahTypes.CreateGrant_With{
+				AdditionalProperties: with,
+			},
+			Subject: openapi_types.Email(q.Result.Email),
+			Start:   iso8601.New(start),
+			End:     iso8601.New(end),
+		})
+	}
+	return reqs, nil
+}
@@ -176,6 +176,46 @@ func (mr *MockAHClientMockRecorder) ListProvidersWithResponse(arg0 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProvidersWithResponse", reflect.TypeOf((*MockAHClient)(nil).ListProvidersWithResponse), varargs...)
 }
 
+// PostGrantsExtendWithBodyWithResponse mocks base method.
+func (m *MockAHClient) PostGrantsExtendWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.PostGrantsExtendResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostGrantsExtendWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.PostGrantsExtendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrantsExtendWithBodyWithResponse indicates an expected call of PostGrantsExtendWithBodyWithResponse.
+func (mr *MockAHClientMockRecorder) PostGrantsExtendWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsExtendWithBodyWithResponse", reflect.TypeOf((*MockAHClient)(nil).PostGrantsExtendWithBodyWithResponse), varargs...)
+}
+
+// PostGrantsExtendWithResponse mocks base method.
+func (m *MockAHClient) PostGrantsExtendWithResponse(arg0 context.Context, arg1 string, arg2 types.PostGrantsExtendJSONRequestBody, arg3 ...types.RequestEditorFn) (*types.PostGrantsExtendResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PostGrantsExtendWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.PostGrantsExtendResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrantsExtendWithResponse indicates an expected call of PostGrantsExtendWithResponse.
+func (mr *MockAHClientMockRecorder) PostGrantsExtendWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsExtendWithResponse", reflect.TypeOf((*MockAHClient)(nil).PostGrantsExtendWithResponse), varargs...)
+}
+
 // PostGrantsRevokeWithBodyWithResponse mocks base method.
 func (m *MockAHClient) PostGrantsRevokeWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...types.RequestEditorFn) (*types.PostGrantsRevokeResponse, error) {
 	m.ctrl.T.Helper()
@@ -255,3 +295,43 @@ func (mr *MockAHClientMockRecorder) PostGrantsWithResponse(arg0, arg1 interface{
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrantsWithResponse", reflect.TypeOf((*MockAHClient)(nil).PostGrantsWithResponse), varargs...)
 }
+
+// ValidateGrantWithBodyWithResponse mocks base method.
+func (m *MockAHClient) ValidateGrantWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...types.RequestEditorFn) (*types.ValidateGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateGrantWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ValidateGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateGrantWithBodyWithResponse indicates an expected call of ValidateGrantWithBodyWithResponse.
+func (mr *MockAHClientMockRecorder) ValidateGrantWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGrantWithBodyWithResponse", reflect.TypeOf((*MockAHClient)(nil).ValidateGrantWithBodyWithResponse), varargs...)
+}
+
+// ValidateGrantWithResponse mocks base method.
+func (m *MockAHClient) ValidateGrantWithResponse(arg0 context.Context, arg1 types.CreateGrant, arg2 ...types.RequestEditorFn) (*types.ValidateGrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateGrantWithResponse", varargs...)
+	ret0, _ := ret[0].(*types.ValidateGrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateGrantWithResponse indicates an expected call of ValidateGrantWithResponse.
+func (mr *MockAHClientMockRecorder) ValidateGrantWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGrantWithResponse", reflect.TypeOf((*MockAHClient)(nil).ValidateGrantWithResponse), varargs...)
+}
@@ -6,11 +6,13 @@ import (
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
 
 	ah_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
 
 	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/common-fate/iso8601"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -65,7 +67,11 @@ func TestAccessRevoke(t *testing.T) {
 				RevokerId: tc.give.RevokerID,
 			}).Return(&tc.withRevokeGrantResponse, tc.wantErr).AnyTimes()
 
-			s := Granter{AHClient: g, Clock: clk}
+			c := ddbmock.New(t)
+			// called by dbupdate.GetUpdateRequestItems when the request is claimed
+			c.MockQuery(&storage.ListRequestReviewers{})
+
+			s := Granter{AHClient: g, DB: c, Clock: clk}
 			_, err := s.RevokeGrant(context.Background(), tc.give)
 
 			assert.Equal(t, tc.wantErr, err)
@@ -74,3 +80,40 @@ func TestAccessRevoke(t *testing.T) {
 	}
 
 }
+
+func TestRevokeGrantWithAdditionalTargets(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	revokeErr := "grant is not running"
+
+	active := access.Grant{Start: now, End: now.Add(time.Hour), Status: ah_types.ACTIVE, Provider: "aws-sso"}
+	additional := active
+	additional.Provider = "okta"
+	expired := additional
+	expired.Status = ah_types.EXPIRED
+
+	ctrl := gomock.NewController(t)
+	g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+	failed := &ah_types.PostGrantsRevokeResponse{JSON400: &struct {
+		Error *string `json:"error,omitempty"`
+	}{Error: &revokeErr}}
+	// the expired grant for the second additional target isn't revoked.
+	g.EXPECT().PostGrantsRevokeWithResponse(gomock.Any(), "req_1", gomock.Any()).Return(failed, nil)
+	g.EXPECT().PostGrantsRevokeWithResponse(gomock.Any(), "req_1-target-1", gomock.Any()).Return(failed, nil)
+
+	c := ddbmock.New(t)
+	c.MockQuery(&storage.ListRequestReviewers{})
+
+	s := Granter{AHClient: g, DB: c, Clock: clk}
+	_, err := s.RevokeGrant(context.Background(), RevokeGrantOpts{
+		Request: access.Request{
+			ID:               "req_1",
+			Grant:            &active,
+			AdditionalGrants: []access.Grant{additional, expired},
+		},
+		RevokerID: "admin",
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "revoked 0 of 2 grants for the request")
+	assert.Contains(t, err.Error(), "target 1 (okta): grant is not running")
+}
@@ -0,0 +1,82 @@
+package grantsvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
+	ah_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/iso8601"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGrant(t *testing.T) {
+	type testcase struct {
+		name                      string
+		withValidateGrantResponse *ah_types.ValidateGrantResponse
+		wantErr                   error
+	}
+	clk := clock.NewMock()
+	now := clk.Now()
+	groupErr := "group Developers was not found"
+
+	testcases := []testcase{
+		{
+			name: "valid",
+			withValidateGrantResponse: &ah_types.ValidateGrantResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+			},
+		},
+		{
+			name: "rejected by provider",
+			withValidateGrantResponse: &ah_types.ValidateGrantResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusBadRequest},
+				JSON400: &struct {
+					Error *string `json:"error,omitempty"`
+				}{Error: &groupErr},
+			},
+			wantErr: errors.New(groupErr),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			g := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			g.EXPECT().ValidateGrantWithResponse(gomock.Any(), gomock.Eq(ah_types.ValidateGrantJSONRequestBody{
+				Id:       "req_123",
+				Provider: "test",
+				With: ah_types.CreateGrant_With{
+					AdditionalProperties: map[string]string{"group": "Developers"},
+				},
+				Subject: "test@test.com",
+				Start:   iso8601.New(now),
+				End:     iso8601.New(now.Add(time.Minute)),
+			})).Return(tc.withValidateGrantResponse, nil)
+			c := ddbmock.New(t)
+			c.MockQuery(&storage.GetUser{Result: &identity.User{Email: "test@test.com"}})
+
+			s := Granter{AHClient: g, DB: c, Clock: clk}
+			err := s.ValidateGrant(context.Background(), CreateGrantOpts{
+				Request: access.Request{
+					ID:              "req_123",
+					RequestedTiming: access.Timing{Duration: time.Minute, StartTime: &now},
+				},
+				AccessRule: rule.AccessRule{
+					Target: rule.Target{ProviderID: "test", With: map[string]string{"group": "Developers"}},
+				},
+			})
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
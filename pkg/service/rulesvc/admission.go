@@ -0,0 +1,30 @@
+package rulesvc
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+)
+
+// validateAdmissionPolicy checks that the admission policy of a rule compiles and evaluates to a supported type.
+func validateAdmissionPolicy(rul rule.AccessRule) error {
+	if rul.AdmissionPolicy == nil {
+		return nil
+	}
+	_, err := rule.CompileAdmissionPolicy(*rul.AdmissionPolicy)
+	if err != nil {
+		return &apio.APIError{
+			Err:    errors.New("access rule validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: []apio.FieldError{
+				{
+					Field: "admissionPolicy",
+					Error: err.Error(),
+				},
+			},
+		}
+	}
+	return nil
+}
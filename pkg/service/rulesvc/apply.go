@@ -0,0 +1,55 @@
+package rulesvc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+type ApplyRuleOpts struct {
+	// ActorID is recorded as the creator or updater of the rule.
+	ActorID        string
+	UpdateMessage  *string
+	UpdateMetadata *map[string]interface{}
+}
+
+// ApplyRuleChange makes a change returned by PlanRules.
+func (s *Service) ApplyRuleChange(ctx context.Context, change RuleChange, opts ApplyRuleOpts) error {
+	switch change.Type {
+	case RuleCreate:
+		_, err := s.createAccessRule(ctx, opts.ActorID, change.Spec.CreateAccessRuleRequest, opts.UpdateMessage, opts.UpdateMetadata)
+		return err
+	case RuleUpdate:
+		spec := change.Spec
+		// the spec describes the whole rule, so owners which aren't in the spec are removed.
+		owners := spec.Owners
+		if owners == nil {
+			owners = &types.AccessRuleOwners{Users: []string{}, Groups: []string{}}
+		}
+		_, err := s.UpdateRule(ctx, &UpdateOpts{
+			UpdaterID: opts.ActorID,
+			Rule:      *change.Current,
+			UpdateRequest: types.UpdateAccessRuleRequest{
+				AdmissionPolicy: spec.AdmissionPolicy,
+				Approval:        spec.Approval,
+				BreakGlass:      spec.BreakGlass,
+				Description:     spec.Description,
+				Fields:          spec.Fields,
+				Groups:          spec.Groups,
+				Name:            spec.Name,
+				Owners:          owners,
+				TimeConstraints: spec.TimeConstraints,
+				UpdateMessage:   opts.UpdateMessage,
+				UsageLimits:     spec.UsageLimits,
+			},
+			UpdateMetadata: opts.UpdateMetadata,
+		})
+		return err
+	case RuleArchive:
+		_, err := s.ArchiveAccessRule(ctx, &identity.User{ID: opts.ActorID}, *change.Current, ArchiveOpts{})
+		return err
+	}
+	return fmt.Errorf("unknown access rule change type %s", change.Type)
+}
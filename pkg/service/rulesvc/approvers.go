@@ -2,9 +2,13 @@ package rulesvc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"sort"
 	"sync"
 
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/storage"
@@ -47,27 +51,40 @@ func (u *userMap) All() []string {
 // GetApprovers gets all the approvers for a rule, both those assigned as individuals and those
 // assigned via a group. It de-duplicates users, so if a user is assigned as an approver through
 // multiple groups they'll only be returned once.
+//
+// If the rule has multiple approval stages, the approvers for every stage are returned.
 func GetApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([]string, error) {
-	users := newUserMap()
+	return getApprovers(ctx, db, rule.Approval.GetStages()...)
+}
 
-	for _, u := range rule.Approval.Users {
-		users.Add(u)
-	}
+// GetStageApprovers gets the approvers for a single approval stage of a rule.
+func GetStageApprovers(ctx context.Context, db ddb.Storage, stage rule.ApprovalStage) ([]string, error) {
+	return getApprovers(ctx, db, stage)
+}
+
+func getApprovers(ctx context.Context, db ddb.Storage, stages ...rule.ApprovalStage) ([]string, error) {
+	users := newUserMap()
 
 	wg, gctx := errgroup.WithContext(ctx)
-	for _, g := range rule.Approval.Groups {
-		id := g
-		wg.Go(func() error {
-			q := &storage.GetGroup{ID: id}
-			_, err := db.Query(gctx, q)
-			if err != nil {
-				return err
-			}
-			for _, u := range q.Result.Users {
-				users.Add(u)
-			}
-			return nil
-		})
+	for _, st := range stages {
+		for _, u := range st.Users {
+			users.Add(u)
+		}
+
+		for _, g := range st.Groups {
+			id := g
+			wg.Go(func() error {
+				q := &storage.GetGroup{ID: id}
+				_, err := db.Query(gctx, q)
+				if err != nil {
+					return err
+				}
+				for _, u := range q.Result.Users {
+					users.Add(u)
+				}
+				return nil
+			})
+		}
 	}
 	err := wg.Wait()
 	if err != nil {
@@ -77,3 +94,94 @@ func GetApprovers(ctx context.Context, db ddb.Storage, rule rule.AccessRule) ([]
 	res := users.All()
 	return res, nil
 }
+
+// validateApprovalThreshold checks that there are enough approvers in each approval stage of the rule
+// to meet the number of approvals the stage requires.
+// Users can't approve their own requests, so a stage which needs every one of its approvers can't be completed
+// for a request made by one of them. Rules where one of those approvers is in the groups of the rule are rejected.
+func validateApprovalThreshold(ctx context.Context, db ddb.Storage, rule rule.AccessRule) error {
+	var fields []apio.FieldError
+	// requestors holds the users who can request access with the rule.
+	// It is only looked up if a stage needs every one of its approvers.
+	var requestors map[string]bool
+	if len(rule.Approval.Stages) > 0 && (len(rule.Approval.Users) > 0 || len(rule.Approval.Groups) > 0 || rule.Approval.RequiredApprovals > 0) {
+		fields = append(fields, apio.FieldError{
+			Field: "approval.stages",
+			Error: "users, groups and requiredApprovals must be empty when approval stages are used",
+		})
+	}
+
+	// break-glass requests are reviewed after access is granted, so there must be someone to review them.
+	if rule.BreakGlass && !rule.Approval.IsRequired() {
+		fields = append(fields, apio.FieldError{
+			Field: "breakGlass",
+			Error: "break-glass access requires the access rule to have approvers",
+		})
+	}
+
+	for i, st := range rule.Approval.GetStages() {
+		field := "approval.requiredApprovals"
+		if len(rule.Approval.Stages) > 0 {
+			if len(st.Users) == 0 && len(st.Groups) == 0 {
+				fields = append(fields, apio.FieldError{
+					Field: fmt.Sprintf("approval.stages[%d]", i),
+					Error: "an approval stage must have at least one approver",
+				})
+				continue
+			}
+			field = fmt.Sprintf("approval.stages[%d].requiredApprovals", i)
+		}
+		threshold := st.Threshold()
+		if threshold == 1 {
+			continue
+		}
+		approvers, err := GetStageApprovers(ctx, db, st)
+		if err != nil {
+			return err
+		}
+		if len(approvers) < threshold {
+			fields = append(fields, apio.FieldError{
+				Field: field,
+				Error: fmt.Sprintf("requiredApprovals: %d exceeds the number of approvers: %d", threshold, len(approvers)),
+			})
+			continue
+		}
+		if len(approvers) > threshold {
+			continue
+		}
+		if requestors == nil {
+			// the members of the groups of the rule are looked up in the same way as the approvers of a stage.
+			users, err := getApprovers(ctx, db, ruleGroups(rule))
+			if err != nil {
+				return err
+			}
+			requestors = make(map[string]bool)
+			for _, u := range users {
+				requestors[u] = true
+			}
+		}
+		for _, u := range approvers {
+			if requestors[u] {
+				fields = append(fields, apio.FieldError{
+					Field: field,
+					Error: fmt.Sprintf("requiredApprovals: %d can't be met for requests made by an approver, as users can't approve their own requests and there are only %d approvers", threshold, len(approvers)),
+				})
+				break
+			}
+		}
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("access rule validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
+
+// ruleGroups returns an approval stage made up of the groups which can request access with the rule.
+func ruleGroups(r rule.AccessRule) rule.ApprovalStage {
+	return rule.ApprovalStage{Groups: r.Groups}
+}
@@ -2,8 +2,11 @@ package rulesvc
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/ddb/ddbmock"
 	"github.com/common-fate/granted-approvals/pkg/identity"
 	"github.com/common-fate/granted-approvals/pkg/rule"
@@ -67,6 +70,21 @@ func TestGetApprovers(t *testing.T) {
 			},
 			want: []string{"usr_2"},
 		},
+		{
+			name: "approval stages",
+			giveRule: rule.AccessRule{
+				Approval: rule.Approval{
+					Stages: []rule.ApprovalStage{
+						{Users: []string{"usr_1"}},
+						{Groups: []string{"grp_1"}},
+					},
+				},
+			},
+			mockGetGroup: &identity.Group{
+				Users: []string{"usr_2"},
+			},
+			want: []string{"usr_1", "usr_2"},
+		},
 		// returning an empty array rather than nil ensures that our API endpoints
 		// that use this method don't return null when the frontend is expecting an array.
 		{
@@ -91,3 +109,78 @@ func TestGetApprovers(t *testing.T) {
 	}
 
 }
+
+func TestValidateApprovalThreshold(t *testing.T) {
+	type testcase struct {
+		name         string
+		giveRule     rule.AccessRule
+		mockGetGroup *identity.Group
+		wantFields   []apio.FieldError
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			giveRule: rule.AccessRule{
+				Groups:   []string{"grp_requestors"},
+				Approval: rule.Approval{Users: []string{"usr_1", "usr_2", "usr_3"}, RequiredApprovals: 2},
+			},
+			mockGetGroup: &identity.Group{Users: []string{"usr_1"}},
+		},
+		{
+			name: "threshold exceeds the number of approvers",
+			giveRule: rule.AccessRule{
+				Groups:   []string{"grp_requestors"},
+				Approval: rule.Approval{Users: []string{"usr_1"}, RequiredApprovals: 2},
+			},
+			wantFields: []apio.FieldError{{Field: "approval.requiredApprovals", Error: "requiredApprovals: 2 exceeds the number of approvers: 1"}},
+		},
+		{
+			name: "every approver is needed and none of them can request access",
+			giveRule: rule.AccessRule{
+				Groups:   []string{"grp_requestors"},
+				Approval: rule.Approval{Users: []string{"usr_1", "usr_2"}, RequiredApprovals: 2},
+			},
+			mockGetGroup: &identity.Group{Users: []string{"usr_3"}},
+		},
+		{
+			name: "every approver is needed and one of them can request access",
+			giveRule: rule.AccessRule{
+				Groups:   []string{"grp_requestors"},
+				Approval: rule.Approval{Users: []string{"usr_1", "usr_2"}, RequiredApprovals: 2},
+			},
+			mockGetGroup: &identity.Group{Users: []string{"usr_2", "usr_3"}},
+			wantFields:   []apio.FieldError{{Field: "approval.requiredApprovals", Error: "requiredApprovals: 2 can't be met for requests made by an approver, as users can't approve their own requests and there are only 2 approvers"}},
+		},
+		{
+			name: "approval stage needs every approver and one of them can request access",
+			giveRule: rule.AccessRule{
+				Groups: []string{"grp_requestors"},
+				Approval: rule.Approval{Stages: []rule.ApprovalStage{
+					{Users: []string{"usr_1"}},
+					{Users: []string{"usr_1", "usr_2"}, RequiredApprovals: 2},
+				}},
+			},
+			mockGetGroup: &identity.Group{Users: []string{"usr_1"}},
+			wantFields:   []apio.FieldError{{Field: "approval.stages[1].requiredApprovals", Error: "requiredApprovals: 2 can't be met for requests made by an approver, as users can't approve their own requests and there are only 2 approvers"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetGroup{Result: tc.mockGetGroup})
+
+			err := validateApprovalThreshold(context.Background(), db, tc.giveRule)
+			if tc.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, &apio.APIError{
+				Err:    errors.New("access rule validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: tc.wantFields,
+			}, err)
+		})
+	}
+}
@@ -3,68 +3,197 @@ package rulesvc
 import (
 	"context"
 
+	"github.com/common-fate/apikit/logger"
 	"github.com/common-fate/ddb"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/identity"
 	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
 	"github.com/common-fate/granted-approvals/pkg/storage"
 	"github.com/common-fate/granted-approvals/pkg/storage/dbupdate"
 	"github.com/common-fate/granted-approvals/pkg/types"
+	"go.uber.org/zap"
 )
 
-func (s *Service) ArchiveAccessRule(ctx context.Context, user *identity.User, in rule.AccessRule) (*rule.AccessRule, error) {
+type ArchiveOpts struct {
+	// RevokeGrants revokes the grants for the rule which are active or scheduled to start.
+	// Pending requests are always cancelled.
+	RevokeGrants bool
+}
+
+// ArchiveImpact is the requests which are affected by archiving an access rule.
+type ArchiveImpact struct {
+	// PendingRequests are cancelled when the rule is archived.
+	PendingRequests []access.Request
+	// ActiveGrants are the approved requests with grants which are active or scheduled to start.
+	// They are only revoked if the rule is archived with RevokeGrants.
+	ActiveGrants []access.Request
+}
+
+type ArchiveAction string
+
+const (
+	ArchiveCancelRequest ArchiveAction = "CANCEL_REQUEST"
+	ArchiveRevokeGrant   ArchiveAction = "REVOKE_GRANT"
+)
+
+type ArchiveOutcomeResult string
+
+const (
+	ArchiveSucceeded ArchiveOutcomeResult = "SUCCEEDED"
+	// ArchiveSkipped is the result for requests which were changed by someone else while the rule was being archived.
+	ArchiveSkipped ArchiveOutcomeResult = "SKIPPED"
+	ArchiveFailed  ArchiveOutcomeResult = "FAILED"
+)
+
+// ArchiveOutcome is what happened to a request affected by archiving an access rule.
+type ArchiveOutcome struct {
+	RequestID string
+	Action    ArchiveAction
+	Result    ArchiveOutcomeResult
+	// Message explains why the request was skipped or failed.
+	Message string
+}
+
+func (o ArchiveOutcome) ToAPI() types.ArchiveOutcome {
+	out := types.ArchiveOutcome{
+		RequestId: o.RequestID,
+		Action:    types.ArchiveOutcomeAction(o.Action),
+		Result:    types.ArchiveOutcomeResult(o.Result),
+	}
+	if o.Message != "" {
+		out.Message = &o.Message
+	}
+	return out
+}
+
+type ArchiveResult struct {
+	Rule     rule.AccessRule
+	Outcomes []ArchiveOutcome
+}
+
+// GetArchiveImpact returns the requests which would be affected by archiving a rule.
+func (s *Service) GetArchiveImpact(ctx context.Context, ruleID string) (*ArchiveImpact, error) {
+	pending, err := storage.ListAllRequestsForRuleAndStatus(ctx, s.DB, ruleID, access.PENDING)
+	if err != nil {
+		return nil, err
+	}
+	active, err := s.listActiveGrants(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	return &ArchiveImpact{PendingRequests: pending, ActiveGrants: active}, nil
+}
+
+// ArchiveAccessRule archives a rule and cancels its pending requests.
+// If opts.RevokeGrants is set, its active and scheduled grants are revoked too.
+// A request which can't be cancelled or revoked doesn't stop the rule being archived; the outcome for each request is returned instead.
+func (s *Service) ArchiveAccessRule(ctx context.Context, user *identity.User, in rule.AccessRule, opts ArchiveOpts) (*ArchiveResult, error) {
 	if in.Status == rule.ARCHIVED {
 		return nil, ErrAccessRuleAlreadyArchived
 	}
+	log := logger.Get(ctx).With("user.id", user.ID, "access_rule.id", in.ID)
 
 	// make a copy of the existing version which will be mutated
 	newVersion := in
 	newVersion.Status = rule.ARCHIVED
 	newVersion.Metadata.UpdatedAt = s.Clock.Now()
+	newVersion.Metadata.UpdatedBy = user.ID
+	newVersion.Metadata.UpdateMessage = nil
+	newVersion.Metadata.UpdateMetadata = nil
+	newVersion.Metadata.RolledBackTo = nil
 	newVersion.Version = types.NewVersionID()
 	newVersion.Current = true
 
 	// Set the existing version to not current
 	in.Current = false
 
+	// the rule is archived before its requests are cancelled, so that no new requests can be made for it while they are.
 	// creates a new version entry as well as setting the current version
-	items := []ddb.Keyer{&newVersion, &in}
-
-	// pagination in case of many many pending requests
-	hasMore := true
-	var next string
-	for hasMore {
-		// list requests for rule and status
-		// We currently don't have an access pattern for this directly, so we can fetch all the pending requests and filter them in go
-		q := storage.ListRequestsForStatus{Status: access.PENDING}
-		var opts []func(*ddb.QueryOpts)
-		if next != "" {
-			opts = append(opts, ddb.Page(next))
-		}
+	err := s.DB.PutBatch(ctx, &newVersion, &in)
+	if err != nil {
+		return nil, err
+	}
+
+	res := ArchiveResult{Rule: newVersion}
+	pending, err := storage.ListAllRequestsForRuleAndStatus(ctx, s.DB, in.ID, access.PENDING)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range pending {
+		res.Outcomes = append(res.Outcomes, s.cancelRequest(ctx, user, r))
+	}
 
-		res, err := s.DB.Query(ctx, &q, opts...)
-		if err != nil && err != ddb.ErrNoItems {
+	if opts.RevokeGrants {
+		active, err := s.listActiveGrants(ctx, in.ID)
+		if err != nil {
 			return nil, err
 		}
-		next = res.NextPage
-		hasMore = next != ""
-
-		for _, r := range q.Result {
-			if r.Rule == in.ID {
-				r.Status = access.CANCELLED
-				r.UpdatedAt = s.Clock.Now()
-				updateItems, err := dbupdate.GetUpdateRequestItems(ctx, s.DB, r)
-				if err != nil {
-					return nil, err
-				}
-				items = append(items, updateItems...)
+		for _, r := range active {
+			outcome := ArchiveOutcome{RequestID: r.ID, Action: ArchiveRevokeGrant, Result: ArchiveSucceeded}
+			_, err = s.Granter.RevokeGrant(ctx, grantsvc.RevokeGrantOpts{Request: r, RevokerID: user.ID})
+			switch {
+			case err == grantsvc.ErrGrantInactive || err == dbupdate.ErrRequestConflict:
+				outcome.Result = ArchiveSkipped
+				outcome.Message = "the grant was revoked or changed while the access rule was being archived"
+			case err != nil:
+				log.Errorw("failed to revoke grant while archiving access rule", "request.id", r.ID, zap.Error(err))
+				outcome.Result = ArchiveFailed
+				outcome.Message = err.Error()
 			}
+			res.Outcomes = append(res.Outcomes, outcome)
 		}
 	}
+	return &res, nil
+}
+
+// cancelRequest cancels a pending request for a rule which has been archived, with the archiving user as the actor.
+func (s *Service) cancelRequest(ctx context.Context, user *identity.User, r access.Request) ArchiveOutcome {
+	log := logger.Get(ctx).With("user.id", user.ID, "access_rule.id", r.Rule, "request.id", r.ID)
+	outcome := ArchiveOutcome{RequestID: r.ID, Action: ArchiveCancelRequest, Result: ArchiveSucceeded}
+	originalStatus := r.Status
+	r.Status = access.CANCELLED
+	r.UpdatedAt = s.Clock.Now()
+	// audit log event
+	reqEvent := access.NewStatusChangeEvent(r.ID, r.UpdatedAt, &user.ID, originalStatus, r.Status)
+
+	err := dbupdate.UpdateRequest(ctx, s.DB, &r, []ddb.Keyer{&reqEvent})
+	if err == dbupdate.ErrRequestConflict {
+		// the request was reviewed or cancelled while the rule was being archived.
+		outcome.Result = ArchiveSkipped
+		outcome.Message = "the request was changed while the access rule was being archived"
+		return outcome
+	}
+	if err != nil {
+		log.Errorw("failed to cancel request while archiving access rule", zap.Error(err))
+		outcome.Result = ArchiveFailed
+		outcome.Message = err.Error()
+		return outcome
+	}
 
-	err := s.DB.PutBatch(ctx, items...)
+	// the request has been cancelled, so a failure to send the event is logged rather than reported as a failure to cancel it.
+	err = s.EventPutter.Put(ctx, gevent.RequestCancelled{Request: r})
 	if err != nil {
-		return nil, err
+		log.Errorw("failed to send request cancelled event while archiving access rule", zap.Error(err))
+	}
+	return outcome
+}
+
+// listActiveGrants returns the approved requests for a rule which have a grant which is active or scheduled to start.
+func (s *Service) listActiveGrants(ctx context.Context, ruleID string) ([]access.Request, error) {
+	var active []access.Request
+	for _, status := range []access.Status{access.APPROVED, access.NEEDS_RETROSPECTIVE_REVIEW} {
+		requests, err := storage.ListAllRequestsForRuleAndStatus(ctx, s.DB, ruleID, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range requests {
+			if r.Grant != nil && (r.Grant.Status == ahTypes.ACTIVE || r.Grant.Status == ahTypes.PENDING) {
+				active = append(active, r)
+			}
+		}
 	}
-	return &newVersion, nil
+	return active, nil
 }
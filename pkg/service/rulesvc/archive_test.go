@@ -2,16 +2,22 @@ package rulesvc
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
 	"github.com/common-fate/granted-approvals/pkg/identity"
 	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
+	"github.com/common-fate/granted-approvals/pkg/service/rulesvc/mocks"
 	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,7 +75,7 @@ func TestArchiveAccessRule(t *testing.T) {
 
 			db := ddbmock.New(t)
 			db.PutBatchErr = tc.wantErr
-			db.MockQueryWithErrWithResult(&storage.ListRequestsForStatus{Status: access.PENDING, Result: []access.Request{}}, &ddb.QueryResult{}, nil)
+			db.MockQueryWithErrWithResult(&storage.ListRequestsForRuleAndStatus{Result: []access.Request{}}, &ddb.QueryResult{}, nil)
 			db.MockQueryWithErrWithResult(&storage.ListRequestReviewers{Result: []access.Reviewer{}}, &ddb.QueryResult{}, nil)
 
 			s := Service{
@@ -77,20 +83,105 @@ func TestArchiveAccessRule(t *testing.T) {
 				DB:    db,
 			}
 
-			got, err := s.ArchiveAccessRule(context.Background(), &tc.givenUser, tc.givenRule)
+			got, err := s.ArchiveAccessRule(context.Background(), &tc.givenUser, tc.givenRule, ArchiveOpts{})
+			assert.Equal(t, tc.wantErr, err)
+			if tc.want == nil {
+				assert.Nil(t, got)
+				return
+			}
 
 			// This is the only thing from service layer that we can't mock yet, hence the override
-			if err == nil {
-				// Rule id and version id must not be empty strings, we check this prior to overwriting them
-				assert.NotEmpty(t, got.Version)
-				got.Version = tc.want.Version
+			// Rule id and version id must not be empty strings, we check this prior to overwriting them
+			assert.NotEmpty(t, got.Rule.Version)
+			got.Rule.Version = tc.want.Version
+			assert.Equal(t, *tc.want, got.Rule)
 
-			}
+		})
+	}
 
-			assert.Equal(t, tc.wantErr, err)
-			assert.Equal(t, tc.want, got)
+}
 
-		})
+// requestsByStatus is a mock database which returns the requests with the status for ListRequestsForRuleAndStatus queries,
+// and records the items which are written.
+type requestsByStatus struct {
+	*ddbmock.Client
+	requests []access.Request
+	written  []ddb.Keyer
+}
+
+func (db *requestsByStatus) Query(ctx context.Context, qb ddb.QueryBuilder, opts ...func(*ddb.QueryOpts)) (*ddb.QueryResult, error) {
+	if q, ok := qb.(*storage.ListRequestsForRuleAndStatus); ok {
+		for _, r := range db.requests {
+			if r.Status == q.Status {
+				q.Result = append(q.Result, r)
+			}
+		}
+		return &ddb.QueryResult{}, nil
 	}
+	return db.Client.Query(ctx, qb, opts...)
+}
 
+func (db *requestsByStatus) PutBatch(ctx context.Context, items ...ddb.Keyer) error {
+	db.written = append(db.written, items...)
+	return nil
+}
+
+func TestArchiveAccessRuleRevokesGrants(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	user := identity.User{ID: "admin"}
+	rul := rule.AccessRule{ID: "rule", Status: rule.ACTIVE, Current: true}
+
+	pending := access.Request{ID: "req_pending", Rule: "rule", Status: access.PENDING}
+	active := access.Request{ID: "req_active", Rule: "rule", Status: access.APPROVED, Grant: &access.Grant{Status: ahTypes.ACTIVE}}
+	scheduled := access.Request{ID: "req_scheduled", Rule: "rule", Status: access.APPROVED, Grant: &access.Grant{Status: ahTypes.PENDING}}
+	expired := access.Request{ID: "req_expired", Rule: "rule", Status: access.APPROVED, Grant: &access.Grant{Status: ahTypes.EXPIRED}}
+
+	db := &requestsByStatus{Client: ddbmock.New(t), requests: []access.Request{pending, active, scheduled, expired}}
+	db.MockQueryWithErrWithResult(&storage.ListRequestReviewers{Result: []access.Reviewer{}}, &ddb.QueryResult{}, nil)
+
+	ctrl := gomock.NewController(t)
+	g := mocks.NewMockGranter(ctrl)
+	g.EXPECT().RevokeGrant(gomock.Any(), grantsvc.RevokeGrantOpts{Request: active, RevokerID: "admin"}).Return(&active, nil)
+	g.EXPECT().RevokeGrant(gomock.Any(), grantsvc.RevokeGrantOpts{Request: scheduled, RevokerID: "admin"}).Return(nil, errors.New("provider error"))
+
+	cancelled := pending
+	cancelled.Status = access.CANCELLED
+	cancelled.UpdatedAt = now
+	cancelled.Version = 1
+	ep := mocks.NewMockEventPutter(ctrl)
+	ep.EXPECT().Put(gomock.Any(), gevent.RequestCancelled{Request: cancelled}).Return(nil)
+
+	s := Service{Clock: clk, DB: db, Granter: g, EventPutter: ep}
+
+	impact, err := s.GetArchiveImpact(context.Background(), "rule")
+	assert.NoError(t, err)
+	assert.Equal(t, &ArchiveImpact{PendingRequests: []access.Request{pending}, ActiveGrants: []access.Request{active, scheduled}}, impact)
+
+	got, err := s.ArchiveAccessRule(context.Background(), &user, rul, ArchiveOpts{RevokeGrants: true})
+	assert.NoError(t, err)
+	assert.Equal(t, rule.ARCHIVED, got.Rule.Status)
+	assert.Equal(t, []ArchiveOutcome{
+		{RequestID: "req_pending", Action: ArchiveCancelRequest, Result: ArchiveSucceeded},
+		{RequestID: "req_active", Action: ArchiveRevokeGrant, Result: ArchiveSucceeded},
+		{RequestID: "req_scheduled", Action: ArchiveRevokeGrant, Result: ArchiveFailed, Message: "provider error"},
+	}, got.Outcomes)
+
+	// the rule is archived before its requests are cancelled.
+	if assert.NotEmpty(t, db.written) {
+		assert.Equal(t, &got.Rule, db.written[0])
+	}
+	// the cancellation is recorded in the request's history, with the user who archived the rule as the actor.
+	var events []access.RequestEvent
+	for _, item := range db.written {
+		if e, ok := item.(*access.RequestEvent); ok {
+			events = append(events, *e)
+		}
+	}
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "req_pending", events[0].RequestID)
+		assert.Equal(t, "admin", *events[0].Actor)
+		assert.Equal(t, access.PENDING, *events[0].FromStatus)
+		assert.Equal(t, access.CANCELLED, *events[0].ToStatus)
+	}
 }
@@ -14,9 +14,14 @@ import (
 )
 
 func (s *Service) CreateAccessRule(ctx context.Context, user *identity.User, in types.CreateAccessRuleRequest) (*rule.AccessRule, error) {
+	return s.createAccessRule(ctx, user.ID, in, nil, nil)
+}
+
+// createAccessRule creates a rule on behalf of the user. The update message and metadata are optional.
+func (s *Service) createAccessRule(ctx context.Context, userID string, in types.CreateAccessRuleRequest, updateMessage *string, updateMetadata *map[string]interface{}) (*rule.AccessRule, error) {
 	id := types.NewAccessRuleID()
 
-	log := logger.Get(ctx).With("user.id", user.ID, "access_rule.id", id)
+	log := logger.Get(ctx).With("user.id", userID, "access_rule.id", id)
 	now := s.Clock.Now()
 
 	// After verifying the provider, we can save the provider type to the rule for convenience
@@ -24,27 +29,35 @@ func (s *Service) CreateAccessRule(ctx context.Context, user *identity.User, in
 	if err != nil {
 		return nil, err
 	}
-	rul := rule.AccessRule{
-		ID:          id,
-		Approval:    rule.Approval(in.Approval),
-		Status:      rule.ACTIVE,
-		Description: in.Description,
-		Name:        in.Name,
-		Groups:      in.Groups,
-		Metadata: rule.AccessRuleMetadata{
-			CreatedAt: now,
-			CreatedBy: user.ID,
-			UpdatedAt: now,
-			UpdatedBy: user.ID,
-		},
-		Target: rule.Target{
-			ProviderID:   in.Target.ProviderId,
-			ProviderType: p.Type,
-			With:         in.Target.With.AdditionalProperties,
-		},
-		TimeConstraints: in.TimeConstraints,
-		Version:         types.NewVersionID(),
-		Current:         true,
+	rul := newRule(in)
+	rul.ID = id
+	rul.Status = rule.ACTIVE
+	rul.Metadata = rule.AccessRuleMetadata{
+		CreatedAt:      now,
+		CreatedBy:      userID,
+		UpdatedAt:      now,
+		UpdatedBy:      userID,
+		UpdateMessage:  updateMessage,
+		UpdateMetadata: updateMetadata,
+	}
+	rul.Target.ProviderType = p.Type
+	for i, t := range rul.AdditionalTargets {
+		ap, err := s.verifyRuleTarget(ctx, (*in.AdditionalTargets)[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "additional target %s", t.ProviderID)
+		}
+		rul.AdditionalTargets[i].ProviderType = ap.Type
+	}
+	rul.Version = types.NewVersionID()
+	rul.Current = true
+
+	err = s.validateRule(ctx, rul)
+	if err != nil {
+		return nil, err
+	}
+	err = s.validateTargetArgs(ctx, rul)
+	if err != nil {
+		return nil, err
 	}
 
 	log.Debugw("saving access rule", "rule", rul)
@@ -58,6 +71,57 @@ func (s *Service) CreateAccessRule(ctx context.Context, user *identity.User, in
 	return &rul, nil
 }
 
+// newRule builds an access rule from a create request.
+// The fields which are set when the rule is saved, such as the ID and metadata, are left empty.
+func newRule(in types.CreateAccessRuleRequest) rule.AccessRule {
+	rul := rule.AccessRule{
+		Approval:          rule.ApprovalFromAPI(in.Approval),
+		Description:       in.Description,
+		Name:              in.Name,
+		Groups:            in.Groups,
+		Owners:            rule.OwnersFromAPI(in.Owners),
+		Target:            rule.TargetFromAPI(in.Target),
+		AdditionalTargets: rule.TargetsFromAPI(in.AdditionalTargets),
+		TimeConstraints:   in.TimeConstraints,
+		Fields:            rule.RequestFieldsFromAPI(in.Fields),
+		UsageLimits:       in.UsageLimits,
+		AdmissionPolicy:   in.AdmissionPolicy,
+	}
+	if in.BreakGlass != nil {
+		rul.BreakGlass = *in.BreakGlass
+	}
+	return rul
+}
+
+// validateRule checks that the configuration of a rule is valid before it is saved.
+func (s *Service) validateRule(ctx context.Context, rul rule.AccessRule) error {
+	err := validateApprovalThreshold(ctx, s.DB, rul)
+	if err != nil {
+		return err
+	}
+	err = validateRequestFields(rul)
+	if err != nil {
+		return err
+	}
+	err = validateAllowedWindows(rul)
+	if err != nil {
+		return err
+	}
+	err = validateUsageLimits(rul)
+	if err != nil {
+		return err
+	}
+	err = validateApprovalPolicies(rul)
+	if err != nil {
+		return err
+	}
+	err = validateSelectableArguments(rul)
+	if err != nil {
+		return err
+	}
+	return validateAdmissionPolicy(rul)
+}
+
 // verifyRuleTarget fetches the provider and returns it if it exists
 func (s *Service) verifyRuleTarget(ctx context.Context, target types.CreateAccessRuleTarget) (*ahTypes.Provider, error) {
 	p, err := s.AHClient.GetProviderWithResponse(ctx, target.ProviderId)
@@ -2,10 +2,12 @@ package rulesvc
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/benbjohnson/clock"
+	"github.com/common-fate/apikit/apio"
 	"github.com/common-fate/ddb/ddbmock"
 	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
@@ -37,7 +39,7 @@ func TestCreateAccessRule(t *testing.T) {
 	mockRule := rule.AccessRule{
 		ID:          ruleID,
 		Version:     versionID,
-		Approval:    rule.Approval(in.Approval),
+		Approval:    rule.ApprovalFromAPI(in.Approval),
 		Status:      rule.ACTIVE,
 		Description: in.Description,
 		Name:        in.Name,
@@ -57,9 +59,15 @@ func TestCreateAccessRule(t *testing.T) {
 		Current:         true,
 	}
 
+	breakGlass := true
+	invalidRegex := "[a-z"
+	invalidAdmissionPolicy := "size(requestor.groups)"
+	validRegex := "[a-z]+"
+
 	/**
 	There are two test cases here:
 	- Create a valid rule
+	- Break-glass access requires the rule to have approvers
 	*/
 	testcases := []testcase{
 		{
@@ -72,6 +80,130 @@ func TestCreateAccessRule(t *testing.T) {
 				Type: "okta",
 			},
 		},
+		{
+			name:        "break-glass without approvers",
+			givenUserID: identity.User{ID: userID},
+			give:        types.CreateAccessRuleRequest{BreakGlass: &breakGlass},
+			withProviderResponse: ahTypes.Provider{
+				Id:   in.Target.ProviderId,
+				Type: "okta",
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("access rule validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "breakGlass",
+						Error: "break-glass access requires the access rule to have approvers",
+					},
+				},
+			},
+		},
+		{
+			name:        "invalid request fields",
+			givenUserID: identity.User{ID: userID},
+			give: types.CreateAccessRuleRequest{Fields: &[]types.RequestField{
+				{Id: "ticket", Label: "Ticket", Regex: &invalidRegex},
+				{Id: "ticket", Label: "Ticket again"},
+				{Id: "env", Label: "Environment", Regex: &validRegex, Options: &[]string{"dev"}},
+			}},
+			withProviderResponse: ahTypes.Provider{
+				Id:   in.Target.ProviderId,
+				Type: "okta",
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("access rule validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "fields[0].regex",
+						Error: "invalid regex: error parsing regexp: missing closing ]: `[a-z`",
+					},
+					{
+						Field: "fields[1].id",
+						Error: "duplicate field id: ticket",
+					},
+					{
+						Field: "fields[2]",
+						Error: "a field can have a regex or options, but not both",
+					},
+				},
+			},
+		},
+		{
+			name:        "invalid allowed windows",
+			givenUserID: identity.User{ID: userID},
+			give: types.CreateAccessRuleRequest{TimeConstraints: types.TimeConstraints{
+				MaxDurationSeconds: 3600,
+				AllowedWindows: &[]types.AllowedWindow{
+					{Timezone: "Not/AZone", Days: []types.Weekday{types.MONDAY}, StartTime: "09:00", EndTime: "17:00"},
+					{Timezone: "UTC", StartTime: "09:00", EndTime: "17:00"},
+				},
+			}},
+			withProviderResponse: ahTypes.Provider{
+				Id:   in.Target.ProviderId,
+				Type: "okta",
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("access rule validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "timeConstraints.allowedWindows[0]",
+						Error: "unknown time zone Not/AZone",
+					},
+					{
+						Field: "timeConstraints.allowedWindows[1].days",
+						Error: "at least one day is required",
+					},
+				},
+			},
+		},
+		{
+			name:        "invalid usage quota",
+			givenUserID: identity.User{ID: userID},
+			give: types.CreateAccessRuleRequest{
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 7200},
+				UsageLimits: &types.UsageLimits{
+					Quota: &types.UsageQuota{MaxDurationSeconds: 3600, WindowSeconds: 3600},
+				},
+			},
+			withProviderResponse: ahTypes.Provider{
+				Id:   in.Target.ProviderId,
+				Type: "okta",
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("access rule validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "usageLimits.quota.maxDurationSeconds",
+						Error: "the quota duration can't be shorter than the maximum duration of the access rule",
+					},
+				},
+			},
+		},
+		{
+			name:        "invalid admission policy",
+			givenUserID: identity.User{ID: userID},
+			give: types.CreateAccessRuleRequest{
+				AdmissionPolicy: &invalidAdmissionPolicy,
+			},
+			withProviderResponse: ahTypes.Provider{
+				Id:   in.Target.ProviderId,
+				Type: "okta",
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("access rule validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{
+						Field: "admissionPolicy",
+						Error: "the policy must evaluate to a bool or a map of strings, but evaluates to int",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -93,6 +225,11 @@ func TestCreateAccessRule(t *testing.T) {
 					StatusCode: http.StatusOK,
 				},
 			}, nil)
+			m.EXPECT().ValidateProviderArgsWithResponse(gomock.Any(), gomock.Eq(tc.give.Target.ProviderId), gomock.Any()).Return(&ahTypes.ValidateProviderArgsResponse{
+				HTTPResponse: &http.Response{
+					StatusCode: http.StatusOK,
+				},
+			}, nil).AnyTimes()
 			s := Service{
 				Clock:    clk,
 				DB:       &dbc,
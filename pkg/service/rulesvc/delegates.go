@@ -0,0 +1,71 @@
+package rulesvc
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"golang.org/x/sync/errgroup"
+)
+
+// Delegate is a user who reviews requests on behalf of an approver who is away.
+type Delegate struct {
+	// UserID is the delegate's user ID.
+	UserID string
+	// OnBehalfOf is the ID of the approver who delegated their reviews.
+	OnBehalfOf string
+}
+
+// GetDelegates returns the delegates for any of the approvers who have a delegation active at the given time.
+// Delegates who are already approvers are not returned, as they can review the request themselves.
+// If several approvers have delegated to the same user, the delegate is attributed to the first approver.
+//
+// Delegations are not followed transitively: if a delegate is also away, their own delegate is not added.
+func GetDelegates(ctx context.Context, db ddb.Storage, approvers []string, now time.Time) ([]Delegate, error) {
+	isApprover := make(map[string]bool, len(approvers))
+	for _, a := range approvers {
+		isApprover[a] = true
+	}
+
+	var mu sync.Mutex
+	// map of delegate ID to the approvers who delegated to them
+	delegates := make(map[string][]string)
+
+	wg, gctx := errgroup.WithContext(ctx)
+	for _, a := range approvers {
+		approver := a
+		wg.Go(func() error {
+			q := storage.GetUserDelegation{UserID: approver}
+			_, err := db.Query(gctx, &q)
+			if err == ddb.ErrNoItems {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			d := q.Result
+			if !d.IsActive(now) || isApprover[d.DelegateID] {
+				return nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			delegates[d.DelegateID] = append(delegates[d.DelegateID], approver)
+			return nil
+		})
+	}
+	err := wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Delegate{}
+	for delegate, onBehalfOf := range delegates {
+		sort.Strings(onBehalfOf)
+		res = append(res, Delegate{UserID: delegate, OnBehalfOf: onBehalfOf[0]})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].UserID < res[j].UserID })
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package rulesvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDelegates(t *testing.T) {
+	type testcase struct {
+		name           string
+		giveApprovers  []string
+		mockDelegation *identity.Delegation
+		want           []Delegate
+	}
+
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testcases := []testcase{
+		{
+			name:          "no delegation",
+			giveApprovers: []string{"usr_1"},
+			want:          []Delegate{},
+		},
+		{
+			name:           "active delegation",
+			giveApprovers:  []string{"usr_1"},
+			mockDelegation: &identity.Delegation{UserID: "usr_1", DelegateID: "usr_2", Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+			want:           []Delegate{{UserID: "usr_2", OnBehalfOf: "usr_1"}},
+		},
+		{
+			name:           "delegation not started",
+			giveApprovers:  []string{"usr_1"},
+			mockDelegation: &identity.Delegation{UserID: "usr_1", DelegateID: "usr_2", Start: now.Add(time.Hour), End: now.Add(time.Hour * 2)},
+			want:           []Delegate{},
+		},
+		{
+			name:           "delegation ended",
+			giveApprovers:  []string{"usr_1"},
+			mockDelegation: &identity.Delegation{UserID: "usr_1", DelegateID: "usr_2", Start: now.Add(-time.Hour * 2), End: now},
+			want:           []Delegate{},
+		},
+		{
+			name:           "delegate is already an approver",
+			giveApprovers:  []string{"usr_1", "usr_2"},
+			mockDelegation: &identity.Delegation{UserID: "usr_1", DelegateID: "usr_2", Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+			want:           []Delegate{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			if tc.mockDelegation != nil {
+				db.MockQuery(&storage.GetUserDelegation{Result: tc.mockDelegation})
+			} else {
+				db.MockQueryWithErr(&storage.GetUserDelegation{}, ddb.ErrNoItems)
+			}
+
+			got, err := GetDelegates(context.Background(), db, tc.giveApprovers, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
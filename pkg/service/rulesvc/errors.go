@@ -18,4 +18,13 @@ var (
 
 	// ErrAccessRuleAlreadyArchived is returned if an archive request is made for a rule which is already archived
 	ErrAccessRuleAlreadyArchived = errors.New("access rule already archived")
+
+	// ErrVersionIsCurrent is returned if a rule is rolled back to the version which is already current
+	ErrVersionIsCurrent = errors.New("this version is already the current version of the access rule")
+
+	// ErrOwnersChangeNotAllowed is returned if an owner of a rule tries to change its owners, which only administrators can do
+	ErrOwnersChangeNotAllowed = errors.New("only administrators can change the owners of an access rule")
+
+	// ErrAdminOnlyFieldsChanged is returned if an owner of a rule tries to change who can request access to it or how requests are approved
+	ErrAdminOnlyFieldsChanged = errors.New("only administrators can change the groups, approvers, approval policies, admission policy or break-glass setting of an access rule")
 )
@@ -0,0 +1,51 @@
+package rulesvc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+)
+
+// validateRequestFields checks that the request fields of a rule have unique IDs and valid validation settings.
+func validateRequestFields(rul rule.AccessRule) error {
+	var fields []apio.FieldError
+	seen := make(map[string]bool)
+	for i, f := range rul.Fields {
+		if seen[f.ID] {
+			fields = append(fields, apio.FieldError{
+				Field: fmt.Sprintf("fields[%d].id", i),
+				Error: fmt.Sprintf("duplicate field id: %s", f.ID),
+			})
+		}
+		seen[f.ID] = true
+
+		if f.Regex != nil {
+			if _, err := regexp.Compile(*f.Regex); err != nil {
+				fields = append(fields, apio.FieldError{
+					Field: fmt.Sprintf("fields[%d].regex", i),
+					Error: fmt.Sprintf("invalid regex: %s", err),
+				})
+			}
+		}
+		// fields with options are shown as a dropdown, so a regex on them would be redundant.
+		if f.Regex != nil && len(f.Options) > 0 {
+			fields = append(fields, apio.FieldError{
+				Field: fmt.Sprintf("fields[%d]", i),
+				Error: "a field can have a regex or options, but not both",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("access rule validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
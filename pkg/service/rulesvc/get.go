@@ -31,19 +31,21 @@ func canGet(user *identity.User, rule *rule.AccessRule, isAdmin bool) bool {
 	if isAdmin {
 		return true
 	}
-	// DE = User can see a rule they're an approver for
-	for _, au := range rule.Approval.Users {
-		if au == user.ID {
-			return true
-		}
-	}
-	// DE = User can see a rule they're an approver of (via groups)
-	for _, group := range user.Groups {
-		for _, g := range rule.Approval.Groups {
-			if g == group {
+	for _, st := range rule.Approval.GetStages() {
+		// DE = User can see a rule they're an approver for
+		for _, au := range st.Users {
+			if au == user.ID {
 				return true
 			}
 		}
+		// DE = User can see a rule they're an approver of (via groups)
+		for _, group := range user.Groups {
+			for _, g := range st.Groups {
+				if g == group {
+					return true
+				}
+			}
+		}
 	}
 	// DE = User can see a rule they're assigned to (via the groups)
 	for _, group := range user.Groups {
@@ -0,0 +1,90 @@
+package rulesvc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// GetOwnedRule returns the current version of a rule if the user is one of its owners.
+// Administrators can get any rule.
+func (s *Service) GetOwnedRule(ctx context.Context, ID string, user *identity.User, isAdmin bool) (*rule.AccessRule, error) {
+	q := storage.GetAccessRuleCurrent{ID: ID}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && !q.Result.Owners.Includes(user.ID, user.Groups) {
+		return nil, ErrUserNotAuthorized
+	}
+	return q.Result, nil
+}
+
+// ListOwnedRules returns the active rules which the user is an owner of, sorted by name.
+func (s *Service) ListOwnedRules(ctx context.Context, user *identity.User) ([]rule.AccessRule, error) {
+	q := storage.ListAccessRulesForStatus{Status: rule.ACTIVE}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	owned := []rule.AccessRule{}
+	for _, r := range q.Result {
+		if r.Owners.Includes(user.ID, user.Groups) {
+			owned = append(owned, r)
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool { return owned[i].Name < owned[j].Name })
+	return owned, nil
+}
+
+// CheckOwnerUpdate returns an error if an update to a rule made by one of its owners changes a field which only administrators can change.
+// Owners can't change the owners, or who can request access and how requests are approved, including whether break-glass
+// requests can skip approval, as otherwise they could make themselves approvers of the rule or give themselves access.
+// Owners can change the time constraints and usage limits, as every request is still reviewed by the approvers which
+// administrators choose, who see the duration that is requested. Administrators can change any field.
+func CheckOwnerUpdate(current rule.AccessRule, update types.UpdateAccessRuleRequest, isAdmin bool) error {
+	if isAdmin {
+		return nil
+	}
+	if update.Owners != nil {
+		updated := rule.OwnersFromAPI(update.Owners)
+		if !sameItems(current.Owners.Users, updated.Users) || !sameItems(current.Owners.Groups, updated.Groups) {
+			return ErrOwnersChangeNotAllowed
+		}
+	}
+	var fields []string
+	for _, ch := range rule.DiffVersions(current, applyUpdate(current, update)) {
+		if ch.Field == "groups" || ch.Field == "admissionPolicy" || ch.Field == "breakGlass" || strings.HasPrefix(ch.Field, "approval.") {
+			fields = append(fields, ch.Field)
+		}
+	}
+	if len(fields) > 0 {
+		return fmt.Errorf("%w (changed: %s)", ErrAdminOnlyFieldsChanged, strings.Join(fields, ", "))
+	}
+	return nil
+}
+
+// sameItems returns true if the lists contain the same items, in any order.
+func sameItems(a, b []string) bool {
+	in := map[string]bool{}
+	for _, s := range a {
+		in[s] = true
+	}
+	for _, s := range b {
+		if !in[s] {
+			return false
+		}
+	}
+	out := map[string]bool{}
+	for _, s := range b {
+		out[s] = true
+	}
+	return len(in) == len(out)
+}
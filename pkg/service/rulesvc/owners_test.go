@@ -0,0 +1,97 @@
+package rulesvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListOwnedRules(t *testing.T) {
+	byUser := rule.AccessRule{ID: "rul_1", Name: "Production", Owners: rule.Owners{Users: []string{"usr_1"}}}
+	byGroup := rule.AccessRule{ID: "rul_2", Name: "Developers", Owners: rule.Owners{Groups: []string{"platform"}}}
+	notOwned := rule.AccessRule{ID: "rul_3", Name: "Admins", Owners: rule.Owners{Users: []string{"usr_2"}}}
+
+	db := ddbmock.New(t)
+	db.MockQuery(&storage.ListAccessRulesForStatus{Result: []rule.AccessRule{byUser, byGroup, notOwned}})
+	s := Service{DB: db}
+
+	got, err := s.ListOwnedRules(context.Background(), &identity.User{ID: "usr_1", Groups: []string{"platform"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []rule.AccessRule{byGroup, byUser}, got)
+}
+
+func TestGetOwnedRule(t *testing.T) {
+	type testcase struct {
+		name    string
+		user    identity.User
+		isAdmin bool
+		wantErr error
+	}
+	owned := rule.AccessRule{ID: "rul_1", Owners: rule.Owners{Users: []string{"usr_1"}, Groups: []string{"platform"}}}
+
+	testcases := []testcase{
+		{name: "owner", user: identity.User{ID: "usr_1"}},
+		{name: "owner through group", user: identity.User{ID: "usr_2", Groups: []string{"platform"}}},
+		{name: "admin", user: identity.User{ID: "usr_3"}, isAdmin: true},
+		{name: "not an owner", user: identity.User{ID: "usr_3", Groups: []string{"developers"}}, wantErr: ErrUserNotAuthorized},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.GetAccessRuleCurrent{Result: &owned})
+			s := Service{DB: db}
+
+			got, err := s.GetOwnedRule(context.Background(), "rul_1", &tc.user, tc.isAdmin)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &owned, got)
+		})
+	}
+}
+
+func TestCheckOwnerUpdate(t *testing.T) {
+	current := rule.AccessRule{Owners: rule.Owners{Users: []string{"usr_1", "usr_2"}, Groups: []string{"platform"}}}
+
+	reordered := types.UpdateAccessRuleRequest{Owners: &types.AccessRuleOwners{Users: []string{"usr_2", "usr_1"}, Groups: []string{"platform"}}}
+	assert.NoError(t, CheckOwnerUpdate(current, reordered, false))
+	assert.NoError(t, CheckOwnerUpdate(current, types.UpdateAccessRuleRequest{}, false))
+
+	removed := types.UpdateAccessRuleRequest{Owners: &types.AccessRuleOwners{Users: []string{"usr_1"}, Groups: []string{"platform"}}}
+	assert.Equal(t, ErrOwnersChangeNotAllowed, CheckOwnerUpdate(current, removed, false))
+	assert.NoError(t, CheckOwnerUpdate(current, removed, true))
+
+	// owners can change other fields, but not who can request access or approve it.
+	renamed := types.UpdateAccessRuleRequest{Name: "renamed", TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 600}}
+	assert.NoError(t, CheckOwnerUpdate(current, renamed, false))
+	maxActive := 2
+	limited := types.UpdateAccessRuleRequest{UsageLimits: &types.UsageLimits{MaxActiveGrants: &maxActive}}
+	assert.NoError(t, CheckOwnerUpdate(current, limited, false))
+
+	// break-glass requests skip approval, so owners can't allow them.
+	breakGlass := true
+	skipApproval := types.UpdateAccessRuleRequest{BreakGlass: &breakGlass}
+	err := CheckOwnerUpdate(current, skipApproval, false)
+	assert.ErrorIs(t, err, ErrAdminOnlyFieldsChanged)
+	assert.EqualError(t, err, "only administrators can change the groups, approvers, approval policies, admission policy or break-glass setting of an access rule (changed: breakGlass)")
+	assert.NoError(t, CheckOwnerUpdate(current, skipApproval, true))
+
+	selfApprover := types.UpdateAccessRuleRequest{Approval: types.ApproverConfig{Users: []string{"usr_1"}}}
+	err = CheckOwnerUpdate(current, selfApprover, false)
+	assert.ErrorIs(t, err, ErrAdminOnlyFieldsChanged)
+	assert.EqualError(t, err, "only administrators can change the groups, approvers, approval policies, admission policy or break-glass setting of an access rule (changed: approval.users)")
+	assert.NoError(t, CheckOwnerUpdate(current, selfApprover, true))
+
+	policy := "true"
+	widened := types.UpdateAccessRuleRequest{Groups: []string{"everyone"}, AdmissionPolicy: &policy}
+	assert.EqualError(t, CheckOwnerUpdate(current, widened, false), "only administrators can change the groups, approvers, approval policies, admission policy or break-glass setting of an access rule (changed: groups, admissionPolicy)")
+}
@@ -0,0 +1,305 @@
+package rulesvc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// RuleSpec is an access rule which is managed as code.
+// Apart from the ID, the fields are the same as the admin API's CreateAccessRuleRequest.
+type RuleSpec struct {
+	// ID is the ID of the deployed rule. Rules which haven't been created yet don't have an ID,
+	// and are matched to deployed rules by name.
+	ID string `json:"id,omitempty"`
+	types.CreateAccessRuleRequest
+	// Source is where the rule was defined, such as a file path. It is only used in messages.
+	Source string `json:"-"`
+}
+
+// SpecFromRule returns the spec for a deployed rule.
+func SpecFromRule(r rule.AccessRule) RuleSpec {
+	detail := r.ToAPIDetail()
+	spec := RuleSpec{
+		ID: r.ID,
+		CreateAccessRuleRequest: types.CreateAccessRuleRequest{
+			AdmissionPolicy: detail.AdmissionPolicy,
+			Approval:        detail.Approval,
+			BreakGlass:      detail.BreakGlass,
+			Description:     detail.Description,
+			Fields:          detail.Fields,
+			Groups:          detail.Groups,
+			Name:            detail.Name,
+			Owners:          detail.Owners,
+			Target:          r.Target.ToCreateAPI(),
+			TimeConstraints: detail.TimeConstraints,
+			UsageLimits:     detail.UsageLimits,
+		},
+	}
+	if len(r.AdditionalTargets) > 0 {
+		targets := make([]types.CreateAccessRuleTarget, len(r.AdditionalTargets))
+		for i, t := range r.AdditionalTargets {
+			targets[i] = t.ToCreateAPI()
+		}
+		spec.AdditionalTargets = &targets
+	}
+	return spec
+}
+
+// ExportRules returns the specs of the active access rules, sorted by name.
+func (s *Service) ExportRules(ctx context.Context) ([]RuleSpec, error) {
+	q := storage.ListAccessRulesForStatus{Status: rule.ACTIVE}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+	specs := make([]RuleSpec, len(q.Result))
+	for i, r := range q.Result {
+		specs[i] = SpecFromRule(r)
+	}
+	sort.SliceStable(specs, func(i, j int) bool { return specs[i].Name < specs[j].Name })
+	return specs, nil
+}
+
+type RuleChangeType string
+
+const (
+	RuleCreate  RuleChangeType = "create"
+	RuleUpdate  RuleChangeType = "update"
+	RuleArchive RuleChangeType = "archive"
+)
+
+// RuleChange is a change which needs to be made for the deployed rules to match their specs.
+type RuleChange struct {
+	Type RuleChangeType
+	// Spec is nil when a rule is archived.
+	Spec *RuleSpec
+	// Current is the deployed rule. It is nil when a rule is created.
+	Current *rule.AccessRule
+	// Fields are the fields of the spec which are changed by an update.
+	Fields []string
+}
+
+// Name returns the name of the rule which is changed.
+func (c RuleChange) Name() string {
+	if c.Spec != nil {
+		return c.Spec.Name
+	}
+	return c.Current.Name
+}
+
+// PlanRules compares the specs with the deployed rules, and returns the changes needed for the deployed rules to match.
+// Active rules which don't have a spec are archived. Running the plan again once the changes are applied returns no changes.
+func (s *Service) PlanRules(ctx context.Context, specs []RuleSpec) ([]RuleChange, error) {
+	q := storage.ListCurrentAccessRules{}
+	_, err := s.DB.Query(ctx, &q)
+	if err != nil && err != ddb.ErrNoItems {
+		return nil, err
+	}
+
+	byID := map[string]*rule.AccessRule{}
+	byName := map[string][]*rule.AccessRule{}
+	for i := range q.Result {
+		r := &q.Result[i]
+		byID[r.ID] = r
+		if r.Status == rule.ACTIVE {
+			byName[r.Name] = append(byName[r.Name], r)
+		}
+	}
+
+	var changes []RuleChange
+	names := map[string]string{}
+	matched := map[string]bool{}
+	for i := range specs {
+		spec := &specs[i]
+		if spec.Name == "" {
+			return nil, fmt.Errorf("%s: the access rule doesn't have a name", spec.Source)
+		}
+		if other, ok := names[spec.Name]; ok {
+			return nil, fmt.Errorf("%s: the access rule %q is also defined in %s", spec.Source, spec.Name, other)
+		}
+		names[spec.Name] = spec.Source
+
+		current, err := matchRule(*spec, byID, byName)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			err = s.validateRule(ctx, newRule(spec.CreateAccessRuleRequest))
+			if err != nil {
+				return nil, specError(*spec, err)
+			}
+			changes = append(changes, RuleChange{Type: RuleCreate, Spec: spec})
+			continue
+		}
+		if matched[current.ID] {
+			return nil, fmt.Errorf("%s: the access rule %s is defined more than once", spec.Source, current.ID)
+		}
+		matched[current.ID] = true
+
+		fields, err := changedFields(SpecFromRule(*current), *spec)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		for _, f := range fields {
+			if f == "target" || f == "additionalTargets" {
+				return nil, fmt.Errorf("%s: the targets of access rule %s can't be changed. Remove the id from the spec and archive the rule to replace it with a new one", spec.Source, current.ID)
+			}
+		}
+		updated := newRule(spec.CreateAccessRuleRequest)
+		updated.ID = current.ID
+		err = s.validateRule(ctx, updated)
+		if err != nil {
+			return nil, specError(*spec, err)
+		}
+		changes = append(changes, RuleChange{Type: RuleUpdate, Spec: spec, Current: current, Fields: fields})
+	}
+
+	for i := range q.Result {
+		r := &q.Result[i]
+		if r.Status == rule.ACTIVE && !matched[r.ID] {
+			changes = append(changes, RuleChange{Type: RuleArchive, Current: r})
+		}
+	}
+
+	order := map[RuleChangeType]int{RuleCreate: 0, RuleUpdate: 1, RuleArchive: 2}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Type != changes[j].Type {
+			return order[changes[i].Type] < order[changes[j].Type]
+		}
+		return changes[i].Name() < changes[j].Name()
+	})
+	return changes, nil
+}
+
+// specError adds the source of the spec to a validation error, including the fields which are invalid.
+func specError(spec RuleSpec, err error) error {
+	var apiErr *apio.APIError
+	if errors.As(err, &apiErr) && len(apiErr.Fields) > 0 {
+		var fields []string
+		for _, f := range apiErr.Fields {
+			fields = append(fields, fmt.Sprintf("%s: %s", f.Field, f.Error))
+		}
+		return fmt.Errorf("%s: %w (%s)", spec.Source, err, strings.Join(fields, ", "))
+	}
+	return fmt.Errorf("%s: %w", spec.Source, err)
+}
+
+// matchRule finds the deployed rule for a spec. It returns nil if the rule hasn't been created yet.
+func matchRule(spec RuleSpec, byID map[string]*rule.AccessRule, byName map[string][]*rule.AccessRule) (*rule.AccessRule, error) {
+	if spec.ID != "" {
+		r, ok := byID[spec.ID]
+		if !ok {
+			return nil, fmt.Errorf("%s: access rule %s was not found", spec.Source, spec.ID)
+		}
+		if r.Status == rule.ARCHIVED {
+			return nil, fmt.Errorf("%s: access rule %s is archived. Remove the id from the spec to create a new rule", spec.Source, spec.ID)
+		}
+		return r, nil
+	}
+	rules := byName[spec.Name]
+	if len(rules) > 1 {
+		return nil, fmt.Errorf("%s: there is more than one access rule named %q, set the id of the rule in the spec", spec.Source, spec.Name)
+	}
+	if len(rules) == 1 {
+		return rules[0], nil
+	}
+	return nil, nil
+}
+
+// changedFields returns the names of the fields which differ between the specs.
+// Fields which are empty are treated the same as fields which aren't set.
+func changedFields(a, b RuleSpec) ([]string, error) {
+	am, err := specFields(a)
+	if err != nil {
+		return nil, err
+	}
+	bm, err := specFields(b)
+	if err != nil {
+		return nil, err
+	}
+	keys := map[string]bool{}
+	for k := range am {
+		keys[k] = true
+	}
+	for k := range bm {
+		keys[k] = true
+	}
+	var fields []string
+	for k := range keys {
+		if !reflect.DeepEqual(am[k], bm[k]) {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// specFields returns the fields of the spec as they would appear in JSON, without the empty ones.
+func specFields(s RuleSpec) (map[string]interface{}, error) {
+	b, err := json.Marshal(s.CreateAccessRuleRequest)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err = json.Unmarshal(b, &m)
+	if err != nil {
+		return nil, err
+	}
+	pruned, _ := pruneEmpty(m).(map[string]interface{})
+	return pruned, nil
+}
+
+// pruneEmpty removes empty values from maps, and returns nil if the value itself is empty.
+func pruneEmpty(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for k, e := range val {
+			if p := pruneEmpty(e); p != nil {
+				out[k] = p
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case []interface{}:
+		if len(val) == 0 {
+			return nil
+		}
+		out := make([]interface{}, len(val))
+		for i, e := range val {
+			out[i] = pruneEmpty(e)
+		}
+		return out
+	case string:
+		if val == "" {
+			return nil
+		}
+	case bool:
+		if !val {
+			return nil
+		}
+	case float64:
+		if val == 0 {
+			return nil
+		}
+	case nil:
+		return nil
+	}
+	return v
+}
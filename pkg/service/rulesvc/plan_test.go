@@ -0,0 +1,134 @@
+package rulesvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/storage"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanRules(t *testing.T) {
+	type change struct {
+		Type   RuleChangeType
+		Name   string
+		Fields []string
+	}
+	type testcase struct {
+		name     string
+		deployed []rule.AccessRule
+		specs    []RuleSpec
+		want     []change
+		wantErr  string
+	}
+
+	deployed := rule.AccessRule{
+		ID:          "rul_1",
+		Name:        "Developers",
+		Description: "dev access",
+		Status:      rule.ACTIVE,
+		Groups:      []string{"developers"},
+		Approval:    rule.Approval{Users: []string{"admin"}},
+		Target:      rule.Target{ProviderID: "okta", With: map[string]string{"groupId": "123"}},
+		TimeConstraints: types.TimeConstraints{
+			MaxDurationSeconds: 3600,
+		},
+		Current: true,
+	}
+	archived := deployed
+	archived.ID = "rul_2"
+	archived.Status = rule.ARCHIVED
+
+	unchanged := SpecFromRule(deployed)
+	unchanged.Source = "developers.yml"
+	// a spec without an id is matched by name.
+	byName := unchanged
+	byName.ID = ""
+	renamed := unchanged
+	renamed.Name = "Engineers"
+	renamed.Description = "engineering access"
+	newRule := byName
+	newRule.Name = "Admins"
+	newRule.Source = "admins.yml"
+	newTarget := unchanged
+	newTarget.Target.ProviderId = "aws-sso"
+	breakGlass := true
+	invalid := newRule
+	invalid.Approval = types.ApproverConfig{}
+	invalid.BreakGlass = &breakGlass
+
+	testcases := []testcase{
+		{
+			name:     "no changes",
+			deployed: []rule.AccessRule{deployed, archived},
+			specs:    []RuleSpec{unchanged},
+		},
+		{
+			name:     "matched by name",
+			deployed: []rule.AccessRule{deployed},
+			specs:    []RuleSpec{byName},
+		},
+		{
+			name:     "update, create and archive",
+			deployed: []rule.AccessRule{deployed},
+			specs:    []RuleSpec{newRule},
+			want: []change{
+				{Type: RuleCreate, Name: "Admins"},
+				{Type: RuleArchive, Name: "Developers"},
+			},
+		},
+		{
+			name:     "update",
+			deployed: []rule.AccessRule{deployed},
+			specs:    []RuleSpec{renamed},
+			want: []change{
+				{Type: RuleUpdate, Name: "Engineers", Fields: []string{"description", "name"}},
+			},
+		},
+		{
+			name:     "target can't change",
+			deployed: []rule.AccessRule{deployed},
+			specs:    []RuleSpec{newTarget},
+			wantErr:  "developers.yml: the targets of access rule rul_1 can't be changed. Remove the id from the spec and archive the rule to replace it with a new one",
+		},
+		{
+			name:     "archived rule",
+			deployed: []rule.AccessRule{archived},
+			specs:    []RuleSpec{{ID: "rul_2", CreateAccessRuleRequest: unchanged.CreateAccessRuleRequest, Source: "developers.yml"}},
+			wantErr:  "developers.yml: access rule rul_2 is archived. Remove the id from the spec to create a new rule",
+		},
+		{
+			name:    "duplicate names",
+			specs:   []RuleSpec{newRule, newRule},
+			wantErr: `admins.yml: the access rule "Admins" is also defined in admins.yml`,
+		},
+		{
+			name:    "invalid rule",
+			specs:   []RuleSpec{invalid},
+			wantErr: "admins.yml: access rule validation failed (breakGlass: break-glass access requires the access rule to have approvers)",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := ddbmock.New(t)
+			db.MockQuery(&storage.ListCurrentAccessRules{Result: tc.deployed})
+
+			s := Service{DB: db}
+			got, err := s.PlanRules(context.Background(), tc.specs)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var changes []change
+			for _, c := range got {
+				changes = append(changes, change{Type: c.Type, Name: c.Name(), Fields: c.Fields})
+			}
+			assert.Equal(t, tc.want, changes)
+		})
+	}
+}
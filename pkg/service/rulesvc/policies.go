@@ -0,0 +1,43 @@
+package rulesvc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+// validateApprovalPolicies checks that the approval policies of a rule have unique IDs,
+// and that there are approvers to review the requests matched by policies which require review.
+func validateApprovalPolicies(rul rule.AccessRule) error {
+	var fields []apio.FieldError
+	seen := make(map[string]bool)
+	for i, p := range rul.Approval.Policies {
+		if seen[p.ID] {
+			fields = append(fields, apio.FieldError{
+				Field: fmt.Sprintf("approval.policies[%d].id", i),
+				Error: fmt.Sprintf("duplicate policy id: %s", p.ID),
+			})
+		}
+		seen[p.ID] = true
+
+		if p.Action == types.REQUIREREVIEW && !rul.Approval.IsRequired() {
+			fields = append(fields, apio.FieldError{
+				Field: fmt.Sprintf("approval.policies[%d].action", i),
+				Error: "policies which require review need the access rule to have approvers",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("access rule validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
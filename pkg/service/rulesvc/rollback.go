@@ -0,0 +1,65 @@
+package rulesvc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+type RollbackOpts struct {
+	UpdaterID string
+	// Rule is the current version of the rule.
+	Rule rule.AccessRule
+	// Version is the earlier version to roll back to.
+	Version       rule.AccessRule
+	UpdateMessage *string
+}
+
+// RollbackRule creates a new current version of a rule which copies an earlier version.
+// The target of a rule can't be changed, so the target of the current version is kept.
+func (s *Service) RollbackRule(ctx context.Context, in *RollbackOpts) (*rule.AccessRule, error) {
+	if in.Rule.Status == rule.ARCHIVED {
+		return nil, ErrAccessRuleAlreadyArchived
+	}
+	if in.Version.Version == in.Rule.Version {
+		return nil, ErrVersionIsCurrent
+	}
+
+	newVersion := in.Version
+	newVersion.Current = true
+	newVersion.Status = in.Rule.Status
+	newVersion.Target = in.Rule.Target
+	newVersion.Version = types.NewVersionID()
+
+	rolledBackTo := in.Version.Version
+	msg := in.UpdateMessage
+	if msg == nil || *msg == "" {
+		m := fmt.Sprintf("Rolled back to version %s", rolledBackTo)
+		msg = &m
+	}
+	newVersion.Metadata = rule.AccessRuleMetadata{
+		CreatedAt:     in.Rule.Metadata.CreatedAt,
+		CreatedBy:     in.Rule.Metadata.CreatedBy,
+		UpdatedAt:     s.Clock.Now(),
+		UpdatedBy:     in.UpdaterID,
+		UpdateMessage: msg,
+		RolledBackTo:  &rolledBackTo,
+	}
+
+	// the rule is validated again, as validation may have changed since the earlier version was saved.
+	err := s.validateRule(ctx, newVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the existing version to not current
+	in.Rule.Current = false
+
+	err = s.DB.PutBatch(ctx, &newVersion, &in.Rule)
+	if err != nil {
+		return nil, err
+	}
+	return &newVersion, nil
+}
@@ -0,0 +1,134 @@
+package rulesvc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollbackRule(t *testing.T) {
+	clk := clock.NewMock()
+	now := clk.Now()
+	msg := "reverting bad edit"
+
+	current := rule.AccessRule{
+		ID:          "rule1",
+		Version:     "v2",
+		Current:     true,
+		Status:      rule.ACTIVE,
+		Name:        "Developers",
+		Description: "broken",
+		Groups:      []string{"everyone"},
+		Target:      rule.Target{ProviderID: "okta", With: map[string]string{"groupId": "123"}},
+		Metadata: rule.AccessRuleMetadata{
+			CreatedBy: "creator",
+			UpdatedBy: "editor",
+		},
+	}
+	earlier := current
+	earlier.Version = "v1"
+	earlier.Current = false
+	earlier.Description = "dev access"
+	earlier.Groups = []string{"developers"}
+	earlier.TimeConstraints = types.TimeConstraints{MaxDurationSeconds: 3600}
+	archived := current
+	archived.Status = rule.ARCHIVED
+
+	type testcase struct {
+		name    string
+		rule    rule.AccessRule
+		version rule.AccessRule
+		message *string
+		want    *rule.AccessRule
+		wantErr error
+	}
+
+	v1 := "v1"
+	defaultMsg := "Rolled back to version v1"
+	testcases := []testcase{
+		{
+			name:    "ok",
+			rule:    current,
+			version: earlier,
+			message: &msg,
+			want: &rule.AccessRule{
+				ID:              "rule1",
+				Current:         true,
+				Status:          rule.ACTIVE,
+				Name:            "Developers",
+				Description:     "dev access",
+				Groups:          []string{"developers"},
+				Target:          current.Target,
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600},
+				Metadata: rule.AccessRuleMetadata{
+					CreatedBy:     "creator",
+					UpdatedBy:     "admin",
+					UpdatedAt:     now,
+					UpdateMessage: &msg,
+					RolledBackTo:  &v1,
+				},
+			},
+		},
+		{
+			name:    "default message",
+			rule:    current,
+			version: earlier,
+			want: &rule.AccessRule{
+				ID:              "rule1",
+				Current:         true,
+				Status:          rule.ACTIVE,
+				Name:            "Developers",
+				Description:     "dev access",
+				Groups:          []string{"developers"},
+				Target:          current.Target,
+				TimeConstraints: types.TimeConstraints{MaxDurationSeconds: 3600},
+				Metadata: rule.AccessRuleMetadata{
+					CreatedBy:     "creator",
+					UpdatedBy:     "admin",
+					UpdatedAt:     now,
+					UpdateMessage: &defaultMsg,
+					RolledBackTo:  &v1,
+				},
+			},
+		},
+		{
+			name:    "current version",
+			rule:    current,
+			version: current,
+			wantErr: ErrVersionIsCurrent,
+		},
+		{
+			name:    "archived rule",
+			rule:    archived,
+			version: earlier,
+			wantErr: ErrAccessRuleAlreadyArchived,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := Service{
+				Clock: clk,
+				DB:    &ddbmock.Client{},
+			}
+			got, err := s.RollbackRule(context.Background(), &RollbackOpts{
+				UpdaterID:     "admin",
+				Rule:          tc.rule,
+				Version:       tc.version,
+				UpdateMessage: tc.message,
+			})
+			if err == nil {
+				// the version ID is random.
+				assert.NotEmpty(t, got.Version)
+				got.Version = ""
+			}
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package rulesvc
 
 import (
+	"context"
+
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/gevent"
+	"github.com/common-fate/granted-approvals/pkg/service/grantsvc"
 )
 
 // Service holds business logic relating to Access Rules.
@@ -11,4 +16,19 @@ type Service struct {
 	Clock    clock.Clock
 	AHClient types.ClientWithResponsesInterface
 	DB       ddb.Storage
+	Granter  Granter
+	// EventPutter sends the events for requests which are cancelled when a rule is archived.
+	EventPutter EventPutter
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/granter.go -package=mocks . Granter
+
+// Granter revokes the grants for a rule when it is archived.
+type Granter interface {
+	RevokeGrant(ctx context.Context, opts grantsvc.RevokeGrantOpts) (*access.Request, error)
+}
+
+//go:generate go run github.com/golang/mock/mockgen -destination=mocks/eventputter.go -package=mocks . EventPutter
+type EventPutter interface {
+	Put(ctx context.Context, detail gevent.EventTyper) error
 }
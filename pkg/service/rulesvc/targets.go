@@ -0,0 +1,151 @@
+package rulesvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/apikit/logger"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// validateSelectableArguments checks that each selectable argument of a rule's target has values for the requestor to choose from.
+// Only the target of the rule can have selectable arguments, as the additional targets are granted without input from the requestor.
+func validateSelectableArguments(rul rule.AccessRule) error {
+	var fields []apio.FieldError
+	ids := make([]string, 0, len(rul.Target.WithSelectable))
+	for id := range rul.Target.WithSelectable {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		arg := rul.Target.WithSelectable[id]
+		if _, ok := rul.Target.With[id]; ok {
+			fields = append(fields, apio.FieldError{
+				Field: "target.withSelectable." + id,
+				Error: "the argument is already set in target.with",
+			})
+		}
+		if len(arg.Values) == 0 && !arg.AnyOption {
+			fields = append(fields, apio.FieldError{
+				Field: "target.withSelectable." + id,
+				Error: "the argument must have values or allow any option",
+			})
+		}
+	}
+	for i, t := range rul.AdditionalTargets {
+		if len(t.WithSelectable) > 0 {
+			fields = append(fields, apio.FieldError{
+				Field: fmt.Sprintf("additionalTargets[%d].withSelectable", i),
+				Error: "only the target of the access rule can have selectable arguments",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("access rule validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
+
+// targetsChanged returns true if the provider or arguments of any of the rule's targets differ between the versions.
+func targetsChanged(from, to rule.AccessRule) bool {
+	for _, ch := range rule.DiffVersions(from, to) {
+		if strings.HasPrefix(ch.Field, "target.") || ch.Field == "additionalTargets" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTargetArgs checks the arguments of each of a rule's targets with the Access Handler.
+// This finds arguments which don't exist in the provider, such as a mistyped group ID, when the rule is saved rather than when access is granted.
+func (s *Service) validateTargetArgs(ctx context.Context, rul rule.AccessRule) error {
+	fields, err := s.validateArgs(ctx, "target", rul.Target)
+	if err != nil {
+		return err
+	}
+	for i, t := range rul.AdditionalTargets {
+		f, err := s.validateArgs(ctx, fmt.Sprintf("additionalTargets[%d]", i), t)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, f...)
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("access rule validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
+
+// validateArgs validates the arguments of a target with its provider.
+// The fields of the errors returned by the Access Handler are prefixed with the field of the target in the rule.
+func (s *Service) validateArgs(ctx context.Context, field string, t rule.Target) ([]apio.FieldError, error) {
+	body := ahTypes.ValidateArgs{
+		With: ahTypes.ValidateArgs_With{AdditionalProperties: t.With},
+	}
+	if len(t.WithSelectable) > 0 {
+		selectable := map[string][]string{}
+		for id, arg := range t.WithSelectable {
+			// an empty list of values allows any of the provider's options.
+			values := []string{}
+			if !arg.AnyOption {
+				values = arg.Values
+			}
+			selectable[id] = values
+		}
+		body.Selectable = &ahTypes.ValidateArgs_Selectable{AdditionalProperties: selectable}
+	}
+
+	res, err := s.AHClient.ValidateProviderArgsWithResponse(ctx, t.ProviderID, body)
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode() {
+	case http.StatusOK:
+		return nil, nil
+	case http.StatusBadRequest:
+		if res.JSON400 == nil {
+			// the body wasn't the expected error response.
+			break
+		}
+		if res.JSON400.Fields == nil {
+			return nil, apio.NewRequestError(errors.New(res.JSON400.Error), http.StatusBadRequest)
+		}
+		var fields []apio.FieldError
+		for _, f := range *res.JSON400.Fields {
+			// the Access Handler calls the selectable arguments "selectable", rather than "withSelectable" as in the rule.
+			name := f.Field
+			if strings.HasPrefix(name, "selectable.") {
+				name = "withSelectable." + strings.TrimPrefix(name, "selectable.")
+			}
+			fields = append(fields, apio.FieldError{Field: field + "." + name, Error: f.Error})
+		}
+		return fields, nil
+	case http.StatusNotFound:
+		return nil, ErrProviderNotFound
+	case http.StatusInternalServerError:
+		if res.JSON500 == nil || res.JSON500.Error == nil {
+			break
+		}
+		return nil, pkgerrors.Wrap(errors.New(aws.ToString(res.JSON500.Error)), "error while validating target arguments in access handler")
+	}
+	logger.Get(ctx).Errorw("unhandled Access Handler response", "body", string(res.Body))
+	return nil, ErrUnhandledResponseFromAccessHandler
+}
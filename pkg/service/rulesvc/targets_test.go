@@ -0,0 +1,225 @@
+package rulesvc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/common-fate/apikit/apio"
+	ahTypes "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSelectableArguments(t *testing.T) {
+	type testcase struct {
+		name       string
+		give       rule.AccessRule
+		wantFields []apio.FieldError
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: rule.AccessRule{
+				Target: rule.Target{
+					With:           map[string]string{"permissionSetArn": "arn"},
+					WithSelectable: map[string]rule.SelectableArgument{"accountId": {Values: []string{"123"}}, "region": {AnyOption: true}},
+				},
+			},
+		},
+		{
+			name: "argument is also fixed",
+			give: rule.AccessRule{
+				Target: rule.Target{
+					With:           map[string]string{"accountId": "123"},
+					WithSelectable: map[string]rule.SelectableArgument{"accountId": {Values: []string{"123"}}},
+				},
+			},
+			wantFields: []apio.FieldError{{Field: "target.withSelectable.accountId", Error: "the argument is already set in target.with"}},
+		},
+		{
+			name: "no values",
+			give: rule.AccessRule{
+				Target: rule.Target{
+					WithSelectable: map[string]rule.SelectableArgument{"accountId": {}},
+				},
+			},
+			wantFields: []apio.FieldError{{Field: "target.withSelectable.accountId", Error: "the argument must have values or allow any option"}},
+		},
+		{
+			name: "additional target",
+			give: rule.AccessRule{
+				AdditionalTargets: []rule.Target{{WithSelectable: map[string]rule.SelectableArgument{"groupId": {AnyOption: true}}}},
+			},
+			wantFields: []apio.FieldError{{Field: "additionalTargets[0].withSelectable", Error: "only the target of the access rule can have selectable arguments"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSelectableArguments(tc.give)
+			if tc.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, &apio.APIError{
+				Err:    errors.New("access rule validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: tc.wantFields,
+			}, err)
+		})
+	}
+}
+
+func TestValidateTargetArgs(t *testing.T) {
+	type testcase struct {
+		name          string
+		give          rule.AccessRule
+		wantArgs      map[string]ahTypes.ValidateArgs
+		mockResponses map[string]*ahTypes.ValidateProviderArgsResponse
+		wantErr       error
+	}
+
+	ok := &ahTypes.ValidateProviderArgsResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}
+	invalid := func(fields ...ahTypes.FieldError) *ahTypes.ValidateProviderArgsResponse {
+		res := &ahTypes.ValidateProviderArgsResponse{HTTPResponse: &http.Response{StatusCode: http.StatusBadRequest}}
+		res.JSON400 = &struct {
+			Error  string                `json:"error"`
+			Fields *[]ahTypes.FieldError `json:"fields,omitempty"`
+		}{Error: "the arguments are not valid for the provider", Fields: &fields}
+		return res
+	}
+
+	testcases := []testcase{
+		{
+			name: "ok",
+			give: rule.AccessRule{
+				Target: rule.Target{
+					ProviderID:     "aws",
+					With:           map[string]string{"permissionSetArn": "arn"},
+					WithSelectable: map[string]rule.SelectableArgument{"accountId": {Values: []string{"123"}}, "region": {AnyOption: true, Values: []string{"us-east-1"}}},
+				},
+			},
+			wantArgs: map[string]ahTypes.ValidateArgs{
+				"aws": {
+					With:       ahTypes.ValidateArgs_With{AdditionalProperties: map[string]string{"permissionSetArn": "arn"}},
+					Selectable: &ahTypes.ValidateArgs_Selectable{AdditionalProperties: map[string][]string{"accountId": {"123"}, "region": {}}},
+				},
+			},
+			mockResponses: map[string]*ahTypes.ValidateProviderArgsResponse{"aws": ok},
+		},
+		{
+			name: "invalid args",
+			give: rule.AccessRule{
+				Target: rule.Target{
+					ProviderID:     "aws",
+					With:           map[string]string{"permissionSetArn": "arn"},
+					WithSelectable: map[string]rule.SelectableArgument{"accountId": {Values: []string{"123"}}},
+				},
+				AdditionalTargets: []rule.Target{
+					{ProviderID: "okta", With: map[string]string{"groupId": "grp"}},
+				},
+			},
+			wantArgs: map[string]ahTypes.ValidateArgs{
+				"aws": {
+					With:       ahTypes.ValidateArgs_With{AdditionalProperties: map[string]string{"permissionSetArn": "arn"}},
+					Selectable: &ahTypes.ValidateArgs_Selectable{AdditionalProperties: map[string][]string{"accountId": {"123"}}},
+				},
+				"okta": {
+					With: ahTypes.ValidateArgs_With{AdditionalProperties: map[string]string{"groupId": "grp"}},
+				},
+			},
+			mockResponses: map[string]*ahTypes.ValidateProviderArgsResponse{
+				"aws": invalid(
+					ahTypes.FieldError{Field: "with.permissionSetArn", Error: "arn isn't one of the provider's options for the argument"},
+					ahTypes.FieldError{Field: "selectable.accountId", Error: "123 isn't one of the provider's options for the argument"},
+				),
+				"okta": invalid(ahTypes.FieldError{Field: "with.groupId", Error: "grp isn't one of the provider's options for the argument"}),
+			},
+			wantErr: &apio.APIError{
+				Err:    errors.New("access rule validation failed"),
+				Status: http.StatusBadRequest,
+				Fields: []apio.FieldError{
+					{Field: "target.with.permissionSetArn", Error: "arn isn't one of the provider's options for the argument"},
+					{Field: "target.withSelectable.accountId", Error: "123 isn't one of the provider's options for the argument"},
+					{Field: "additionalTargets[0].with.groupId", Error: "grp isn't one of the provider's options for the argument"},
+				},
+			},
+		},
+		{
+			name: "provider not found",
+			give: rule.AccessRule{
+				Target: rule.Target{ProviderID: "aws"},
+			},
+			wantArgs:      map[string]ahTypes.ValidateArgs{"aws": {}},
+			mockResponses: map[string]*ahTypes.ValidateProviderArgsResponse{"aws": {HTTPResponse: &http.Response{StatusCode: http.StatusNotFound}}},
+			wantErr:       ErrProviderNotFound,
+		},
+		{
+			name: "bad request without an error body",
+			give: rule.AccessRule{
+				Target: rule.Target{ProviderID: "aws"},
+			},
+			wantArgs:      map[string]ahTypes.ValidateArgs{"aws": {}},
+			mockResponses: map[string]*ahTypes.ValidateProviderArgsResponse{"aws": {HTTPResponse: &http.Response{StatusCode: http.StatusBadRequest}}},
+			wantErr:       ErrUnhandledResponseFromAccessHandler,
+		},
+		{
+			name: "internal server error without an error body",
+			give: rule.AccessRule{
+				Target: rule.Target{ProviderID: "aws"},
+			},
+			wantArgs:      map[string]ahTypes.ValidateArgs{"aws": {}},
+			mockResponses: map[string]*ahTypes.ValidateProviderArgsResponse{"aws": {HTTPResponse: &http.Response{StatusCode: http.StatusInternalServerError}}},
+			wantErr:       ErrUnhandledResponseFromAccessHandler,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+			for id, args := range tc.wantArgs {
+				m.EXPECT().ValidateProviderArgsWithResponse(gomock.Any(), id, args).Return(tc.mockResponses[id], nil)
+			}
+			s := Service{AHClient: m}
+
+			err := s.validateTargetArgs(context.Background(), tc.give)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestTargetsChanged(t *testing.T) {
+	current := rule.AccessRule{
+		Name: "test",
+		Target: rule.Target{
+			ProviderID:     "aws",
+			With:           map[string]string{"permissionSetArn": "arn"},
+			WithSelectable: map[string]rule.SelectableArgument{"accountId": {Values: []string{"123"}}},
+		},
+		AdditionalTargets: []rule.Target{{ProviderID: "okta", With: map[string]string{"groupId": "grp"}}},
+	}
+
+	renamed := current
+	renamed.Name = "renamed"
+	assert.False(t, targetsChanged(current, renamed))
+
+	changedWith := current
+	changedWith.Target.With = map[string]string{"permissionSetArn": "other"}
+	assert.True(t, targetsChanged(current, changedWith))
+
+	changedSelectable := current
+	changedSelectable.Target.WithSelectable = map[string]rule.SelectableArgument{"accountId": {Values: []string{"123", "456"}}}
+	assert.True(t, targetsChanged(current, changedSelectable))
+
+	changedAdditional := current
+	changedAdditional.AdditionalTargets = []rule.Target{{ProviderID: "okta", With: map[string]string{"groupId": "other"}}}
+	assert.True(t, targetsChanged(current, changedAdditional))
+}
@@ -12,32 +12,68 @@ type UpdateOpts struct {
 	Rule           rule.AccessRule
 	UpdateRequest  types.UpdateAccessRuleRequest
 	ApprovalGroups []rule.Approval
+	// UpdateMetadata is optional information about the update, such as the commit it was made from.
+	UpdateMetadata *map[string]interface{}
 }
 
 func (s *Service) UpdateRule(ctx context.Context, in *UpdateOpts) (*rule.AccessRule, error) {
 	clk := s.Clock
-	// makes a copy of the existing version which will be mutated
-	newVersion := in.Rule
-
-	// fields to be updated
-	newVersion.Description = in.UpdateRequest.Description
-	newVersion.Name = in.UpdateRequest.Name
-	newVersion.Approval.Users = in.UpdateRequest.Approval.Users
-	newVersion.Approval.Groups = in.UpdateRequest.Approval.Groups
-	newVersion.Groups = in.UpdateRequest.Groups
+	newVersion := applyUpdate(in.Rule, in.UpdateRequest)
 	newVersion.Metadata.UpdatedBy = in.UpdaterID
 	newVersion.Metadata.UpdatedAt = clk.Now()
-	newVersion.TimeConstraints = in.UpdateRequest.TimeConstraints
+	newVersion.Metadata.UpdateMessage = in.UpdateRequest.UpdateMessage
+	newVersion.Metadata.UpdateMetadata = in.UpdateMetadata
+	newVersion.Metadata.RolledBackTo = nil
 	newVersion.Version = types.NewVersionID()
 
+	err := s.validateRule(ctx, newVersion)
+	if err != nil {
+		return nil, err
+	}
+	// unchanged targets aren't checked again, so that a rule can still be updated if the provider's options have changed since it was created.
+	if targetsChanged(in.Rule, newVersion) {
+		err = s.validateTargetArgs(ctx, newVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Set the existing version to not current
 	in.Rule.Current = false
 
 	// updated the previous version to be a version and inserts the new one as current
-	err := s.DB.PutBatch(ctx, &newVersion, &in.Rule)
+	err = s.DB.PutBatch(ctx, &newVersion, &in.Rule)
 	if err != nil {
 		return nil, err
 	}
 
 	return &newVersion, nil
 }
+
+// applyUpdate returns a copy of the rule with the fields from the update request.
+// The metadata and version of the copy are unchanged.
+func applyUpdate(current rule.AccessRule, update types.UpdateAccessRuleRequest) rule.AccessRule {
+	// makes a copy of the existing version which will be mutated
+	newVersion := current
+
+	// fields to be updated
+	newVersion.Description = update.Description
+	newVersion.Name = update.Name
+	newVersion.Approval.Users = update.Approval.Users
+	newVersion.Approval.Groups = update.Approval.Groups
+	approval := rule.ApprovalFromAPI(update.Approval)
+	newVersion.Approval.RequiredApprovals = approval.RequiredApprovals
+	newVersion.Approval.Stages = approval.Stages
+	newVersion.Approval.Policies = approval.Policies
+	newVersion.Groups = update.Groups
+	// the owners are optional in the update request, so that they aren't removed by clients which don't know about them.
+	if update.Owners != nil {
+		newVersion.Owners = rule.OwnersFromAPI(update.Owners)
+	}
+	newVersion.TimeConstraints = update.TimeConstraints
+	newVersion.BreakGlass = update.BreakGlass != nil && *update.BreakGlass
+	newVersion.Fields = rule.RequestFieldsFromAPI(update.Fields)
+	newVersion.UsageLimits = update.UsageLimits
+	newVersion.AdmissionPolicy = update.AdmissionPolicy
+	return newVersion
+}
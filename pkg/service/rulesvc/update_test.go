@@ -6,8 +6,10 @@ import (
 
 	"github.com/benbjohnson/clock"
 	"github.com/common-fate/ddb/ddbmock"
+	"github.com/common-fate/granted-approvals/accesshandler/pkg/types/ahmocks"
 	"github.com/common-fate/granted-approvals/pkg/rule"
 	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,7 +39,7 @@ func TestUpdateAccessRule(t *testing.T) {
 	*/
 	mockRule := rule.AccessRule{
 		ID:       ruleID,
-		Approval: rule.Approval(in.Approval),
+		Approval: rule.ApprovalFromAPI(in.Approval),
 		Status:   rule.ACTIVE,
 		Metadata: rule.AccessRuleMetadata{
 			CreatedAt: now,
@@ -102,9 +104,16 @@ func TestUpdateAccessRule(t *testing.T) {
 				PutBatchErr: tc.wantErr,
 			}
 
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// the update doesn't change the target, so its arguments aren't validated with the Access Handler again.
+			m := ahmocks.NewMockClientWithResponsesInterface(ctrl)
+
 			s := Service{
-				Clock: clk,
-				DB:    &dbc,
+				Clock:    clk,
+				DB:       &dbc,
+				AHClient: m,
 			}
 
 			got, err := s.UpdateRule(context.Background(), &UpdateOpts{
@@ -0,0 +1,39 @@
+package rulesvc
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+)
+
+// validateUsageLimits checks that the usage quota of a rule can be met by at least one grant.
+func validateUsageLimits(rul rule.AccessRule) error {
+	if rul.UsageLimits == nil || rul.UsageLimits.Quota == nil {
+		return nil
+	}
+	var fields []apio.FieldError
+	quota := rul.UsageLimits.Quota
+	if quota.MaxDurationSeconds > quota.WindowSeconds {
+		fields = append(fields, apio.FieldError{
+			Field: "usageLimits.quota.maxDurationSeconds",
+			Error: "the quota duration can't be longer than the quota window",
+		})
+	}
+	if quota.MaxDurationSeconds < rul.TimeConstraints.MaxDurationSeconds {
+		fields = append(fields, apio.FieldError{
+			Field: "usageLimits.quota.maxDurationSeconds",
+			Error: "the quota duration can't be shorter than the maximum duration of the access rule",
+		})
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("access rule validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
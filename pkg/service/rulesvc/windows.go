@@ -0,0 +1,41 @@
+package rulesvc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/common-fate/apikit/apio"
+	"github.com/common-fate/granted-approvals/pkg/rule"
+)
+
+// validateAllowedWindows checks that the allowed windows of a rule have a valid timezone, days and times.
+func validateAllowedWindows(rul rule.AccessRule) error {
+	if rul.TimeConstraints.AllowedWindows == nil {
+		return nil
+	}
+	var fields []apio.FieldError
+	for i, w := range *rul.TimeConstraints.AllowedWindows {
+		if len(w.Days) == 0 {
+			fields = append(fields, apio.FieldError{
+				Field: fmt.Sprintf("timeConstraints.allowedWindows[%d].days", i),
+				Error: "at least one day is required",
+			})
+		}
+		if err := rule.ValidateAllowedWindow(w); err != nil {
+			fields = append(fields, apio.FieldError{
+				Field: fmt.Sprintf("timeConstraints.allowedWindows[%d]", i),
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return &apio.APIError{
+			Err:    errors.New("access rule validation failed"),
+			Status: http.StatusBadRequest,
+			Fields: fields,
+		}
+	}
+	return nil
+}
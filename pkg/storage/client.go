@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/cfaws"
+)
+
+// ErrVersionConflict is returned by a versioned write if the item has been changed since it was read.
+var ErrVersionConflict = errors.New("the item has been changed since it was read")
+
+// VersionedWriter is implemented by storage which supports conditional writes.
+type VersionedWriter interface {
+	// PutVersioned saves item and the other items atomically, only if the version attribute of item in the table is still version.
+	// ErrVersionConflict is returned otherwise, and none of the items are saved.
+	PutVersioned(ctx context.Context, item ddb.Keyer, version int, items ...ddb.Keyer) error
+}
+
+var _ ddb.Storage = &Client{}
+var _ VersionedWriter = &Client{}
+
+// Client is a ddb client which also supports versioned writes.
+// The ddb package doesn't support condition expressions, so these are made using the DynamoDB client directly.
+type Client struct {
+	*ddb.Client
+	dynamo *dynamodb.Client
+	table  string
+}
+
+// New creates a new storage client for the table.
+// The AWS config is taken from the context if it has been set, so that the CLI uses the deployment's credentials.
+func New(ctx context.Context, table string) (*Client, error) {
+	cfg, err := cfaws.ConfigFromContextOrDefault(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dc := dynamodb.NewFromConfig(cfg)
+	db, err := ddb.New(ctx, table, ddb.WithDynamoDBClient(dc))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{Client: db, dynamo: dc, table: table}, nil
+}
+
+// maxTransactionItems is the maximum number of items which DynamoDB can write in a single transaction.
+const maxTransactionItems = 100
+
+// PutVersioned saves item and the other items in a single transaction, which is conditional on the version of item.
+// If the condition fails, none of the items are saved.
+// Items which were saved before versioning was added don't have a version attribute, and are treated as version 0.
+func (c *Client) PutVersioned(ctx context.Context, item ddb.Keyer, version int, items ...ddb.Keyer) error {
+	in, err := c.versionedTransaction(item, version, items)
+	if err != nil {
+		return err
+	}
+	_, err = c.dynamo.TransactWriteItems(ctx, in)
+	var tce *types.TransactionCanceledException
+	if errors.As(err, &tce) && len(tce.CancellationReasons) > 0 && aws.ToString(tce.CancellationReasons[0].Code) == "ConditionalCheckFailed" {
+		return ErrVersionConflict
+	}
+	return err
+}
+
+// versionedTransaction builds a transaction which saves the items, and only succeeds if the version of item in the table is version.
+// The condition is on the first write of the transaction, so a conflict is reported by its first cancellation reason.
+func (c *Client) versionedTransaction(item ddb.Keyer, version int, items []ddb.Keyer) (*dynamodb.TransactWriteItemsInput, error) {
+	if len(items)+1 > maxTransactionItems {
+		return nil, fmt.Errorf("can't save %d items in one transaction, the maximum is %d", len(items)+1, maxTransactionItems)
+	}
+	attrs, err := marshalItem(item)
+	if err != nil {
+		return nil, err
+	}
+	writes := []types.TransactWriteItem{
+		{
+			Put: &types.Put{
+				TableName:           &c.table,
+				Item:                attrs,
+				ConditionExpression: aws.String("attribute_not_exists(version) OR version = :version"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":version": &types.AttributeValueMemberN{Value: strconv.Itoa(version)},
+				},
+			},
+		},
+	}
+	for _, it := range items {
+		attrs, err := marshalItem(it)
+		if err != nil {
+			return nil, err
+		}
+		writes = append(writes, types.TransactWriteItem{Put: &types.Put{TableName: &c.table, Item: attrs}})
+	}
+	return &dynamodb.TransactWriteItemsInput{TransactItems: writes}, nil
+}
+
+// marshalItem turns an item into its DynamoDB representation, including its keys.
+// It matches how the ddb package marshals items.
+func marshalItem(item ddb.Keyer) (map[string]types.AttributeValue, error) {
+	keys, err := item.DDBKeys()
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(keys)
+	for i := 0; i < v.NumField(); i++ {
+		// empty keys aren't written, so that the item isn't added to indexes it doesn't use.
+		if val := v.Field(i).String(); val != "" {
+			attrs[v.Type().Field(i).Name] = &types.AttributeValueMemberS{Value: val}
+		}
+	}
+	return attrs, nil
+}
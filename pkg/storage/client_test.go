@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPutVersioned(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingClient(t)
+
+	// a request which hasn't been saved yet has no version attribute.
+	req := access.Request{ID: types.NewRequestID(), Status: access.PENDING, Version: 1}
+	err := s.PutVersioned(ctx, &req, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the write fails if the request has changed since it was read, and the other items aren't saved.
+	stale := req
+	stale.Status = access.CANCELLED
+	stale.Version = 2
+	reviewer := access.Reviewer{ReviewerID: types.NewUserID(), Request: stale}
+	err = s.PutVersioned(ctx, &stale, 0, &reviewer)
+	assert.Equal(t, ErrVersionConflict, err)
+
+	rq := ListRequestReviewers{RequestID: req.ID}
+	_, err = s.Query(ctx, &rq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, rq.Result)
+
+	// the write succeeds with the current version, and the other items are saved.
+	err = s.PutVersioned(ctx, &stale, 1, &reviewer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gq := GetRequest{ID: req.ID}
+	_, err = s.Query(ctx, &gq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, access.CANCELLED, gq.Result.Status)
+	assert.Equal(t, 2, gq.Result.Version)
+
+	rq = ListRequestReviewers{RequestID: req.ID}
+	_, err = s.Query(ctx, &rq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []access.Reviewer{reviewer}, rq.Result)
+}
+
+func TestVersionedTransaction(t *testing.T) {
+	c := Client{table: "test"}
+	req := access.Request{ID: "req_1", Version: 2}
+	reviewer := access.Reviewer{ReviewerID: "usr_1", Request: req}
+
+	in, err := c.versionedTransaction(&req, 1, []ddb.Keyer{&access.RequestIndex{Request: req}, &reviewer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, in.TransactItems, 3)
+
+	// only the write of the versioned item is conditional.
+	put := in.TransactItems[0].Put
+	assert.Equal(t, "attribute_not_exists(version) OR version = :version", aws.ToString(put.ConditionExpression))
+	assert.Equal(t, &dynamodbtypes.AttributeValueMemberN{Value: "1"}, put.ExpressionAttributeValues[":version"])
+	assert.Equal(t, &dynamodbtypes.AttributeValueMemberN{Value: "2"}, put.Item["version"])
+	for _, w := range in.TransactItems[1:] {
+		assert.Nil(t, w.Put.ConditionExpression)
+	}
+
+	// the transaction can't be larger than DynamoDB allows.
+	items := make([]ddb.Keyer, maxTransactionItems)
+	for i := range items {
+		items[i] = &reviewer
+	}
+	_, err = c.versionedTransaction(&req, 1, items)
+	assert.Error(t, err)
+}
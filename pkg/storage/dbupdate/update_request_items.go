@@ -2,6 +2,7 @@ package dbupdate
 
 import (
 	"context"
+	"errors"
 
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/granted-approvals/pkg/access"
@@ -36,12 +37,46 @@ func GetUpdateRequestItems(ctx context.Context, db ddb.Storage, r access.Request
 		o.Reviewers = rq.Result
 	}
 
-	items := make([]ddb.Keyer, len(o.Reviewers)+1)
+	items := make([]ddb.Keyer, len(o.Reviewers)+2)
 	items[0] = &r
+	items[1] = &access.RequestIndex{Request: r}
 	for i, rv := range o.Reviewers {
 		rvc := rv
 		rvc.Request = r
-		items[1+i] = &rvc
+		items[2+i] = &rvc
 	}
 	return items, nil
 }
+
+// ErrRequestConflict is returned if a request was changed by someone else while it was being updated.
+var ErrRequestConflict = errors.New("the request was changed by someone else while you were updating it, reload it and try again")
+
+// UpdateRequest saves an updated request along with its reviewers, and any other items such as audit log events.
+// The request is only saved if it hasn't changed since it was read, otherwise ErrRequestConflict is returned and nothing is saved.
+// The version of r is incremented.
+func UpdateRequest(ctx context.Context, db ddb.Storage, r *access.Request, items []ddb.Keyer, opts ...func(*UpdateRequestOpts)) error {
+	readVersion := r.Version
+	r.Version++
+	reqItems, err := GetUpdateRequestItems(ctx, db, *r, opts...)
+	if err != nil {
+		return err
+	}
+
+	vw, ok := db.(storage.VersionedWriter)
+	if !ok {
+		// storage which doesn't support conditional writes, such as the mock used in tests, saves the items without checking the version.
+		return db.PutBatch(ctx, append(reqItems, items...)...)
+	}
+	err = vw.PutVersioned(ctx, reqItems[0], readVersion, append(reqItems[1:], items...)...)
+	if err == storage.ErrVersionConflict {
+		return ErrRequestConflict
+	}
+	return err
+}
+
+// ClaimRequest saves the request as it was read with an incremented version, without changing it otherwise.
+// This is used before actions which can't be undone, such as granting access, so that only one of any
+// concurrent updates to the request goes ahead. The others fail with ErrRequestConflict.
+func ClaimRequest(ctx context.Context, db ddb.Storage, r *access.Request, opts ...func(*UpdateRequestOpts)) error {
+	return UpdateRequest(ctx, db, r, nil, opts...)
+}
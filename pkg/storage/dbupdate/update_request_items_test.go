@@ -3,6 +3,7 @@ package dbupdate
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/common-fate/ddb"
 	"github.com/common-fate/ddb/ddbmock"
@@ -29,13 +30,13 @@ func TestGetUpdateRequestItems(t *testing.T) {
 			name:          "ok",
 			give:          requestUpdated,
 			withReviewers: reviewers,
-			want:          []ddb.Keyer{&requestUpdated, &reviewersUpdated[0], &reviewersUpdated[1]},
+			want:          []ddb.Keyer{&requestUpdated, &access.RequestIndex{Request: requestUpdated}, &reviewersUpdated[0], &reviewersUpdated[1]},
 		},
 		{
 			name:     "supply reviewers",
 			give:     requestUpdated,
 			giveOpts: []func(*UpdateRequestOpts){WithReviewers(reviewers)},
-			want:     []ddb.Keyer{&requestUpdated, &reviewersUpdated[0], &reviewersUpdated[1]},
+			want:     []ddb.Keyer{&requestUpdated, &access.RequestIndex{Request: requestUpdated}, &reviewersUpdated[0], &reviewersUpdated[1]},
 		},
 	}
 
@@ -55,3 +56,59 @@ func TestGetUpdateRequestItems(t *testing.T) {
 		})
 	}
 }
+
+// versionedDB is a mock database which supports versioned writes.
+type versionedDB struct {
+	*ddbmock.Client
+	err        error
+	gotItem    ddb.Keyer
+	gotVersion int
+	gotOthers  []ddb.Keyer
+}
+
+func (db *versionedDB) PutVersioned(ctx context.Context, item ddb.Keyer, version int, items ...ddb.Keyer) error {
+	db.gotItem = item
+	db.gotVersion = version
+	db.gotOthers = items
+	return db.err
+}
+
+func TestUpdateRequest(t *testing.T) {
+	type testcase struct {
+		name        string
+		withErr     error
+		wantVersion int
+		wantErr     error
+	}
+	reviewers := []access.Reviewer{{ReviewerID: "1"}}
+	event := access.NewStatusChangeEvent("abcd", time.Time{}, nil, access.PENDING, access.APPROVED)
+
+	testcases := []testcase{
+		{
+			name:        "ok",
+			wantVersion: 2,
+		},
+		{
+			name:        "request changed since it was read",
+			withErr:     storage.ErrVersionConflict,
+			wantVersion: 2,
+			wantErr:     ErrRequestConflict,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := &versionedDB{Client: ddbmock.New(t), err: tc.withErr}
+			r := access.Request{ID: "abcd", Status: access.APPROVED, Version: 1}
+			err := UpdateRequest(context.Background(), db, &r, []ddb.Keyer{&event}, WithReviewers(reviewers))
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantVersion, r.Version)
+
+			// the write is conditional on the version which was read.
+			assert.Equal(t, 1, db.gotVersion)
+			assert.Equal(t, &r, db.gotItem)
+			reviewer := access.Reviewer{ReviewerID: "1", Request: r}
+			assert.Equal(t, []ddb.Keyer{&access.RequestIndex{Request: r}, &reviewer, &event}, db.gotOthers)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestGetBlackout(t *testing.T) {
+	db := newTestingStorage(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	b := access.Blackout{
+		ID:        types.NewBlackoutID(),
+		Start:     now,
+		End:       now.Add(time.Hour * 24),
+		Reason:    "end of year change freeze",
+		CreatedBy: types.NewUserID(),
+		CreatedAt: now,
+	}
+	ddbtest.PutFixtures(t, db, &b)
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &GetBlackout{ID: b.ID},
+			Want:  &GetBlackout{ID: b.ID, Result: &b},
+		},
+		{
+			Name:    "blackout not found",
+			Query:   &GetBlackout{ID: types.NewBlackoutID()},
+			WantErr: ddb.ErrNoItems,
+		},
+	}
+
+	ddbtest.RunQueryTests(t, db, tc)
+}
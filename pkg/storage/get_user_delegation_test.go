@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/identity"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestGetUserDelegation(t *testing.T) {
+	db := newTestingStorage(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	d := identity.Delegation{
+		UserID:     types.NewUserID(),
+		DelegateID: types.NewUserID(),
+		Start:      now,
+		End:        now.Add(time.Hour * 24),
+	}
+	ddbtest.PutFixtures(t, db, &d)
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &GetUserDelegation{UserID: d.UserID},
+			Want:  &GetUserDelegation{UserID: d.UserID, Result: &d},
+		},
+		{
+			Name:    "delegation not found",
+			Query:   &GetUserDelegation{UserID: types.NewUserID()},
+			WantErr: ddb.ErrNoItems,
+		},
+	}
+
+	ddbtest.RunQueryTests(t, db, tc)
+}
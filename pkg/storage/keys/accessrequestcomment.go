@@ -0,0 +1,24 @@
+package keys
+
+import (
+	"time"
+
+	"github.com/common-fate/iso8601"
+)
+
+const AccessRequestCommentKey = "ACCESS_REQUEST_COMMENT#"
+
+type accessRequestCommentKeys struct {
+	PK1        string
+	SK1        func(requestID string, createdAt time.Time, commentID string) string
+	SK1Request func(requestID string) string
+}
+
+var AccessRequestComment = accessRequestCommentKeys{
+	PK1: AccessRequestCommentKey,
+	// utc iso8601 formatted time string, so that comments on a request are sorted oldest first
+	SK1: func(requestID string, createdAt time.Time, commentID string) string {
+		return requestID + "#" + iso8601.New(createdAt).String() + "#" + commentID
+	},
+	SK1Request: func(requestID string) string { return requestID + "#" },
+}
@@ -0,0 +1,26 @@
+package keys
+
+import (
+	"time"
+
+	"github.com/common-fate/iso8601"
+)
+
+const AccessRequestExpiryKey = "ACCESS_REQUEST_EXPIRY#"
+
+type accessRequestExpiryKeys struct {
+	PK1 string
+	SK1 func(expiresAt time.Time, requestID string) string
+	// SK1Before is compared against SK1 to find expiries at or before a time.
+	SK1Before func(t time.Time) string
+}
+
+var AccessRequestExpiry = accessRequestExpiryKeys{
+	PK1: AccessRequestExpiryKey,
+	// utc iso8601 formatted time string, so that expiries are sorted by time
+	SK1: func(expiresAt time.Time, requestID string) string {
+		return iso8601.New(expiresAt).String() + "#" + requestID
+	},
+	// '~' sorts after any character in a request ID
+	SK1Before: func(t time.Time) string { return iso8601.New(t).String() + "#~" },
+}
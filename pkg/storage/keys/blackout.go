@@ -0,0 +1,13 @@
+package keys
+
+const BlackoutKey = "BLACKOUT#"
+
+type blackoutKeys struct {
+	PK1 string
+	SK1 func(blackoutID string) string
+}
+
+var Blackout = blackoutKeys{
+	PK1: BlackoutKey,
+	SK1: func(blackoutID string) string { return blackoutID },
+}
@@ -0,0 +1,39 @@
+package keys
+
+import (
+	"time"
+
+	"github.com/common-fate/iso8601"
+)
+
+const RequestIndexKey = "REQUEST_INDEX#"
+
+type requestIndexKeys struct {
+	PK1          string
+	SK1          func(requestID string) string
+	GSI1PK       func(ruleID string) string
+	GSI1SK       func(requestID string) string
+	GSI2PK       func(ruleID string) string
+	GSI2SK       func(status string, requestID string) string
+	GSI2SKStatus func(status string) string
+	GSI3PK       func(status string) string
+	GSI3SK       func(requestEnd time.Time) string
+	GSI4PK       func(ruleID string, status string) string
+	GSI4SK       func(requestEnd time.Time) string
+}
+
+var RequestIndex = requestIndexKeys{
+	PK1:          RequestIndexKey,
+	SK1:          func(requestID string) string { return requestID },
+	GSI1PK:       func(ruleID string) string { return RequestIndexKey + ruleID },
+	GSI1SK:       func(requestID string) string { return requestID },
+	GSI2PK:       func(ruleID string) string { return RequestIndexKey + ruleID },
+	GSI2SK:       func(status string, requestID string) string { return status + "#" + requestID },
+	GSI2SKStatus: func(status string) string { return status + "#" },
+	GSI3PK:       func(status string) string { return RequestIndexKey + status },
+	// utc iso8601 formatted time string
+	GSI3SK: func(requestEnd time.Time) string { return iso8601.New(requestEnd).String() },
+	GSI4PK: func(ruleID string, status string) string { return RequestIndexKey + ruleID + "#" + status },
+	// utc iso8601 formatted time string
+	GSI4SK: func(requestEnd time.Time) string { return iso8601.New(requestEnd).String() },
+}
@@ -0,0 +1,13 @@
+package keys
+
+const UserDelegationKey = "USER_DELEGATION#"
+
+type userDelegationKeys struct {
+	PK1 string
+	SK1 func(userID string) string
+}
+
+var UserDelegation = userDelegationKeys{
+	PK1: UserDelegationKey,
+	SK1: func(userID string) string { return userID },
+}
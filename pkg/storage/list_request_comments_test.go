@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestListRequestComments(t *testing.T) {
+	s := newTestingStorage(t)
+
+	reqID := types.NewRequestID()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	c1 := access.Comment{ID: types.NewCommentID(), RequestID: reqID, UserID: types.NewUserID(), Body: "which ticket is this for?", CreatedAt: now}
+	c2 := access.Comment{ID: types.NewCommentID(), RequestID: reqID, UserID: types.NewUserID(), Body: "INC-123", CreatedAt: now.Add(time.Second)}
+	ddbtest.PutFixtures(t, s, []*access.Comment{&c1, &c2})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListRequestComments{RequestID: reqID},
+			Want:  &ListRequestComments{RequestID: reqID, Result: []access.Comment{c1, c2}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// ListRequestExpiriesBefore lists the expiries of pending requests which expire at or before a time.
+type ListRequestExpiriesBefore struct {
+	Before time.Time
+	Result []access.RequestExpiry `ddb:"result"`
+}
+
+func (l *ListRequestExpiriesBefore) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		// oldest to newest
+		ScanIndexForward:       aws.Bool(true),
+		KeyConditionExpression: aws.String("PK = :pk1 AND SK <= :sk1"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk1": &types.AttributeValueMemberS{Value: keys.AccessRequestExpiry.PK1},
+			":sk1": &types.AttributeValueMemberS{Value: keys.AccessRequestExpiry.SK1Before(l.Before)},
+		},
+	}
+	return &qi, nil
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRequestExpiriesBefore(t *testing.T) {
+	s := newTestingStorage(t)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Second)
+	expired := access.RequestExpiry{RequestID: types.NewRequestID(), ExpiresAt: now.Add(-time.Minute)}
+	expiresNow := access.RequestExpiry{RequestID: types.NewRequestID(), ExpiresAt: now}
+	notExpired := access.RequestExpiry{RequestID: types.NewRequestID(), ExpiresAt: now.Add(time.Minute)}
+	ddbtest.PutFixtures(t, s, []*access.RequestExpiry{&expired, &expiresNow, &notExpired})
+
+	// the expiries partition is shared with other tests, so we only check for the fixtures created here.
+	q := ListRequestExpiriesBefore{Before: now}
+	_, err := s.Query(ctx, &q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range q.Result {
+		got = append(got, e.RequestID)
+	}
+	assert.Contains(t, got, expired.RequestID)
+	assert.Contains(t, got, expiresNow.RequestID)
+	assert.NotContains(t, got, notExpired.RequestID)
+}
@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// ListRequestsForRule lists the requests for an access rule, newest first.
+type ListRequestsForRule struct {
+	RuleID string
+	Result []access.Request `ddb:"result"`
+}
+
+func (l *ListRequestsForRule) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		// newest to oldest
+		ScanIndexForward:       aws.Bool(false),
+		IndexName:              aws.String(keys.IndexNames.GSI1),
+		KeyConditionExpression: aws.String("GSI1PK = :pk"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.RequestIndex.GSI1PK(l.RuleID)},
+		},
+	}
+	return &qi, nil
+}
+
+func (l *ListRequestsForRule) UnmarshalQueryOutput(out *dynamodb.QueryOutput) error {
+	var items []access.RequestIndex
+	err := attributevalue.UnmarshalListOfMaps(out.Items, &items)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		l.Result = append(l.Result, item.Request)
+	}
+	return nil
+}
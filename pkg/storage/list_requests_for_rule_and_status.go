@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// ListRequestsForRuleAndStatus lists the requests for an access rule which have the status, newest first.
+type ListRequestsForRuleAndStatus struct {
+	RuleID string
+	Status access.Status
+	Result []access.Request `ddb:"result"`
+}
+
+func (l *ListRequestsForRuleAndStatus) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		// newest to oldest
+		ScanIndexForward:       aws.Bool(false),
+		IndexName:              aws.String(keys.IndexNames.GSI2),
+		KeyConditionExpression: aws.String("GSI2PK = :pk and begins_with(GSI2SK, :sk)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.RequestIndex.GSI2PK(l.RuleID)},
+			":sk": &types.AttributeValueMemberS{Value: keys.RequestIndex.GSI2SKStatus(string(l.Status))},
+		},
+	}
+	return &qi, nil
+}
+
+func (l *ListRequestsForRuleAndStatus) UnmarshalQueryOutput(out *dynamodb.QueryOutput) error {
+	var items []access.RequestIndex
+	err := attributevalue.UnmarshalListOfMaps(out.Items, &items)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		l.Result = append(l.Result, item.Request)
+	}
+	return nil
+}
+
+// ListAllRequestsForRuleAndStatus returns every request for an access rule with the status, reading all of the pages of results.
+func ListAllRequestsForRuleAndStatus(ctx context.Context, db ddb.Storage, ruleID string, status access.Status) ([]access.Request, error) {
+	var requests []access.Request
+	hasMore := true
+	var next string
+	for hasMore {
+		q := ListRequestsForRuleAndStatus{RuleID: ruleID, Status: status}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := db.Query(ctx, &q, opts...)
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+		next = ""
+		if res != nil {
+			next = res.NextPage
+		}
+		hasMore = next != ""
+		requests = append(requests, q.Result...)
+	}
+	return requests, nil
+}
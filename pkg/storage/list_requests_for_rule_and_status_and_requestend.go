@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// ListRequestsForRuleAndStatusAndRequestend lists the requests for an access rule which have the status, by their end time, latest first.
+// See the access.Request.DDBKeys for a comment explaining what the endtime represents for requests
+type ListRequestsForRuleAndStatusAndRequestend struct {
+	RuleID               string
+	Status               access.Status
+	RequestEndComparator RequestEndComparator
+	CompareTo            time.Time
+	Result               []access.Request `ddb:"result"`
+}
+
+func (l *ListRequestsForRuleAndStatusAndRequestend) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              &keys.IndexNames.GSI4,
+		ScanIndexForward:       aws.Bool(false),
+		KeyConditionExpression: aws.String(fmt.Sprintf("GSI4PK = :pk and GSI4SK %s :sk", l.RequestEndComparator)),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.RequestIndex.GSI4PK(l.RuleID, string(l.Status))},
+			":sk": &types.AttributeValueMemberS{Value: keys.RequestIndex.GSI4SK(l.CompareTo)},
+		},
+	}
+	return &qi, nil
+}
+
+func (l *ListRequestsForRuleAndStatusAndRequestend) UnmarshalQueryOutput(out *dynamodb.QueryOutput) error {
+	var items []access.RequestIndex
+	err := attributevalue.UnmarshalListOfMaps(out.Items, &items)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		l.Result = append(l.Result, item.Request)
+	}
+	return nil
+}
+
+// ListAllRequestsForRuleAndStatusAndRequestend returns every request for an access rule with the status whose end time compares to the time,
+// reading all of the pages of results.
+func ListAllRequestsForRuleAndStatusAndRequestend(ctx context.Context, db ddb.Storage, ruleID string, status access.Status, comparator RequestEndComparator, compareTo time.Time) ([]access.Request, error) {
+	var requests []access.Request
+	hasMore := true
+	var next string
+	for hasMore {
+		q := ListRequestsForRuleAndStatusAndRequestend{RuleID: ruleID, Status: status, RequestEndComparator: comparator, CompareTo: compareTo}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := db.Query(ctx, &q, opts...)
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+		next = ""
+		if res != nil {
+			next = res.NextPage
+		}
+		hasMore = next != ""
+		requests = append(requests, q.Result...)
+	}
+	return requests, nil
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/common-fate/ddb/ddbtest"
+	ac_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestListRequestsForRuleAndStatusAndRequestend(t *testing.T) {
+	s := newTestingStorage(t)
+
+	rule := types.NewAccessRuleID()
+	now := time.Now().UTC().Truncate(time.Second)
+	ended := access.Request{ID: types.NewRequestID(), Rule: rule, Status: access.APPROVED, Grant: &access.Grant{Status: ac_types.EXPIRED, End: now.Add(-time.Hour)}}
+	active := access.Request{ID: types.NewRequestID(), Rule: rule, Status: access.APPROVED, Grant: &access.Grant{Status: ac_types.ACTIVE, End: now.Add(time.Hour)}}
+	otherRule := access.Request{ID: types.NewRequestID(), Rule: types.NewAccessRuleID(), Status: access.APPROVED, Grant: &access.Grant{Status: ac_types.ACTIVE, End: now.Add(time.Hour)}}
+	ddbtest.PutFixtures(t, s, []*access.RequestIndex{{Request: ended}, {Request: active}, {Request: otherRule}})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListRequestsForRuleAndStatusAndRequestend{RuleID: rule, Status: access.APPROVED, RequestEndComparator: GreaterThan, CompareTo: now},
+			Want:  &ListRequestsForRuleAndStatusAndRequestend{RuleID: rule, Status: access.APPROVED, RequestEndComparator: GreaterThan, CompareTo: now, Result: []access.Request{active}},
+		},
+		{
+			Name:  "no requests with the status",
+			Query: &ListRequestsForRuleAndStatusAndRequestend{RuleID: rule, Status: access.PENDING, RequestEndComparator: GreaterThan, CompareTo: now},
+			Want:  &ListRequestsForRuleAndStatusAndRequestend{RuleID: rule, Status: access.PENDING, RequestEndComparator: GreaterThan, CompareTo: now},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
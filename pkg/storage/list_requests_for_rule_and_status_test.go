@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestListRequestsForRuleAndStatus(t *testing.T) {
+	s := newTestingStorage(t)
+
+	rule := types.NewAccessRuleID()
+	approved := access.Request{ID: types.NewRequestID(), Rule: rule, Status: access.APPROVED}
+	pending := access.Request{ID: types.NewRequestID(), Rule: rule, Status: access.PENDING}
+	otherRule := access.Request{ID: types.NewRequestID(), Rule: types.NewAccessRuleID(), Status: access.APPROVED}
+	ddbtest.PutFixtures(t, s, []*access.RequestIndex{{Request: approved}, {Request: pending}, {Request: otherRule}})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "ok",
+			Query: &ListRequestsForRuleAndStatus{RuleID: rule, Status: access.APPROVED},
+			Want:  &ListRequestsForRuleAndStatus{RuleID: rule, Status: access.APPROVED, Result: []access.Request{approved}},
+		},
+		{
+			Name:  "no requests with the status",
+			Query: &ListRequestsForRuleAndStatus{RuleID: rule, Status: access.DECLINED},
+			Want:  &ListRequestsForRuleAndStatus{RuleID: rule, Status: access.DECLINED},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/common-fate/ddb/ddbtest"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+)
+
+func TestListRequestsForRule(t *testing.T) {
+	s := newTestingStorage(t)
+
+	rule := types.NewAccessRuleID()
+	older := access.Request{ID: types.NewRequestID(), Rule: rule, Status: access.APPROVED}
+	newer := access.Request{ID: types.NewRequestID(), Rule: rule, Status: access.PENDING}
+	otherRule := access.Request{ID: types.NewRequestID(), Rule: types.NewAccessRuleID(), Status: access.PENDING}
+	ddbtest.PutFixtures(t, s, []*access.RequestIndex{{Request: older}, {Request: newer}, {Request: otherRule}})
+
+	tc := []ddbtest.QueryTestCase{
+		{
+			Name:  "newest first",
+			Query: &ListRequestsForRule{RuleID: rule},
+			Want:  &ListRequestsForRule{RuleID: rule, Result: []access.Request{newer, older}},
+		},
+	}
+
+	ddbtest.RunQueryTests(t, s, tc)
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/common-fate/ddb"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/storage/keys"
+)
+
+// ListRequestsForStatusAndRequestend lists the requests with the status by their end time, latest first.
+// See the access.Request.DDBKeys for a comment explaining what the endtime represents for requests
+type ListRequestsForStatusAndRequestend struct {
+	Status               access.Status
+	RequestEndComparator RequestEndComparator
+	CompareTo            time.Time
+	Result               []access.Request `ddb:"result"`
+}
+
+func (l *ListRequestsForStatusAndRequestend) BuildQuery() (*dynamodb.QueryInput, error) {
+	qi := dynamodb.QueryInput{
+		IndexName:              &keys.IndexNames.GSI3,
+		ScanIndexForward:       aws.Bool(false),
+		KeyConditionExpression: aws.String(fmt.Sprintf("GSI3PK = :pk and GSI3SK %s :sk", l.RequestEndComparator)),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pk": &types.AttributeValueMemberS{Value: keys.RequestIndex.GSI3PK(string(l.Status))},
+			":sk": &types.AttributeValueMemberS{Value: keys.RequestIndex.GSI3SK(l.CompareTo)},
+		},
+	}
+	return &qi, nil
+}
+
+func (l *ListRequestsForStatusAndRequestend) UnmarshalQueryOutput(out *dynamodb.QueryOutput) error {
+	var items []access.RequestIndex
+	err := attributevalue.UnmarshalListOfMaps(out.Items, &items)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		l.Result = append(l.Result, item.Request)
+	}
+	return nil
+}
+
+// ListAllRequestsForStatusAndRequestend returns every request with the status whose end time compares to the time, reading all of the pages of results.
+func ListAllRequestsForStatusAndRequestend(ctx context.Context, db ddb.Storage, status access.Status, comparator RequestEndComparator, compareTo time.Time) ([]access.Request, error) {
+	var requests []access.Request
+	hasMore := true
+	var next string
+	for hasMore {
+		q := ListRequestsForStatusAndRequestend{Status: status, RequestEndComparator: comparator, CompareTo: compareTo}
+		var opts []func(*ddb.QueryOpts)
+		if next != "" {
+			opts = append(opts, ddb.Page(next))
+		}
+		res, err := db.Query(ctx, &q, opts...)
+		if err != nil && err != ddb.ErrNoItems {
+			return nil, err
+		}
+		next = ""
+		if res != nil {
+			next = res.NextPage
+		}
+		hasMore = next != ""
+		requests = append(requests, q.Result...)
+	}
+	return requests, nil
+}
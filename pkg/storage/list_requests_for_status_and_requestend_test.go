@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ac_types "github.com/common-fate/granted-approvals/accesshandler/pkg/types"
+	"github.com/common-fate/granted-approvals/pkg/access"
+	"github.com/common-fate/granted-approvals/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAllRequestsForStatusAndRequestend(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingStorage(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	ended := access.Request{ID: types.NewRequestID(), Status: access.APPROVED, Grant: &access.Grant{Status: ac_types.EXPIRED, End: now.Add(-time.Hour)}}
+	active := access.Request{ID: types.NewRequestID(), Status: access.APPROVED, Grant: &access.Grant{Status: ac_types.ACTIVE, End: now.Add(time.Hour)}}
+	cancelled := access.Request{ID: types.NewRequestID(), Status: access.CANCELLED, CreatedAt: now}
+	err := s.PutBatch(ctx, &access.RequestIndex{Request: ended}, &access.RequestIndex{Request: active}, &access.RequestIndex{Request: cancelled})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ListAllRequestsForStatusAndRequestend(ctx, s, access.APPROVED, GreaterThanEqual, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, got, active)
+	assert.NotContains(t, got, ended)
+	assert.NotContains(t, got, cancelled)
+}
@@ -12,13 +12,19 @@ import (
 // newTestingStorage creates a new testing storage.
 // It skips the tests if the TESTING_DYNAMODB_TABLE env var isn't set.
 func newTestingStorage(t *testing.T) *ddb.Client {
+	return newTestingClient(t).Client
+}
+
+// newTestingClient creates a new testing storage client which supports versioned writes.
+// It skips the tests if the TESTING_DYNAMODB_TABLE env var isn't set.
+func newTestingClient(t *testing.T) *Client {
 	ctx := context.Background()
 	_ = godotenv.Load("../../.env")
 	table := os.Getenv("TESTING_DYNAMODB_TABLE")
 	if table == "" {
 		t.Skip("TESTING_DYNAMODB_TABLE is not set")
 	}
-	s, err := ddb.New(ctx, table)
+	s, err := New(ctx, table)
 	if err != nil {
 		t.Fatal(err)
 	}
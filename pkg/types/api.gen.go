@@ -30,8 +30,35 @@ const (
 
 // Defines values for ApprovalMethod.
 const (
-	AUTOMATIC ApprovalMethod = "AUTOMATIC"
-	REVIEWED  ApprovalMethod = "REVIEWED"
+	AUTOMATIC  ApprovalMethod = "AUTOMATIC"
+	BREAKGLASS ApprovalMethod = "BREAK_GLASS"
+	REVIEWED   ApprovalMethod = "REVIEWED"
+)
+
+// Defines values for ApprovalPolicyAction.
+const (
+	AUTOAPPROVE   ApprovalPolicyAction = "AUTO_APPROVE"
+	REQUIREREVIEW ApprovalPolicyAction = "REQUIRE_REVIEW"
+)
+
+// Defines values for ArchiveOutcomeAction.
+const (
+	CANCELREQUEST ArchiveOutcomeAction = "CANCEL_REQUEST"
+	REVOKEGRANT   ArchiveOutcomeAction = "REVOKE_GRANT"
+)
+
+// Defines values for ArchiveOutcomeResult.
+const (
+	FAILED    ArchiveOutcomeResult = "FAILED"
+	SKIPPED   ArchiveOutcomeResult = "SKIPPED"
+	SUCCEEDED ArchiveOutcomeResult = "SUCCEEDED"
+)
+
+// Defines values for ExtensionStatus.
+const (
+	ExtensionStatusAPPROVED ExtensionStatus = "APPROVED"
+	ExtensionStatusDECLINED ExtensionStatus = "DECLINED"
+	ExtensionStatusPENDING  ExtensionStatus = "PENDING"
 )
 
 // Defines values for GrantStatus.
@@ -58,6 +85,12 @@ const (
 	RequestEventFromGrantStatusREVOKED RequestEventFromGrantStatus = "REVOKED"
 )
 
+// Defines values for RequestEventGrantDrift.
+const (
+	MISSING    RequestEventGrantDrift = "MISSING"
+	NOTREMOVED RequestEventGrantDrift = "NOT_REMOVED"
+)
+
 // Defines values for RequestEventToGrantStatus.
 const (
 	RequestEventToGrantStatusACTIVE  RequestEventToGrantStatus = "ACTIVE"
@@ -69,24 +102,48 @@ const (
 
 // Defines values for RequestStatus.
 const (
-	RequestStatusAPPROVED  RequestStatus = "APPROVED"
-	RequestStatusCANCELLED RequestStatus = "CANCELLED"
-	RequestStatusDECLINED  RequestStatus = "DECLINED"
-	RequestStatusPENDING   RequestStatus = "PENDING"
+	RequestStatusAPPROVED                 RequestStatus = "APPROVED"
+	RequestStatusCANCELLED                RequestStatus = "CANCELLED"
+	RequestStatusDECLINED                 RequestStatus = "DECLINED"
+	RequestStatusEXPIRED                  RequestStatus = "EXPIRED"
+	RequestStatusNEEDSRETROSPECTIVEREVIEW RequestStatus = "NEEDS_RETROSPECTIVE_REVIEW"
+	RequestStatusPENDING                  RequestStatus = "PENDING"
 )
 
 // Defines values for ReviewDecision.
 const (
-	APPROVED ReviewDecision = "APPROVED"
-	DECLINED ReviewDecision = "DECLINED"
+	ReviewDecisionAPPROVED ReviewDecision = "APPROVED"
+	ReviewDecisionDECLINED ReviewDecision = "DECLINED"
+)
+
+// Defines values for Weekday.
+const (
+	FRIDAY    Weekday = "FRIDAY"
+	MONDAY    Weekday = "MONDAY"
+	SATURDAY  Weekday = "SATURDAY"
+	SUNDAY    Weekday = "SUNDAY"
+	THURSDAY  Weekday = "THURSDAY"
+	TUESDAY   Weekday = "TUESDAY"
+	WEDNESDAY Weekday = "WEDNESDAY"
 )
 
 // Access Rule contains information for an end user to make a request for access.
 type AccessRule struct {
+	// Further targets which are granted together with the target, for rules which grant a bundle of access.
+	AdditionalTargets *[]AccessRuleTarget `json:"additionalTargets,omitempty"`
+
+	// An optional CEL expression which decides whether requests for the rule are allowed. It can use the variables requestor (id, email, groups), request (durationSeconds, startTime, reason, fields), rule (id, name, provider, with) and activeRequests, the requestor's pending and approved requests which haven't ended (id, ruleId, status, end, and the provider and with of the grant once access is granted). It must evaluate to a bool, where false denies the request, or to a map with a decision of allow, deny or review, and an optional message shown to the requestor.
+	AdmissionPolicy *AdmissionPolicy `json:"admissionPolicy,omitempty"`
+
+	// Whether users can use break-glass access for this rule, which grants access immediately and requires the request to be reviewed afterwards.
+	BreakGlass  *bool  `json:"breakGlass,omitempty"`
 	Description string `json:"description"`
-	ID          string `json:"id"`
-	IsCurrent   bool   `json:"isCurrent"`
-	Name        string `json:"name"`
+
+	// Additional fields which users must fill in when requesting access.
+	Fields    *[]RequestField `json:"fields,omitempty"`
+	ID        string          `json:"id"`
+	IsCurrent bool            `json:"isCurrent"`
+	Name      string          `json:"name"`
 
 	// A target for an access rule
 	Target AccessRuleTarget `json:"target"`
@@ -94,16 +151,40 @@ type AccessRule struct {
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 
+	// Limits on how much an Access Rule can be used. Break-glass requests are not limited.
+	UsageLimits *UsageLimits `json:"usageLimits,omitempty"`
+
 	// A unique version identifier for the Access Rule. Updating a rule creates a new version.
 	// When a rule is updated, it's ID remains consistent.
 	Version string `json:"version"`
 }
 
+// The requests which are affected by archiving an Access Rule.
+type AccessRuleArchiveImpact struct {
+	// The approved requests with grants which are active or scheduled to start. They are revoked if the rule is archived with revokeGrants.
+	ActiveGrants []Request `json:"activeGrants"`
+
+	// The pending requests which are cancelled when the rule is archived.
+	PendingRequests []Request `json:"pendingRequests"`
+}
+
 // AccessRuleDetail contains detailed information about a rule and is used in administrative apis.
 type AccessRuleDetail struct {
+	// Further targets which are granted together with the target, for rules which grant a bundle of access.
+	AdditionalTargets *[]AccessRuleTarget `json:"additionalTargets,omitempty"`
+
+	// An optional CEL expression which decides whether requests for the rule are allowed. It can use the variables requestor (id, email, groups), request (durationSeconds, startTime, reason, fields), rule (id, name, provider, with) and activeRequests, the requestor's pending and approved requests which haven't ended (id, ruleId, status, end, and the provider and with of the grant once access is granted). It must evaluate to a bool, where false denies the request, or to a map with a decision of allow, deny or review, and an optional message shown to the requestor.
+	AdmissionPolicy *AdmissionPolicy `json:"admissionPolicy,omitempty"`
+
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
+	Approval ApproverConfig `json:"approval"`
+
+	// Whether users can use break-glass access for this rule, which grants access immediately and requires the request to be reviewed afterwards.
+	BreakGlass  *bool  `json:"breakGlass,omitempty"`
+	Description string `json:"description"`
+
+	// Additional fields which users must fill in when requesting access.
+	Fields *[]RequestField `json:"fields,omitempty"`
 
 	// The group IDs that the access rule applies to.
 	Groups    []string           `json:"groups"`
@@ -112,6 +193,9 @@ type AccessRuleDetail struct {
 	Metadata  AccessRuleMetadata `json:"metadata"`
 	Name      string             `json:"name"`
 
+	// The users and groups who can edit and archive an Access Rule and view its requests, without being administrators.
+	Owners *AccessRuleOwners `json:"owners,omitempty"`
+
 	// The status of an Access Rule.
 	Status AccessRuleStatus `json:"status"`
 
@@ -121,20 +205,53 @@ type AccessRuleDetail struct {
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 
+	// Limits on how much an Access Rule can be used. Break-glass requests are not limited.
+	UsageLimits *UsageLimits `json:"usageLimits,omitempty"`
+
 	// A unique version identifier for the Access Rule. Updating a rule creates a new version.
 	// When a rule is updated, it's ID remains consistent.
 	Version string `json:"version"`
 }
 
+// The changes made to an Access Rule between two of its versions.
+type AccessRuleDiff struct {
+	Changes     []AccessRuleFieldChange `json:"changes"`
+	FromVersion string                  `json:"fromVersion"`
+	RuleId      string                  `json:"ruleId"`
+	ToVersion   string                  `json:"toVersion"`
+}
+
+// A change to a single field of an Access Rule.
+//
+// Fields which are lists of IDs, such as approvers and groups, have the items which were added and removed. Other fields have their value in each version, which is omitted if the field isn't set.
+type AccessRuleFieldChange struct {
+	Added *[]string `json:"added,omitempty"`
+
+	// The path of the field which changed.
+	Field   string    `json:"field"`
+	From    *string   `json:"from,omitempty"`
+	Removed *[]string `json:"removed,omitempty"`
+	To      *string   `json:"to,omitempty"`
+}
+
 // AccessRuleMetadata defines model for AccessRuleMetadata.
 type AccessRuleMetadata struct {
-	CreatedAt     time.Time `json:"createdAt"`
-	CreatedBy     string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+	CreatedBy string    `json:"createdBy"`
+
+	// If this version was created by rolling the rule back, the earlier version which it copies.
+	RolledBackTo  *string   `json:"rolledBackTo,omitempty"`
 	UpdateMessage *string   `json:"updateMessage,omitempty"`
 	UpdatedAt     time.Time `json:"updatedAt"`
 	UpdatedBy     string    `json:"updatedBy"`
 }
 
+// The users and groups who can edit and archive an Access Rule and view its requests, without being administrators.
+type AccessRuleOwners struct {
+	Groups []string `json:"groups"`
+	Users  []string `json:"users"`
+}
+
 // The status of an Access Rule.
 type AccessRuleStatus string
 
@@ -143,6 +260,9 @@ type AccessRuleTarget struct {
 	// Provider
 	Provider Provider              `json:"provider"`
 	With     AccessRuleTarget_With `json:"with"`
+
+	// Arguments which requestors choose a value for when they request access, keyed by argument ID.
+	WithSelectable *AccessRuleTarget_WithSelectable `json:"withSelectable,omitempty"`
 }
 
 // AccessRuleTarget_With defines model for AccessRuleTarget.With.
@@ -150,21 +270,144 @@ type AccessRuleTarget_With struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// Arguments which requestors choose a value for when they request access, keyed by argument ID.
+type AccessRuleTarget_WithSelectable struct {
+	AdditionalProperties map[string]SelectableArgument `json:"-"`
+}
+
+// An optional CEL expression which decides whether requests for the rule are allowed. It can use the variables requestor (id, email, groups), request (durationSeconds, startTime, reason, fields), rule (id, name, provider, with) and activeRequests, the requestor's pending and approved requests which haven't ended (id, ruleId, status, end, and the provider and with of the grant once access is granted). It must evaluate to a bool, where false denies the request, or to a map with a decision of allow, deny or review, and an optional message shown to the requestor.
+type AdmissionPolicy = string
+
+// A recurring window of time in which access can be granted, such as business hours.
+type AllowedWindow struct {
+	Days []Weekday `json:"days"`
+
+	// The time of day the window ends, in 24 hour HH:MM format. If it is before the start time, the window ends on the following day.
+	EndTime string `json:"endTime"`
+
+	// The time of day the window starts, in 24 hour HH:MM format.
+	StartTime string `json:"startTime"`
+
+	// The IANA timezone the window is defined in.
+	Timezone string `json:"timezone"`
+}
+
+// Conditions which a request must meet for an approval policy to apply. All of the conditions which are set must be met. A policy without conditions applies to every request.
+type ApprovalConditions struct {
+	// The requested duration must be at most this many seconds.
+	MaxDurationSeconds *int `json:"maxDurationSeconds,omitempty"`
+
+	// The requestor must belong to at least one of these groups.
+	RequestorGroups *[]string `json:"requestorGroups,omitempty"`
+}
+
 // Describes whether a request has been approved automatically or from a review
 type ApprovalMethod string
 
+// A condition based policy deciding whether requests for an Access Rule are approved automatically or need review.
+type ApprovalPolicy struct {
+	Action ApprovalPolicyAction `json:"action"`
+
+	// Conditions which a request must meet for an approval policy to apply. All of the conditions which are set must be met. A policy without conditions applies to every request.
+	Conditions  ApprovalConditions `json:"conditions"`
+	Description *string            `json:"description,omitempty"`
+	Id          string             `json:"id"`
+}
+
+// ApprovalPolicyAction defines model for ApprovalPolicyAction.
+type ApprovalPolicyAction string
+
+// A step in a sequential approval chain for an Access Rule.
+type ApprovalStage struct {
+	// The group IDs whose members may approve this stage.
+	Groups []string `json:"groups"`
+
+	// The number of distinct approving reviews required to complete this stage. Defaults to 1 if omitted.
+	RequiredApprovals *int `json:"requiredApprovals,omitempty"`
+
+	// The user IDs of the approvers for this stage.
+	Users []string `json:"users"`
+}
+
 // Approver config for access rules
 type ApproverConfig struct {
 	Groups []string `json:"groups"`
 
+	// Ordered approval policies. The first policy whose conditions match a request decides whether it is approved automatically or needs review. If no policy matches, requests need review if the rule has approvers.
+	Policies *[]ApprovalPolicy `json:"policies,omitempty"`
+
+	// The number of distinct approving reviews required before access is granted. Defaults to 1 if omitted.
+	RequiredApprovals *int `json:"requiredApprovals,omitempty"`
+
+	// Ordered approval stages. If provided, each stage must be approved before reviewers for the next stage are notified. The users, groups and requiredApprovals fields must be empty when stages are used.
+	Stages *[]ApprovalStage `json:"stages,omitempty"`
+
 	// The user IDs of the approvers for the request.
 	Users []string `json:"users"`
 }
 
+// The archived Access Rule and the outcome for each request affected by archiving it.
+type ArchiveAccessRuleResult struct {
+	// AccessRuleDetail contains detailed information about a rule and is used in administrative apis.
+	AccessRule AccessRuleDetail `json:"accessRule"`
+	Outcomes   []ArchiveOutcome `json:"outcomes"`
+}
+
+// What happened to a request affected by archiving an Access Rule.
+type ArchiveOutcome struct {
+	Action ArchiveOutcomeAction `json:"action"`
+
+	// Why the request was skipped or failed.
+	Message   *string `json:"message,omitempty"`
+	RequestId string  `json:"requestId"`
+
+	// SKIPPED if the request or grant was changed by someone else while the rule was being archived.
+	Result ArchiveOutcomeResult `json:"result"`
+}
+
+// ArchiveOutcomeAction defines model for ArchiveOutcome.Action.
+type ArchiveOutcomeAction string
+
+// SKIPPED if the request or grant was changed by someone else while the rule was being archived.
+type ArchiveOutcomeResult string
+
+// A period, such as a change freeze, during which access can't be granted for any access rule.
+type Blackout struct {
+	CreatedBy string    `json:"createdBy"`
+	End       time.Time `json:"end"`
+	Id        string    `json:"id"`
+	Reason    string    `json:"reason"`
+	Start     time.Time `json:"start"`
+}
+
+// The result of reviewing a single request in a bulk review.
+type BulkReviewResult struct {
+	// The reason the request could not be reviewed. Only set if the review failed.
+	Error *string `json:"error,omitempty"`
+
+	// A request to access something made by an end user in Granted.
+	Request   *Request `json:"request,omitempty"`
+	RequestId string   `json:"requestId"`
+}
+
+// A comment on an access request.
+type Comment struct {
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+	RequestId string    `json:"requestId"`
+
+	// The ID of the user who made the comment.
+	User string `json:"user"`
+}
+
 // A target for an access rule
 type CreateAccessRuleTarget struct {
 	ProviderId string                      `json:"providerId"`
 	With       CreateAccessRuleTarget_With `json:"with"`
+
+	// Arguments which requestors choose a value for when they request access, keyed by argument ID. An argument can't be in both with and withSelectable.
+	WithSelectable *CreateAccessRuleTarget_WithSelectable `json:"withSelectable,omitempty"`
 }
 
 // CreateAccessRuleTarget_With defines model for CreateAccessRuleTarget.With.
@@ -172,6 +415,31 @@ type CreateAccessRuleTarget_With struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// Arguments which requestors choose a value for when they request access, keyed by argument ID. An argument can't be in both with and withSelectable.
+type CreateAccessRuleTarget_WithSelectable struct {
+	AdditionalProperties map[string]SelectableArgument `json:"-"`
+}
+
+// A delegation of a user's reviews to another user while they are away.
+type Delegation struct {
+	// The ID of the user who reviews requests on behalf of the user.
+	Delegate string    `json:"delegate"`
+	End      time.Time `json:"end"`
+	Start    time.Time `json:"start"`
+
+	// The ID of the user whose reviews are delegated.
+	User string `json:"user"`
+}
+
+// The status of a request extension.
+type ExtensionStatus string
+
+// A field which failed validation.
+type FieldError struct {
+	Error string `json:"error"`
+	Field string `json:"field"`
+}
+
 // A temporary assignment of a user to a principal.
 type Grant struct {
 	// The end time of the grant.
@@ -193,6 +461,13 @@ type Grant struct {
 // The current state of the grant.
 type GrantStatus string
 
+// Whether the Access Handler would be able to grant access for a request.
+type GrantValidation struct {
+	// The reason access couldn't be granted. Only set if the grant isn't valid.
+	Error *string `json:"error,omitempty"`
+	Valid bool    `json:"valid"`
+}
+
 // Group defines model for Group.
 type Group struct {
 	Description string `json:"description"`
@@ -213,9 +488,21 @@ type Provider struct {
 type Request struct {
 	AccessRule RequestAccessRule `json:"accessRule"`
 
+	// The grants for the additional targets of the Access Rule, in the same order as the targets.
+	AdditionalGrants *[]Grant `json:"additionalGrants,omitempty"`
+
 	// Describes whether a request has been approved automatically or from a review
 	ApprovalMethod *ApprovalMethod `json:"approvalMethod,omitempty"`
 
+	// The ID of the approval policy of the Access Rule which decided the approval method of the request. Not set if no policy matched the request.
+	ApprovalPolicyId *string `json:"approvalPolicyId,omitempty"`
+
+	// The ID of the user who submitted the request on behalf of the requestor. Only set for delegated requests.
+	DelegatedBy *string `json:"delegatedBy,omitempty"`
+
+	// A request to extend the grant of an approved request.
+	Extension *RequestExtension `json:"extension,omitempty"`
+
 	// A temporary assignment of a user to a principal.
 	Grant       *Grant    `json:"grant,omitempty"`
 	ID          string    `json:"id"`
@@ -223,10 +510,18 @@ type Request struct {
 	RequestedAt time.Time `json:"requestedAt"`
 	Requestor   string    `json:"requestor"`
 
+	// The values chosen by the requestor for the selectable arguments of the Access Rule target, keyed by argument ID.
+	SelectedWith *SelectedArguments `json:"selectedWith,omitempty"`
+
 	// The status of an Access Request.
-	Status    RequestStatus `json:"status"`
-	Timing    RequestTiming `json:"timing"`
-	UpdatedAt time.Time     `json:"updatedAt"`
+	// NEEDS_RETROSPECTIVE_REVIEW requests were made using break-glass access, access has been granted but the request must still be reviewed.
+	// EXPIRED requests were not reviewed before the pending timeout of their Access Rule.
+	Status RequestStatus `json:"status"`
+	Timing RequestTiming `json:"timing"`
+
+	// Set if the request timing was shortened to fit within the allowed windows of the Access Rule or to avoid a blackout period.
+	TimingClippedReason *string   `json:"timingClippedReason,omitempty"`
+	UpdatedAt           time.Time `json:"updatedAt"`
 }
 
 // RequestAccessRule defines model for RequestAccessRule.
@@ -240,12 +535,33 @@ type RequestDetail struct {
 	// Access Rule contains information for an end user to make a request for access.
 	AccessRule AccessRule `json:"accessRule"`
 
+	// The grants for the additional targets of the Access Rule, in the same order as the targets.
+	AdditionalGrants *[]Grant `json:"additionalGrants,omitempty"`
+
 	// Describes whether a request has been approved automatically or from a review
 	ApprovalMethod *ApprovalMethod `json:"approvalMethod,omitempty"`
 
+	// The ID of the approval policy of the Access Rule which decided the approval method of the request. Not set if no policy matched the request.
+	ApprovalPolicyId *string `json:"approvalPolicyId,omitempty"`
+
+	// The index of the approval stage which the request is currently waiting on.
+	ApprovalStage *int `json:"approvalStage,omitempty"`
+
+	// The user IDs of the reviewers who have approved this request so far.
+	ApprovedBy *[]string `json:"approvedBy,omitempty"`
+
 	// true if the requesting user is a reviewer of this request.
 	CanReview bool `json:"canReview"`
 
+	// The ID of the user who submitted the request on behalf of the requestor. Only set for delegated requests.
+	DelegatedBy *string `json:"delegatedBy,omitempty"`
+
+	// A request to extend the grant of an approved request.
+	Extension *RequestExtension `json:"extension,omitempty"`
+
+	// The values given for the request fields of the Access Rule.
+	Fields *[]RequestFieldValue `json:"fields,omitempty"`
+
 	// A temporary assignment of a user to a principal.
 	Grant       *Grant    `json:"grant,omitempty"`
 	ID          string    `json:"id"`
@@ -253,33 +569,74 @@ type RequestDetail struct {
 	RequestedAt time.Time `json:"requestedAt"`
 	Requestor   string    `json:"requestor"`
 
+	// The values chosen by the requestor for the selectable arguments of the Access Rule target, keyed by argument ID.
+	SelectedWith *SelectedArguments `json:"selectedWith,omitempty"`
+
 	// The status of an Access Request.
-	Status    RequestStatus `json:"status"`
-	Timing    RequestTiming `json:"timing"`
-	UpdatedAt time.Time     `json:"updatedAt"`
+	// NEEDS_RETROSPECTIVE_REVIEW requests were made using break-glass access, access has been granted but the request must still be reviewed.
+	// EXPIRED requests were not reviewed before the pending timeout of their Access Rule.
+	Status RequestStatus `json:"status"`
+	Timing RequestTiming `json:"timing"`
+
+	// Set if the request timing was shortened to fit within the allowed windows of the Access Rule or to avoid a blackout period.
+	TimingClippedReason *string   `json:"timingClippedReason,omitempty"`
+	UpdatedAt           time.Time `json:"updatedAt"`
 }
 
 // RequestEvent defines model for RequestEvent.
 type RequestEvent struct {
-	Actor     *string   `json:"actor,omitempty"`
+	Actor *string `json:"actor,omitempty"`
+
+	// The text of a comment made on the request.
+	Comment   *string   `json:"comment,omitempty"`
 	CreatedAt time.Time `json:"createdAt"`
 
+	// The ID of a user who was added as a reviewer on behalf of an approver who is away.
+	Delegate *string `json:"delegate,omitempty"`
+
+	// The status of a request extension.
+	ExtensionStatus   *ExtensionStatus `json:"extensionStatus,omitempty"`
+	FromApprovalStage *int             `json:"fromApprovalStage,omitempty"`
+
 	// The current state of the grant.
 	FromGrantStatus *RequestEventFromGrantStatus `json:"fromGrantStatus,omitempty"`
 
 	// The status of an Access Request.
-	FromStatus         *RequestStatus `json:"fromStatus,omitempty"`
-	FromTiming         *RequestTiming `json:"fromTiming,omitempty"`
-	GrantCreated       *bool          `json:"grantCreated,omitempty"`
-	GrantFailureReason *string        `json:"grantFailureReason,omitempty"`
-	Id                 string         `json:"id"`
-	RequestCreated     *bool          `json:"requestCreated,omitempty"`
-	RequestId          string         `json:"requestId"`
+	// NEEDS_RETROSPECTIVE_REVIEW requests were made using break-glass access, access has been granted but the request must still be reviewed.
+	// EXPIRED requests were not reviewed before the pending timeout of their Access Rule.
+	FromStatus   *RequestStatus `json:"fromStatus,omitempty"`
+	FromTiming   *RequestTiming `json:"fromTiming,omitempty"`
+	GrantCreated *bool          `json:"grantCreated,omitempty"`
+
+	// Set when the grant reconciler found that the grant doesn't match its provider. MISSING means that an active grant isn't provisioned, and NOT_REMOVED means that an expired grant is still provisioned.
+	GrantDrift *RequestEventGrantDrift `json:"grantDrift,omitempty"`
+
+	// Why the grant reconciler couldn't remediate the drift.
+	GrantDriftError *string `json:"grantDriftError,omitempty"`
+
+	// Set when the grant reconciler provisioned missing access again, or removed access which should have expired.
+	GrantDriftRemediated *bool   `json:"grantDriftRemediated,omitempty"`
+	GrantFailureReason   *string `json:"grantFailureReason,omitempty"`
+
+	// The ID of the grant which the event relates to. Only set for the grants of the additional targets of an Access Rule, as the grant for the rule's target has the same ID as the request.
+	GrantId *string `json:"grantId,omitempty"`
+	Id      string  `json:"id"`
+
+	// The ID of the user the request was made for, if the request was made by another user. For reviewer delegation events, the ID of the approver the delegate reviews on behalf of.
+	OnBehalfOf *string `json:"onBehalfOf,omitempty"`
+
+	// Set when the requestor ended their own grant before it expired.
+	ReleasedByRequestor *bool  `json:"releasedByRequestor,omitempty"`
+	RequestCreated      *bool  `json:"requestCreated,omitempty"`
+	RequestId           string `json:"requestId"`
+	ToApprovalStage     *int   `json:"toApprovalStage,omitempty"`
 
 	// The current state of the grant.
 	ToGrantStatus *RequestEventToGrantStatus `json:"toGrantStatus,omitempty"`
 
 	// The status of an Access Request.
+	// NEEDS_RETROSPECTIVE_REVIEW requests were made using break-glass access, access has been granted but the request must still be reviewed.
+	// EXPIRED requests were not reviewed before the pending timeout of their Access Rule.
 	ToStatus *RequestStatus `json:"toStatus,omitempty"`
 	ToTiming *RequestTiming `json:"toTiming,omitempty"`
 }
@@ -287,10 +644,87 @@ type RequestEvent struct {
 // The current state of the grant.
 type RequestEventFromGrantStatus string
 
+// Set when the grant reconciler found that the grant doesn't match its provider. MISSING means that an active grant isn't provisioned, and NOT_REMOVED means that an expired grant is still provisioned.
+type RequestEventGrantDrift string
+
 // The current state of the grant.
 type RequestEventToGrantStatus string
 
+// A request to extend the grant of an approved request.
+type RequestExtension struct {
+	// The amount of time to add to the grant.
+	DurationSeconds int       `json:"durationSeconds"`
+	Reason          *string   `json:"reason,omitempty"`
+	RequestedAt     time.Time `json:"requestedAt"`
+
+	// The user who reviewed the extension. Not set for extensions which were approved automatically.
+	ReviewedBy *string `json:"reviewedBy,omitempty"`
+
+	// The status of a request extension.
+	Status ExtensionStatus `json:"status"`
+}
+
+// An additional field which users fill in when requesting access, such as a ticket number.
+type RequestField struct {
+	Description *string `json:"description,omitempty"`
+
+	// The key the field value is stored under on the request.
+	Id    string `json:"id"`
+	Label string `json:"label"`
+
+	// If set, the value must be one of these options.
+	Options *[]string `json:"options,omitempty"`
+
+	// A regular expression the whole value must match.
+	Regex    *string `json:"regex,omitempty"`
+	Required bool    `json:"required"`
+}
+
+// Values for the request fields of an Access Rule, keyed by field ID.
+type RequestFieldInput struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// The value given for a request field.
+type RequestFieldValue struct {
+	Id    string `json:"id"`
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// What would happen if a request was made.
+type RequestPreview struct {
+	// Describes whether a request has been approved automatically or from a review
+	ApprovalMethod *ApprovalMethod `json:"approvalMethod,omitempty"`
+
+	// The ID of the approval policy of the access rule which would decide whether the request needs review.
+	ApprovalPolicyId *string `json:"approvalPolicyId,omitempty"`
+
+	// The reason the request would be rejected. Only set if the request isn't valid.
+	Error *string `json:"error,omitempty"`
+
+	// The fields of the request which are invalid, if any.
+	Fields *[]FieldError `json:"fields,omitempty"`
+
+	// Whether the Access Handler would be able to grant access for a request.
+	GrantValidation *GrantValidation `json:"grantValidation,omitempty"`
+
+	// The IDs of the users who would be asked to review the request. Empty if the request would be approved automatically.
+	Reviewers []string `json:"reviewers"`
+
+	// The status of an Access Request.
+	// NEEDS_RETROSPECTIVE_REVIEW requests were made using break-glass access, access has been granted but the request must still be reviewed.
+	// EXPIRED requests were not reviewed before the pending timeout of their Access Rule.
+	Status *RequestStatus `json:"status,omitempty"`
+	Timing *RequestTiming `json:"timing,omitempty"`
+
+	// Whether the request would be created.
+	Valid bool `json:"valid"`
+}
+
 // The status of an Access Request.
+// NEEDS_RETROSPECTIVE_REVIEW requests were made using break-glass access, access has been granted but the request must still be reviewed.
+// EXPIRED requests were not reviewed before the pending timeout of their Access Rule.
 type RequestStatus string
 
 // RequestTiming defines model for RequestTiming.
@@ -304,10 +738,51 @@ type RequestTiming struct {
 // A decision made on an Access Request.
 type ReviewDecision string
 
+// The values which requestors can choose from for an argument of an Access Rule target.
+type SelectableArgument struct {
+	// If true, requestors can choose any of the options suggested by the provider for the argument, in addition to the values.
+	AnyOption *bool `json:"anyOption,omitempty"`
+
+	// The values which requestors can choose from.
+	Values *[]string `json:"values,omitempty"`
+}
+
+// The values chosen by the requestor for the selectable arguments of the Access Rule target, keyed by argument ID.
+type SelectedArguments struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // Time configuration for an Access Rule.
 type TimeConstraints struct {
+	// If set, access can only be granted within these windows. A grant which would run past the end of its window is shortened to end with the window.
+	AllowedWindows *[]AllowedWindow `json:"allowedWindows,omitempty"`
+
 	// The maximum duration in seconds the access is allowed for.
 	MaxDurationSeconds int `json:"maxDurationSeconds"`
+
+	// If set, requests which are still pending after this many seconds are expired and can no longer be reviewed.
+	PendingTimeoutSeconds *int `json:"pendingTimeoutSeconds,omitempty"`
+}
+
+// Limits on how much an Access Rule can be used. Break-glass requests are not limited.
+type UsageLimits struct {
+	// If set, the minimum number of seconds between the end of a user's grant and the start of their next grant for the rule.
+	CooldownSeconds *int `json:"cooldownSeconds,omitempty"`
+
+	// If set, the maximum number of grants for the rule which can be active at the same time, across all users.
+	MaxActiveGrants *int `json:"maxActiveGrants,omitempty"`
+
+	// The maximum total duration of grants a user can have for an Access Rule within a rolling window.
+	Quota *UsageQuota `json:"quota,omitempty"`
+}
+
+// The maximum total duration of grants a user can have for an Access Rule within a rolling window.
+type UsageQuota struct {
+	// The maximum total duration in seconds of a user's grants within the window.
+	MaxDurationSeconds int `json:"maxDurationSeconds"`
+
+	// The length in seconds of the rolling window.
+	WindowSeconds int `json:"windowSeconds"`
 }
 
 // User defines model for User.
@@ -321,6 +796,9 @@ type User struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// Weekday defines model for Weekday.
+type Weekday string
+
 // ArgOptionsResponse defines model for ArgOptionsResponse.
 type ArgOptionsResponse struct {
 	// Whether any options have been suggested for the argument.
@@ -337,6 +815,11 @@ type AuthUserResponse struct {
 	User    User `json:"user"`
 }
 
+// BulkReviewResponse defines model for BulkReviewResponse.
+type BulkReviewResponse struct {
+	Results []BulkReviewResult `json:"results"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error string `json:"error"`
@@ -360,12 +843,23 @@ type ListAccessRulesResponse struct {
 	Next        *string      `json:"next"`
 }
 
+// ListBlackoutsResponse defines model for ListBlackoutsResponse.
+type ListBlackoutsResponse struct {
+	Blackouts []Blackout `json:"blackouts"`
+}
+
 // ListGroupsResponse defines model for ListGroupsResponse.
 type ListGroupsResponse struct {
 	Groups []Group `json:"groups"`
 	Next   *string `json:"next"`
 }
 
+// ListRequestCommentsResponse defines model for ListRequestCommentsResponse.
+type ListRequestCommentsResponse struct {
+	Comments []Comment `json:"comments"`
+	Next     *string   `json:"next"`
+}
+
 // ListRequestEventsResponse defines model for ListRequestEventsResponse.
 type ListRequestEventsResponse struct {
 	Events []RequestEvent `json:"events"`
@@ -384,34 +878,89 @@ type ListUserResponse struct {
 	Users []User  `json:"users"`
 }
 
+// What would happen if a request was made.
+type RequestPreviewResponse = RequestPreview
+
 // ReviewResponse defines model for ReviewResponse.
 type ReviewResponse struct {
 	// A request to access something made by an end user in Granted.
 	Request *Request `json:"request,omitempty"`
 }
 
+// AddRequestCommentRequest defines model for AddRequestCommentRequest.
+type AddRequestCommentRequest struct {
+	// The text of the comment.
+	Body string `json:"body"`
+}
+
+// BulkReviewRequest defines model for BulkReviewRequest.
+type BulkReviewRequest struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// A decision made on an Access Request.
+	Decision   ReviewDecision `json:"decision"`
+	RequestIds []string       `json:"requestIds"`
+}
+
 // CreateAccessRuleRequest defines model for CreateAccessRuleRequest.
 type CreateAccessRuleRequest struct {
+	// Further targets to grant together with the target, for rules which grant a bundle of access. A grant is created for each target when a request is approved. The targets of a rule can't be changed once it is created.
+	AdditionalTargets *[]CreateAccessRuleTarget `json:"additionalTargets,omitempty"`
+
+	// An optional CEL expression which decides whether requests for the rule are allowed. It can use the variables requestor (id, email, groups), request (durationSeconds, startTime, reason, fields), rule (id, name, provider, with) and activeRequests, the requestor's pending and approved requests which haven't ended (id, ruleId, status, end, and the provider and with of the grant once access is granted). It must evaluate to a bool, where false denies the request, or to a map with a decision of allow, deny or review, and an optional message shown to the requestor.
+	AdmissionPolicy *AdmissionPolicy `json:"admissionPolicy,omitempty"`
+
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
+	Approval ApproverConfig `json:"approval"`
+
+	// Allow users to use break-glass access for this rule. Requires the rule to have approvers, who review break-glass requests afterwards.
+	BreakGlass  *bool  `json:"breakGlass,omitempty"`
+	Description string `json:"description"`
+
+	// Additional fields which users must fill in when requesting access.
+	Fields *[]RequestField `json:"fields,omitempty"`
 
 	// The group IDs that the access rule applies to.
 	Groups []string `json:"groups"`
 	Name   string   `json:"name"`
 
+	// The users and groups who can edit and archive an Access Rule and view its requests, without being administrators.
+	Owners *AccessRuleOwners `json:"owners,omitempty"`
+
 	// A target for an access rule
 	Target CreateAccessRuleTarget `json:"target"`
 
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
+
+	// Limits on how much an Access Rule can be used. Break-glass requests are not limited.
+	UsageLimits *UsageLimits `json:"usageLimits,omitempty"`
+}
+
+// CreateBlackoutRequest defines model for CreateBlackoutRequest.
+type CreateBlackoutRequest struct {
+	End    time.Time `json:"end"`
+	Reason string    `json:"reason"`
+	Start  time.Time `json:"start"`
 }
 
 // CreateRequestRequest defines model for CreateRequestRequest.
 type CreateRequestRequest struct {
-	AccessRuleId string        `json:"accessRuleId"`
-	Reason       *string       `json:"reason,omitempty"`
-	Timing       RequestTiming `json:"timing"`
+	AccessRuleId string `json:"accessRuleId"`
+
+	// Use break-glass access. Access is granted immediately and the request is reviewed afterwards. The Access Rule must allow break-glass access.
+	BreakGlass *bool `json:"breakGlass,omitempty"`
+
+	// Values for the request fields of an Access Rule, keyed by field ID.
+	Fields *RequestFieldInput `json:"fields,omitempty"`
+
+	// The ID of the user to request access for. Only administrators can request access on behalf of another user, and the user must belong to one of the groups of the Access Rule. If omitted, access is requested for the current user.
+	OnBehalfOf *string       `json:"onBehalfOf,omitempty"`
+	Reason     *string       `json:"reason,omitempty"`
+	Timing     RequestTiming `json:"timing"`
+
+	// The values chosen by the requestor for the selectable arguments of the Access Rule target, keyed by argument ID.
+	With *SelectedArguments `json:"with,omitempty"`
 }
 
 // CreateUserRequest defines model for CreateUserRequest.
@@ -421,6 +970,13 @@ type CreateUserRequest struct {
 	Name    string              `json:"name"`
 }
 
+// ExtendRequestRequest defines model for ExtendRequestRequest.
+type ExtendRequestRequest struct {
+	// The amount of time to add to the grant.
+	DurationSeconds int     `json:"durationSeconds"`
+	Reason          *string `json:"reason,omitempty"`
+}
+
 // ReviewRequest defines model for ReviewRequest.
 type ReviewRequest struct {
 	Comment *string `json:"comment,omitempty"`
@@ -430,17 +986,40 @@ type ReviewRequest struct {
 	OverrideTiming *RequestTiming `json:"overrideTiming,omitempty"`
 }
 
+// SetDelegationRequest defines model for SetDelegationRequest.
+type SetDelegationRequest struct {
+	// The ID of the user who will review requests on your behalf.
+	Delegate string    `json:"delegate"`
+	End      time.Time `json:"end"`
+	Start    time.Time `json:"start"`
+}
+
 // UpdateAccessRuleRequest defines model for UpdateAccessRuleRequest.
 type UpdateAccessRuleRequest struct {
+	// An optional CEL expression which decides whether requests for the rule are allowed. It can use the variables requestor (id, email, groups), request (durationSeconds, startTime, reason, fields), rule (id, name, provider, with) and activeRequests, the requestor's pending and approved requests which haven't ended (id, ruleId, status, end, and the provider and with of the grant once access is granted). It must evaluate to a bool, where false denies the request, or to a map with a decision of allow, deny or review, and an optional message shown to the requestor.
+	AdmissionPolicy *AdmissionPolicy `json:"admissionPolicy,omitempty"`
+
 	// Approver config for access rules
-	Approval    ApproverConfig `json:"approval"`
-	Description string         `json:"description"`
-	Groups      []string       `json:"groups"`
-	Name        string         `json:"name"`
+	Approval ApproverConfig `json:"approval"`
+
+	// Allow users to use break-glass access for this rule. Requires the rule to have approvers, who review break-glass requests afterwards.
+	BreakGlass  *bool  `json:"breakGlass,omitempty"`
+	Description string `json:"description"`
+
+	// Additional fields which users must fill in when requesting access.
+	Fields *[]RequestField `json:"fields,omitempty"`
+	Groups []string        `json:"groups"`
+	Name   string          `json:"name"`
+
+	// The users and groups who can edit and archive an Access Rule and view its requests, without being administrators.
+	Owners *AccessRuleOwners `json:"owners,omitempty"`
 
 	// Time configuration for an Access Rule.
 	TimeConstraints TimeConstraints `json:"timeConstraints"`
 	UpdateMessage   *string         `json:"updateMessage,omitempty"`
+
+	// Limits on how much an Access Rule can be used. Break-glass requests are not limited.
+	UsageLimits *UsageLimits `json:"usageLimits,omitempty"`
 }
 
 // AdminListAccessRulesParams defines parameters for AdminListAccessRules.
@@ -452,6 +1031,27 @@ type AdminListAccessRulesParams struct {
 // AdminListAccessRulesParamsStatus defines parameters for AdminListAccessRules.
 type AdminListAccessRulesParamsStatus string
 
+// AdminArchiveAccessRuleJSONBody defines parameters for AdminArchiveAccessRule.
+type AdminArchiveAccessRuleJSONBody struct {
+	// Revoke the grants for the access rule which are active or scheduled to start.
+	RevokeGrants *bool `json:"revokeGrants,omitempty"`
+}
+
+// AdminDiffAccessRuleVersionsParams defines parameters for AdminDiffAccessRuleVersions.
+type AdminDiffAccessRuleVersionsParams struct {
+	// The earlier version to compare.
+	From string `form:"from" json:"from"`
+
+	// The later version to compare. Defaults to the current version.
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// AdminRollbackAccessRuleJSONBody defines parameters for AdminRollbackAccessRule.
+type AdminRollbackAccessRuleJSONBody struct {
+	// A message describing why the rule was rolled back. Defaults to a message naming the version.
+	UpdateMessage *string `json:"updateMessage,omitempty"`
+}
+
 // AdminListRequestsParams defines parameters for AdminListRequests.
 type AdminListRequestsParams struct {
 	// omit this param to view all results
@@ -464,6 +1064,12 @@ type AdminListRequestsParams struct {
 // AdminListRequestsParamsStatus defines parameters for AdminListRequests.
 type AdminListRequestsParamsStatus string
 
+// OwnerListAccessRuleRequestsParams defines parameters for OwnerListAccessRuleRequests.
+type OwnerListAccessRuleRequestsParams struct {
+	// encrypted token containing pagination info
+	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+}
+
 // UserListRequestsParams defines parameters for UserListRequests.
 type UserListRequestsParams struct {
 	// omit this param to view all results
@@ -479,37 +1085,285 @@ type UserListRequestsParams struct {
 // UserListRequestsParamsStatus defines parameters for UserListRequests.
 type UserListRequestsParamsStatus string
 
+// ReviewRequestExtensionJSONBody defines parameters for ReviewRequestExtension.
+type ReviewRequestExtensionJSONBody struct {
+	// A decision made on an Access Request.
+	Decision ReviewDecision `json:"decision"`
+}
+
 // AdminCreateAccessRuleJSONRequestBody defines body for AdminCreateAccessRule for application/json ContentType.
 type AdminCreateAccessRuleJSONRequestBody CreateAccessRuleRequest
 
 // AdminUpdateAccessRuleJSONRequestBody defines body for AdminUpdateAccessRule for application/json ContentType.
 type AdminUpdateAccessRuleJSONRequestBody UpdateAccessRuleRequest
 
+// AdminArchiveAccessRuleJSONRequestBody defines body for AdminArchiveAccessRule for application/json ContentType.
+type AdminArchiveAccessRuleJSONRequestBody AdminArchiveAccessRuleJSONBody
+
+// AdminRollbackAccessRuleJSONRequestBody defines body for AdminRollbackAccessRule for application/json ContentType.
+type AdminRollbackAccessRuleJSONRequestBody AdminRollbackAccessRuleJSONBody
+
+// AdminCreateBlackoutJSONRequestBody defines body for AdminCreateBlackout for application/json ContentType.
+type AdminCreateBlackoutJSONRequestBody CreateBlackoutRequest
+
+// OwnerUpdateAccessRuleJSONRequestBody defines body for OwnerUpdateAccessRule for application/json ContentType.
+type OwnerUpdateAccessRuleJSONRequestBody UpdateAccessRuleRequest
+
 // UserCreateRequestJSONRequestBody defines body for UserCreateRequest for application/json ContentType.
 type UserCreateRequestJSONRequestBody CreateRequestRequest
 
+// UserPreviewRequestJSONRequestBody defines body for UserPreviewRequest for application/json ContentType.
+type UserPreviewRequestJSONRequestBody CreateRequestRequest
+
+// BulkReviewRequestsJSONRequestBody defines body for BulkReviewRequests for application/json ContentType.
+type BulkReviewRequestsJSONRequestBody BulkReviewRequest
+
+// AddRequestCommentJSONRequestBody defines body for AddRequestComment for application/json ContentType.
+type AddRequestCommentJSONRequestBody AddRequestCommentRequest
+
+// ExtendRequestJSONRequestBody defines body for ExtendRequest for application/json ContentType.
+type ExtendRequestJSONRequestBody ExtendRequestRequest
+
+// ReviewRequestExtensionJSONRequestBody defines body for ReviewRequestExtension for application/json ContentType.
+type ReviewRequestExtensionJSONRequestBody ReviewRequestExtensionJSONBody
+
 // ReviewRequestJSONRequestBody defines body for ReviewRequest for application/json ContentType.
 type ReviewRequestJSONRequestBody ReviewRequest
 
-// Getter for additional properties for AccessRuleTarget_With. Returns the specified
+// SetMyDelegationJSONRequestBody defines body for SetMyDelegation for application/json ContentType.
+type SetMyDelegationJSONRequestBody SetDelegationRequest
+
+// Getter for additional properties for AccessRuleTarget_With. Returns the specified
+// element and whether it was found
+func (a AccessRuleTarget_With) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for AccessRuleTarget_With
+func (a *AccessRuleTarget_With) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for AccessRuleTarget_With to handle AdditionalProperties
+func (a *AccessRuleTarget_With) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for AccessRuleTarget_With to handle AdditionalProperties
+func (a AccessRuleTarget_With) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for AccessRuleTarget_WithSelectable. Returns the specified
+// element and whether it was found
+func (a AccessRuleTarget_WithSelectable) Get(fieldName string) (value SelectableArgument, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for AccessRuleTarget_WithSelectable
+func (a *AccessRuleTarget_WithSelectable) Set(fieldName string, value SelectableArgument) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]SelectableArgument)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for AccessRuleTarget_WithSelectable to handle AdditionalProperties
+func (a *AccessRuleTarget_WithSelectable) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]SelectableArgument)
+		for fieldName, fieldBuf := range object {
+			var fieldVal SelectableArgument
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for AccessRuleTarget_WithSelectable to handle AdditionalProperties
+func (a AccessRuleTarget_WithSelectable) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CreateAccessRuleTarget_With. Returns the specified
+// element and whether it was found
+func (a CreateAccessRuleTarget_With) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CreateAccessRuleTarget_With
+func (a *CreateAccessRuleTarget_With) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CreateAccessRuleTarget_With to handle AdditionalProperties
+func (a *CreateAccessRuleTarget_With) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CreateAccessRuleTarget_With to handle AdditionalProperties
+func (a CreateAccessRuleTarget_With) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CreateAccessRuleTarget_WithSelectable. Returns the specified
+// element and whether it was found
+func (a CreateAccessRuleTarget_WithSelectable) Get(fieldName string) (value SelectableArgument, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CreateAccessRuleTarget_WithSelectable
+func (a *CreateAccessRuleTarget_WithSelectable) Set(fieldName string, value SelectableArgument) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]SelectableArgument)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CreateAccessRuleTarget_WithSelectable to handle AdditionalProperties
+func (a *CreateAccessRuleTarget_WithSelectable) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]SelectableArgument)
+		for fieldName, fieldBuf := range object {
+			var fieldVal SelectableArgument
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CreateAccessRuleTarget_WithSelectable to handle AdditionalProperties
+func (a CreateAccessRuleTarget_WithSelectable) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for RequestFieldInput. Returns the specified
 // element and whether it was found
-func (a AccessRuleTarget_With) Get(fieldName string) (value string, found bool) {
+func (a RequestFieldInput) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for AccessRuleTarget_With
-func (a *AccessRuleTarget_With) Set(fieldName string, value string) {
+// Setter for additional properties for RequestFieldInput
+func (a *RequestFieldInput) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for AccessRuleTarget_With to handle AdditionalProperties
-func (a *AccessRuleTarget_With) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for RequestFieldInput to handle AdditionalProperties
+func (a *RequestFieldInput) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -530,8 +1384,8 @@ func (a *AccessRuleTarget_With) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for AccessRuleTarget_With to handle AdditionalProperties
-func (a AccessRuleTarget_With) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for RequestFieldInput to handle AdditionalProperties
+func (a RequestFieldInput) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -544,25 +1398,25 @@ func (a AccessRuleTarget_With) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for CreateAccessRuleTarget_With. Returns the specified
+// Getter for additional properties for SelectedArguments. Returns the specified
 // element and whether it was found
-func (a CreateAccessRuleTarget_With) Get(fieldName string) (value string, found bool) {
+func (a SelectedArguments) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for CreateAccessRuleTarget_With
-func (a *CreateAccessRuleTarget_With) Set(fieldName string, value string) {
+// Setter for additional properties for SelectedArguments
+func (a *SelectedArguments) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for CreateAccessRuleTarget_With to handle AdditionalProperties
-func (a *CreateAccessRuleTarget_With) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for SelectedArguments to handle AdditionalProperties
+func (a *SelectedArguments) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -583,8 +1437,8 @@ func (a *CreateAccessRuleTarget_With) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for CreateAccessRuleTarget_With to handle AdditionalProperties
-func (a CreateAccessRuleTarget_With) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for SelectedArguments to handle AdditionalProperties
+func (a SelectedArguments) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -623,12 +1477,30 @@ type ServerInterface interface {
 	// Archive Access Rule
 	// (POST /api/v1/admin/access-rules/{ruleId}/archive)
 	AdminArchiveAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
+	// Preview the impact of archiving an Access Rule
+	// (GET /api/v1/admin/access-rules/{ruleId}/archive/impact)
+	AdminGetAccessRuleArchiveImpact(w http.ResponseWriter, r *http.Request, ruleId string)
+	// Diff Access Rule versions
+	// (GET /api/v1/admin/access-rules/{ruleId}/diff)
+	AdminDiffAccessRuleVersions(w http.ResponseWriter, r *http.Request, ruleId string, params AdminDiffAccessRuleVersionsParams)
 	// Get Access Rule version history
 	// (GET /api/v1/admin/access-rules/{ruleId}/versions)
 	AdminGetAccessRuleVersions(w http.ResponseWriter, r *http.Request, ruleId string)
 	// Get Access Rule Version
 	// (GET /api/v1/admin/access-rules/{ruleId}/versions/{version})
 	AdminGetAccessRuleVersion(w http.ResponseWriter, r *http.Request, ruleId string, version string)
+	// Roll back Access Rule
+	// (POST /api/v1/admin/access-rules/{ruleId}/versions/{version}/rollback)
+	AdminRollbackAccessRule(w http.ResponseWriter, r *http.Request, ruleId string, version string)
+	// List blackout periods
+	// (GET /api/v1/admin/blackouts)
+	AdminListBlackouts(w http.ResponseWriter, r *http.Request)
+	// Create a blackout period
+	// (POST /api/v1/admin/blackouts)
+	AdminCreateBlackout(w http.ResponseWriter, r *http.Request)
+	// Delete a blackout period
+	// (DELETE /api/v1/admin/blackouts/{blackoutId})
+	AdminDeleteBlackout(w http.ResponseWriter, r *http.Request, blackoutId string)
 	// List groups
 	// (GET /api/v1/admin/groups)
 	GetGroups(w http.ResponseWriter, r *http.Request)
@@ -656,6 +1528,21 @@ type ServerInterface interface {
 	// Returns a list of users
 	// (GET /api/v1/admin/users)
 	GetUsers(w http.ResponseWriter, r *http.Request)
+	// List owned Access Rules
+	// (GET /api/v1/owner/access-rules)
+	OwnerListAccessRules(w http.ResponseWriter, r *http.Request)
+	// Get owned Access Rule
+	// (GET /api/v1/owner/access-rules/{ruleId})
+	OwnerGetAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
+	// Update owned Access Rule
+	// (PUT /api/v1/owner/access-rules/{ruleId})
+	OwnerUpdateAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
+	// Archive owned Access Rule
+	// (POST /api/v1/owner/access-rules/{ruleId}/archive)
+	OwnerArchiveAccessRule(w http.ResponseWriter, r *http.Request, ruleId string)
+	// List requests for owned Access Rule
+	// (GET /api/v1/owner/access-rules/{ruleId}/requests)
+	OwnerListAccessRuleRequests(w http.ResponseWriter, r *http.Request, ruleId string, params OwnerListAccessRuleRequestsParams)
 	// List my requests
 	// (GET /api/v1/requests)
 	UserListRequests(w http.ResponseWriter, r *http.Request, params UserListRequestsParams)
@@ -665,6 +1552,12 @@ type ServerInterface interface {
 	// Your GET endpoint
 	// (GET /api/v1/requests/past)
 	UserListRequestsPast(w http.ResponseWriter, r *http.Request)
+	// Preview a request
+	// (POST /api/v1/requests/preview)
+	UserPreviewRequest(w http.ResponseWriter, r *http.Request)
+	// Review multiple requests
+	// (POST /api/v1/requests/review)
+	BulkReviewRequests(w http.ResponseWriter, r *http.Request)
 	// Your GET endpoint
 	// (GET /api/v1/requests/upcoming)
 	UserListRequestsUpcoming(w http.ResponseWriter, r *http.Request)
@@ -677,9 +1570,24 @@ type ServerInterface interface {
 	// Cancel a request
 	// (POST /api/v1/requests/{requestId}/cancel)
 	CancelRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// List request comments
+	// (GET /api/v1/requests/{requestId}/comments)
+	ListRequestComments(w http.ResponseWriter, r *http.Request, requestId string)
+	// Comment on a request
+	// (POST /api/v1/requests/{requestId}/comments)
+	AddRequestComment(w http.ResponseWriter, r *http.Request, requestId string)
 	// List request events
 	// (GET /api/v1/requests/{requestId}/events)
 	ListRequestEvents(w http.ResponseWriter, r *http.Request, requestId string)
+	// Extend an active request
+	// (POST /api/v1/requests/{requestId}/extend)
+	ExtendRequest(w http.ResponseWriter, r *http.Request, requestId string)
+	// Review a request extension
+	// (POST /api/v1/requests/{requestId}/extension/review)
+	ReviewRequestExtension(w http.ResponseWriter, r *http.Request, requestId string)
+	// Release an active request
+	// (POST /api/v1/requests/{requestId}/release)
+	ReleaseRequest(w http.ResponseWriter, r *http.Request, requestId string)
 	// Review a request
 	// (POST /api/v1/requests/{requestId}/review)
 	ReviewRequest(w http.ResponseWriter, r *http.Request, requestId string)
@@ -689,6 +1597,15 @@ type ServerInterface interface {
 	// Get details for the current user
 	// (GET /api/v1/users/me)
 	GetMe(w http.ResponseWriter, r *http.Request)
+	// Remove the current user's reviewer delegation
+	// (DELETE /api/v1/users/me/delegation)
+	DeleteMyDelegation(w http.ResponseWriter, r *http.Request)
+	// Get the current user's reviewer delegation
+	// (GET /api/v1/users/me/delegation)
+	GetMyDelegation(w http.ResponseWriter, r *http.Request)
+	// Set the current user's reviewer delegation
+	// (PUT /api/v1/users/me/delegation)
+	SetMyDelegation(w http.ResponseWriter, r *http.Request)
 	// Get a user
 	// (GET /api/v1/users/{userId})
 	GetUser(w http.ResponseWriter, r *http.Request, userId string)
@@ -894,6 +1811,86 @@ func (siw *ServerInterfaceWrapper) AdminArchiveAccessRule(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminGetAccessRuleArchiveImpact operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetAccessRuleArchiveImpact(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetAccessRuleArchiveImpact(w, r, ruleId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminDiffAccessRuleVersions operation middleware
+func (siw *ServerInterfaceWrapper) AdminDiffAccessRuleVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminDiffAccessRuleVersionsParams
+
+	// ------------- Required query parameter "from" -------------
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminDiffAccessRuleVersions(w, r, ruleId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // AdminGetAccessRuleVersions operation middleware
 func (siw *ServerInterfaceWrapper) AdminGetAccessRuleVersions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -955,6 +1952,97 @@ func (siw *ServerInterfaceWrapper) AdminGetAccessRuleVersion(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
+// AdminRollbackAccessRule operation middleware
+func (siw *ServerInterfaceWrapper) AdminRollbackAccessRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameter("simple", false, "version", chi.URLParam(r, "version"), &version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminRollbackAccessRule(w, r, ruleId, version)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminListBlackouts operation middleware
+func (siw *ServerInterfaceWrapper) AdminListBlackouts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListBlackouts(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminCreateBlackout operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateBlackout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminCreateBlackout(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminDeleteBlackout operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteBlackout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "blackoutId" -------------
+	var blackoutId string
+
+	err = runtime.BindStyledParameter("simple", false, "blackoutId", chi.URLParam(r, "blackoutId"), &blackoutId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "blackoutId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminDeleteBlackout(w, r, blackoutId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // GetGroups operation middleware
 func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1130,7 +2218,115 @@ func (siw *ServerInterfaceWrapper) AdminListRequests(w http.ResponseWriter, r *h
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListRequests(w, r, params)
+		siw.Handler.AdminListRequests(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AdminGetRequest operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetRequest(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsers(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// OwnerListAccessRules operation middleware
+func (siw *ServerInterfaceWrapper) OwnerListAccessRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OwnerListAccessRules(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// OwnerGetAccessRule operation middleware
+func (siw *ServerInterfaceWrapper) OwnerGetAccessRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OwnerGetAccessRule(w, r, ruleId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// OwnerUpdateAccessRule operation middleware
+func (siw *ServerInterfaceWrapper) OwnerUpdateAccessRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OwnerUpdateAccessRule(w, r, ruleId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1140,23 +2336,23 @@ func (siw *ServerInterfaceWrapper) AdminListRequests(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// AdminGetRequest operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetRequest(w http.ResponseWriter, r *http.Request) {
+// OwnerArchiveAccessRule operation middleware
+func (siw *ServerInterfaceWrapper) OwnerArchiveAccessRule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "requestId" -------------
-	var requestId string
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
 
-	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetRequest(w, r, requestId)
+		siw.Handler.OwnerArchiveAccessRule(w, r, ruleId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1166,12 +2362,37 @@ func (siw *ServerInterfaceWrapper) AdminGetRequest(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// GetUsers operation middleware
-func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
+// OwnerListAccessRuleRequests operation middleware
+func (siw *ServerInterfaceWrapper) OwnerListAccessRuleRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId string
+
+	err = runtime.BindStyledParameter("simple", false, "ruleId", chi.URLParam(r, "ruleId"), &ruleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OwnerListAccessRuleRequestsParams
+
+	// ------------- Optional query parameter "nextToken" -------------
+	if paramValue := r.URL.Query().Get("nextToken"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "nextToken", r.URL.Query(), &params.NextToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextToken", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsers(w, r)
+		siw.Handler.OwnerListAccessRuleRequests(w, r, ruleId, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1264,6 +2485,36 @@ func (siw *ServerInterfaceWrapper) UserListRequestsPast(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
+// UserPreviewRequest operation middleware
+func (siw *ServerInterfaceWrapper) UserPreviewRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserPreviewRequest(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// BulkReviewRequests operation middleware
+func (siw *ServerInterfaceWrapper) BulkReviewRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkReviewRequests(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // UserListRequestsUpcoming operation middleware
 func (siw *ServerInterfaceWrapper) UserListRequestsUpcoming(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1357,6 +2608,58 @@ func (siw *ServerInterfaceWrapper) CancelRequest(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
+// ListRequestComments operation middleware
+func (siw *ServerInterfaceWrapper) ListRequestComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRequestComments(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddRequestComment operation middleware
+func (siw *ServerInterfaceWrapper) AddRequestComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddRequestComment(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // ListRequestEvents operation middleware
 func (siw *ServerInterfaceWrapper) ListRequestEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1383,6 +2686,84 @@ func (siw *ServerInterfaceWrapper) ListRequestEvents(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
+// ExtendRequest operation middleware
+func (siw *ServerInterfaceWrapper) ExtendRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExtendRequest(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ReviewRequestExtension operation middleware
+func (siw *ServerInterfaceWrapper) ReviewRequestExtension(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReviewRequestExtension(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ReleaseRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReleaseRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId string
+
+	err = runtime.BindStyledParameter("simple", false, "requestId", chi.URLParam(r, "requestId"), &requestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReleaseRequest(w, r, requestId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // ReviewRequest operation middleware
 func (siw *ServerInterfaceWrapper) ReviewRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1450,6 +2831,51 @@ func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request)
 	handler(w, r.WithContext(ctx))
 }
 
+// DeleteMyDelegation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMyDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMyDelegation(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetMyDelegation operation middleware
+func (siw *ServerInterfaceWrapper) GetMyDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyDelegation(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SetMyDelegation operation middleware
+func (siw *ServerInterfaceWrapper) SetMyDelegation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetMyDelegation(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // GetUser operation middleware
 func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1613,12 +3039,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}/archive", wrapper.AdminArchiveAccessRule)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}/archive/impact", wrapper.AdminGetAccessRuleArchiveImpact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}/diff", wrapper.AdminDiffAccessRuleVersions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}/versions", wrapper.AdminGetAccessRuleVersions)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}/versions/{version}", wrapper.AdminGetAccessRuleVersion)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/access-rules/{ruleId}/versions/{version}/rollback", wrapper.AdminRollbackAccessRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/blackouts", wrapper.AdminListBlackouts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/blackouts", wrapper.AdminCreateBlackout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/admin/blackouts/{blackoutId}", wrapper.AdminDeleteBlackout)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/groups", wrapper.GetGroups)
 	})
@@ -1646,6 +3090,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin/users", wrapper.GetUsers)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/owner/access-rules", wrapper.OwnerListAccessRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/owner/access-rules/{ruleId}", wrapper.OwnerGetAccessRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/owner/access-rules/{ruleId}", wrapper.OwnerUpdateAccessRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/owner/access-rules/{ruleId}/archive", wrapper.OwnerArchiveAccessRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/owner/access-rules/{ruleId}/requests", wrapper.OwnerListAccessRuleRequests)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests", wrapper.UserListRequests)
 	})
@@ -1655,6 +3114,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests/past", wrapper.UserListRequestsPast)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/preview", wrapper.UserPreviewRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/review", wrapper.BulkReviewRequests)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests/upcoming", wrapper.UserListRequestsUpcoming)
 	})
@@ -1667,9 +3132,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/cancel", wrapper.CancelRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/requests/{requestId}/comments", wrapper.ListRequestComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/comments", wrapper.AddRequestComment)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/requests/{requestId}/events", wrapper.ListRequestEvents)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/extend", wrapper.ExtendRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/extension/review", wrapper.ReviewRequestExtension)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/release", wrapper.ReleaseRequest)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/requests/{requestId}/review", wrapper.ReviewRequest)
 	})
@@ -1679,6 +3159,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/users/me", wrapper.GetMe)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/users/me/delegation", wrapper.DeleteMyDelegation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/users/me/delegation", wrapper.GetMyDelegation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/users/me/delegation", wrapper.SetMyDelegation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/users/{userId}", wrapper.GetUser)
 	})
@@ -1689,87 +3178,197 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9e2/bOJ5fhdAdMHeAaztpptsGONxlkrTrmT6yqTuzd9vBgZZoi1uJVEkqiSfwd1/w",
-	"KUqibPmRSXa2f7Wx+fjx936Rvo9imheUICJ4dHofMfS1RFz8QBOM1AfnDEGBzuIYcX5dZuhaD5BfxZQI",
-	"RNR/YVFkOIYCUzL6O6dEfsbjFOVQ/q9gtEBMmBVhUTB6AzP5/39naB6dRv82qqAY6Xl8dKbGIXZOyRwv",
-	"otUgShCPGS7kLnIyuoN5kaHoNDpLckwAVEACQcGHLwJGg0gsC/ktFwwTtcCC0bJQQNSWiqYpAuo7MLng",
-	"QKRQAJEiuyArMwTUCZFcfRgNIixQrtZpbWE+gIzBpfybwBzVgZXAASghDoEoIFsgsQk3TapM9Sw5H+fo",
-	"nBIuGMSGpusWmjaGr1YDxQOYoSQ6/ZvF2KCimjlSnRoO7jYAv7pD0tnfUSyi1Upuok9guOkATOVQMUmC",
-	"dGEImiXaKMe5/N8GTBkYp3pwE0+1/d2Sa8/+iSO2/8FRDrESpTllORTRqfkkwFqYKznxcDCjNEOQ+Hza",
-	"mNU4pl3bsIBdseOc1+gGo9v9zxjTPDfTWodKUIy5UQjrySdhubCjV4NI6haGEzQ9APkdFCFMNBRXdEYA",
-	"NLrtOw6YAgzQOYAEaIkGZrPhZzL11JD+EGjeAjEkYIaAPQUBsyXAJM7KRH5rP7ajMVEaza4xo8ly+JlM",
-	"5gALgDmgORYCJQM1iDK8wARmzR1vcZbJLUuOkqHE4Kcieaq2YY3q3153hzTGXkp2EJUKde8Q53DRQ+6a",
-	"Gw76KuagZKrFeUEJ11g/Y4sPaji/Nh/vQcIUcrNY287+kiKRIgYgWQKqB4EU3iAwQ4gAXi4WiAuUgDll",
-	"2gCzRSklf1ipM09l0a5tpNBUi5lhNbNtqDUcadlKIUkyxEa0QAQWeLjMsyAh9cHarNKgloeCCso+msHM",
-	"UueHBLxhkAgPCatBdFaKVBuOvQnlmYMwlSQFSo6YVBCQaJcFSxYUlEl9pcCTqiBEHDlxk1zIg7SQpyau",
-	"Ny1NtF0gAXHGAZzR0nhupUgRERIVKFGHkDBdMkYPgTkk1+lhLNWwnhZBDQYMiZIRKQGM5uokHLEbHCNF",
-	"/LeYi0rbWh14CKEl6E7NIWWWwZl0UwUrUcCHkKjcSosGqCulQm3YCzUgw1xIdtOsmHBwm1Jl/awRVczp",
-	"uerGbLUxxjWjHABflbtXR8Zam+XmaDCCFqcfHTqdz61Qe6lDEmBtQQBhj46qR0dSxX/WN5MrOHF8o8zw",
-	"AdAU8E/WYUjtezjkOG9ib+YxPuDljQT3ELr2xqYmeuHF3/5w6DFAHA49v6vONvpwayRuVOZu4QMg5kBO",
-	"zR6GbLOncmjbdgVlnCU9FN/GqfjAhs57I4RVMVkvkq96wG3BUn6qjl9lfAit5R2qZczSKsCo9HnL2/TU",
-	"KpBnhJhwgIlOZ2BKrDOMiHbkgKAgh19QtZ0eoZaRvmj9/HvnDHFSn8fK7P+PX94eX6KZOP7LS/L6Lz8e",
-	"Jz/Bo9fTy1d/Hf/YWmIQ3T1b0Gc6lowmFzoTc14yVk9pBHIxh80ZPkS2cBBJB9Tgtmk4S4K/lgiYEQAn",
-	"0iOfY8RcfOfRfghUNsHwkWIGlSXjAAKCbu0qQ/CZ/JIiYgdhDnQonQwAFt9xMLkADOWKiWJKOOZSaIaf",
-	"A3hrSC9Oouo02yY5fZJKycdC81jF9y2xGkQth7BDNqoRlYAk6m+U1CRFxz8GM5AkCjtcDfKjN3yDACxw",
-	"QFj+tVLzjyDZORIwgQL2l9V3dsYOeoELKMotnO2Pevw3jfIgGsVQY9C/puK4ZR/NY3TLWv3zzmPLRupd",
-	"oSw5CyTfDfubDyVcQ0lNubKZ9cMyKIebcp92hNnVFTbkFl1CGILCrBKEokGq6pg+8D4g/nJBPL/ziNWN",
-	"6Y9OJAMpS/VdoxIgGTkaRIiUuQT07Hw6+fkyGkRn1+d/nvx8eREG5qPltRZqWzIbEDPNbNbx8lRty2BI",
-	"/sXJ5kzflR23GkS3WKTK2CQJllvC7Kq2Zpfedv5onXQOBLNyEB9TJz4t0hgZfIdESpM2Ni7UXzPEwa1N",
-	"Xju/M4VcZ65NJioBsBRUGuMYZtkSUKbzeNAWeXxCfpp+eHc2nZxHg+j68ufJ5S8NWtbhCrE9F7TI8CJV",
-	"NJTGLHrx8lWeiZfw6x25O1GYaljqNrFtDi1WAzxXWhGctyi+Sx3FxVptnlcevbTsdK7tuk1qOo3uYorB",
-	"vjlHA3oLyQ47AfboKLQfRGg6KtUPJB+qLr2LhKg0f/DEKC8og2wJIOd4QXJEVBALXZwGQcEwiXEBs7az",
-	"iUgS5gkZ6UlFbnliIQFQStD5Pcfj4+Nn4xfPjp5Px89Pn786fT4evjo++r9oUJkLqa2fbWszfIXWhmxy",
-	"YWGy4+QpF7pMY13cOqRU+7s5Jm8RWUjKHoU9NSY6rQITj4YPvsZexdrtUHYrAJxRdFeX7y8m799Ik2Vt",
-	"1+X19Ydrrfc+/HR5IT/569Xk2ijAFm5KzYphXsllWASThEnkGxgs+wUI0+qSWEuYhig5D86CNPCtj6bh",
-	"QPG1J2JafIJyRcui7XFtKmTjsNbo178RdDNxA14JVgDeSVJU3oszY9YNceT1lqpm9DNf8Pk8YUd/WsTp",
-	"+AQq2K86xdF+A1p6pQNB+oP7Pj67GuKd46oich0r8hhGCHWe65eP7jDupCY+dH9bW3Wroh0VD/edo5SJ",
-	"aa1xab2mWnZdG9SyPqc5EqkMhHKYIDBb1vJpmPiV3K7iTc8EYr1yA1vO1easghutkgLG9KwvhUCd6w8R",
-	"PhSjr2kFM7jbO/Qw6wSrxH3jcoNRLyjfpVHpMMFUSEqqM3qxrYGxjsmBz0c+QJ6MWYYOKJ42a7V7GcIy",
-	"76UStgjV21Ctz+OZQZ1JvEeSyMOKYgyJrkm0DyhYiQCe+966PJlrHTHRD2LaPmPu+/TtHNk3of9jC73P",
-	"S1spAF3UDTQYdKG8lrzamawyhFfs9vHxfWEJy8fdGElOne7GTOocOhJOwnltNeI1xFnJ0HW3pOFknQCu",
-	"3cGM6YiZBX0qFBJ0R/oIeohGZF9IVcRfyUBb1rRAhZzatm9+d/T9b99/jTPEk6+vIs8F3Tqj6Xqb/WzY",
-	"1dX1Bx1GVBQ4P3t/fvn2rfr04vL87eR9PUVWByBAizqq2pFWyVTd7COKKUn83AomAi10tlJFdUoVtE6I",
-	"OX35YnykYnMuYF5Iw/1peq4++I0S5Mebe6neJqRtJEytCu5DyxNKl1+z+cu7Gfx+FlWd+hdeL33Th7Ed",
-	"7tphoSRA0TA9w5SrbRcg3bRdRmqwF86RSVoa5NjUWyNzXqd5Du8u2mRvc24O73Be5sBiXpKW6wl+/VG6",
-	"N1lGb3XP8lDnE+TE6PTFeNBipwZZA8B4SJq2Kj0tw/jJNNl2XAlpmw/MuHjf1dXeoZ0zuGZOgWNRMrSH",
-	"x1MlCR7QbbHZngoBFeieI+OO2hGkfOI9kgDnKcM+HaJYfvA/6E6fPIMzPsRU513aIb+aDd7LoxMPyNMo",
-	"FaLgp6MRvIECMj5cYJGWM+lkm86gYUzzUTk6Ojk+Ojkej//75r9OJEp/pDz1oXEbrs847LDxn06Ox89f",
-	"vNIb62sGmMypbV6COodnz0nznBLwGgqFbZZ5O8XquzkUSCKq1X5kYiNggxQOzq4mUbt0wr2Y7jQ6Go71",
-	"pQHV4h+dRs+H4+FYnhSKVNFrBAs8ujkydwKeMduyGkz4v0FCin6tWAIg9+O4oer+R1rApePiGt3Oar2o",
-	"tbsYx+Nxl8S4caOuLl3VdlXmOWRLs1uta1XVkRdcSsUlSYDi5l/lnNDJR/dM3WZbrUVBYlrvA7r3M/lM",
-	"Lg0qOIBMWo1sCeAsQzISVgUxHzoTQi7VUAhylM9syGhbQFSEqVu8M1XJF3QAKKvNTBDHC91Vp8nh2sSD",
-	"PQITV69IKOLkOwFyhFSPCVf2Rddg+ABA8Ofp9OpkfARKAkuRUoZ/Q4np21cxrW7db1Nd4vkNqicRQjTv",
-	"3eHXN+oPXDD5ScrAyfhoM4/VL0uoWSdbz6rxo+QXD/dhbpTyyGCOhCoZ/u0+whJuKaOVkmL2mmWl53WX",
-	"Z4WiZrrn101cPnKVx7X83q5R1ot9Q/CZTFPHDhzA+v2FyQX/JhidguGutBxAK7avxzwe5zc1ccVCjycE",
-	"SY5JP1OnoG/auhYxVaNfwzBFrYPUV36NM4FYndlnSwBBAZnAcZlBZmJIVf6XU76WiC19f8WW5Nyp17fK",
-	"NFFyAPPbuFXU3wibG26S3DRUy9EJkXY7QQDxzT6FKgvwA02W3UfyXnsYdT31sGrh6OgBzJW9FNU2WjYv",
-	"pCRxvJP8Hu0nv4YQYeNlqbhWuPp5U+3oNUDqR3AlumnzRB0KT7IeRJEOoqIM0FBfiOdNOvZsRA2Tu3nJ",
-	"fhfJ7rqov3oi3DNuo/IHmAAPTMNhDXR7/obHUPVB76kAr2lJ1IjvQ1tNiECMwAx8REy6Q4rlGqymMXgQ",
-	"DTCCLE7xja5iPhR3Bu3JO8i+8OZtWekLaoCS4WdyRpagQES9IWGvdxn/EPPaPPsaRAxJjLIs5N8pvJzp",
-	"xf91VZbjut0VncFhjf36cpvRLt3u3bWLVGxffYq5oGypwxvfF9vSOP1st34AJ+tAKmGdPWni43e0L1vS",
-	"dnRv/rfqQWVeoBjPceyOF86b9yTuNwfEY5gKJ78TowyCC914pNmd5apO7y5/VRU/gLvOUueYN8hcjt9Z",
-	"+ht36wNxldt6oyXWI0f36t91briVk9YNVL3ZsPOcDykI5rZ/mPtbHKlGA/Moyo68aPC0JwvZ7tgNuYVq",
-	"WChhfuV9uxeGe9309m+qNG4VHFT37O0TNDC36kb+6L66A7A+/nRd7bMlUHWzFqt7rbAPxu0VCZ44yvsI",
-	"Uu36xUFkqUbOEWSLbulaSB0tUgQkAfRmQI+YSQffPLHlOfheO3sn6c/kjnuSf/NLWI9J55ooQLYABvAn",
-	"Q/DRPWQL+Yf3BJphgG79Wb3ytpNFDjwS90QkUZHIYuIhaRT2thQp9qS1/0TLGrfEqyZJz8t7f6UjF39d",
-	"jVibiKc5FkYDyGGuDKV34WWmbxpvmYAPNAHVu7psr1cgMT9ogohIzJaFQAkQ9Asi9ikEqccK/Y6KbtOZ",
-	"0w5ICboTUzk12pA82c1bbb3sU+fY/6UlA28upwCRpKBY991t8lotgUf3rqWvR4RHGi+GdkdzVc/tgxnz",
-	"enP8Gk1/8lia3t3n3aMW5zVc7qMG3EXZIIFfIxGnjYJyMO76xPcpodZeYqqj6zpY1N4QgG3UbUqZ21Hm",
-	"zVt3ZUAJsdFKqtWOgyUtpYKaK3S4JOVtiuNUfRdDYuaHS85/MMXIU3pbocG9kBK4fjGnbAAYNI97KiwF",
-	"Z6WQ6zZPkaKco+wGdR3SLh1Sqa55+w+nyxXD5kvf/oYVR0cWvvZ4VeBSkOoQUUIM8pILk7FbNpJ04DZF",
-	"BOTwS/3tLfDJtZV43RjtR7P81hBMbH6j4gR/J4bmiCESIz4EHyT73GKObOcHOBmfuOfcXFlgfddH7fX3",
-	"3WvVjefjOwrVHdXkUIV3gy0IaLVRATWRg6otwbzI4BIoGXWVkgFAd4W0EQP1ShNDN/QLSnwVuFFvXcFO",
-	"s/0Arkrw4GURU9tYv/bwrXqSPLR7MqOugyQ3mpsa2RLAWD1WRZmKwJIy05w8QwtMlLjoe/GYgHkpSoY2",
-	"6/tPFujHxd1W/pzrr3JChuc1FU+o8C/fUQYo8/S+UidKoYDN7qXtDas99mytXjMp6mgnIbA3GYV32UZB",
-	"+B+ECnQKjGMUNFG2pbe27X92dox981ufit8aYiFbrMKEC1bGYm31UZ3EXKbwxpucO3OXhT6TUGaeEiA1",
-	"a2VDlRjQTOkMhjgtWYyCOXtt3iY+iIfhpm3flA8A0jfRb0x04xBPixe00evZ67AbCF2OlnaEZDSggWhH",
-	"xk4VLfXPEJjLcjKWyJD5aQzzyxnGI9fXB9scda52OJBe6p0dHf/ztDCcGxJs72D53FS9Bv3gJjNYi6q9",
-	"br2vD9F4I/uRs6lWJNxb109LjzD3+MDvrkf0bcWA9nBvN+jrPqBKXLj3D1T8NkOtdvtWvBZDInnSPBQn",
-	"UoQZoLdViD5QhKlfBdjQwt/i4PrvMe0QddUXWO3C/42HrZv5JY3p3ZUE1qxCv6CtWAUfiFVU+qsW4BgL",
-	"pGGyDFRIMtOSyxDHxEFDcDmfIx3v4DxHCYYCZUsQIiL9gtZbmn96a3Ft0EVsCNiXIVQ+cqQvq/bu76h+",
-	"N6YKPTO6WOiXk8NX+d4g8Q7tVkhr/oRPv162lvfnX71rxlw98XQv/+kXg9oIrRMb5m7sgwVk5seC1leK",
-	"D9wMCNcgs48t1Ojd0hBKKFQjsl62uhp7OhplNIZZSrk4fTl+OY6kIjKguYu1DsTVwH2ms/KrX1f/CAAA",
-	"//9VjVIy73MAAA==",
+	"H4sIAAAAAAAC/+x9a3MbN5boX8HlnaqZ1G1TsuNkE1VN7aUl2dFM/BhJTnY39s2CbJDEqAkwAFoSx1f/",
+	"fQsHjwa60c3mw5Jm4i8zjojG4+DgvB+fBhO+WHJGmJKDo08DQX4riVQveE4J/GGU5+fmb8d8sSBM2f/S",
+	"v004U4TBP/FyWdAJVpSzg79LzvTf5GROFlj/ayn4kghlpxzzfKX/PydyIuhSfzM4GlzOCVLkViE+RWpO",
+	"0MQsNxxkgwW+/ZGwmZoPjp4dPv8uGywoc394mg3UakkGRwOpBGWzwd1dBqegguSDo1/Mah/9KD7+O5mo",
+	"wd2dHveiLK7OyTUlN7ufyu5X/7O2oWyQkwmV1Hz/B0Gmg6PB/z6oAH9g5pQHZi8nbrQ9CZHqLIc1qCIL",
+	"mVxhgW/PzI/fHAJ87H9V4MFC4FUDOsH8wTZT4Mpq9zVCAnaL3FfoZk4nc0QlArCRHCmOCJ7M3Y3ateRQ",
+	"b/hYEKzIaDIhUp6XBdn9AnCeUz0QF5dYzIjB53jPL0uh5kQgZQboDc4EZgopPiPwyw1Vc9isGZKhKRdI",
+	"lAWR9nhmPEbjkuUF0UfDcIYhGtnfqEQTOFwOHwMEzGzoZk4Ywg4QFlSCX5N8iC79ohJmhVXRBLM/KjQm",
+	"aDLHbEZyxNmEIBquol+IR4wu7KqD3IBpcOcv26JINsD5gkp9p+94QSerdROPasP1DHAuXKz9FMYRcczZ",
+	"lAImjwXBV68KLBP3NyoKfoNKSQTcXSkJguFPZnq8vQmAuppTCRAconOD7tLgoAaq4miOr4kDvpAZuplz",
+	"h8/hjA5lEZ4qIm6wyOVw4OE15rwgmA3qb+PTgNzixbLQYzRsmNuZ4ujtlcKDrPmAp5QUeerIHquRGWLx",
+	"0ABhUUqFprQoEGUGu+yOKZs5xOyLHvYFvtSrpJBiJni5lGnCDb+hsxMNZKwA0vbIAHBDEPT5o900gFBf",
+	"kuEFicGpwYc0erIUEPkNI2LtQasX8NaM1yubt7D9C6ILcsyZVAJTy0q7JrqsDb/LBqXEM/IjXdD1X78P",
+	"htYJur2l4AVaMMY46k/c3HoLrzRnf1HgyRUv9yAFEJbr/5tyscBqcDTIsSJP9F5SFysIttN1sv5sIBUW",
+	"qu+8NdCZbzPYmV+yExoWCnvgXR6lzvLky+iiiu+TZHCIDJ5qTgGMieSILhYkp1iRYoUwy0OurIcZAkjy",
+	"kNoBX7Iz6f0ZkoOBECcWTRLHirb1pT9nbFnCu+LsBZnjYvp2miY8ZydOutAEURNYd56KGQzRW1asDNWg",
+	"Gs0VF1Jz1vpYztAYVgMGzDhIBHrezEMLVgEQjEnB2UyvyBlxmzCvz/1XALYhOpsivqBKkTxz61HPYKys",
+	"oD+alEIQpmClYfdbaPyk6EL/qx+gL83gu2yghZ51H12QgkwUyUdiVmo5t0l5Ihz2e+l8P+8lEXugJAtM",
+	"i+jNm78kYEcl8OMAeAGaOm7TTSXc3Jaouhlbznl6qwjL90Yn8lLAwAsy4SxvYcZ4wUtm9Ci6AGkH5yCM",
+	"GwzFVqmijC7KRUhGKVNkRkQnktWgUd9QCxget5KlZUBBc3K5zfOpA2QjHYp5GfSPjvwa2uNph1ls+IFd",
+	"BjKV+SMyTwwo2ZggdwqGxitE2aQoc/2r+7MbTVlE9rV6PPzAzqZWqfA0Sg/igs6oFj1rK95oiXMM1DAH",
+	"be6CqBNSkBnc4h7Q3MxFetF8LbrDhiz8vMzOGVrxUlianqSlGwkhu8gW/kRZJGa0PJf3y3zvyvEXjW4n",
+	"je6fWmHbXOPap2q1u2oEz+E1kVrvSe5uT8pTfatZX3Uq+Y5hcrnkTFpTqpi9heHy3P55hwc9x9JO1kTB",
+	"n+fGmIXZCnEzyDyPMSEMyXI2i0VObGW69EPgbctoWlxNZoelsHd4YDB7jrXNTBzwJWF4SYerRZG8InOw",
+	"JnrWbisAQbXLPmzXfgXnxwy9MiY9D4S7bDAq1dwIpztfVCBypm/JMzIq9W4iNUUzuldGeUtfjv5wPcYT",
+	"0QAefNgtvtbBdkIUpoVEeMxLa+Mp1ZwwpUFBcjjEoGZV3xl8gsiyUDEV6zpstHhZqLVY5BboA4FLEJz0",
+	"eH0xhrtowg02Xq9IMzAPF1d2ACDUqRB8H9hE9Dw9lBQY1lMEhcFIEFUKpqmC4Au4XUnENZ0Q2P+PVKqK",
+	"uDsevw9CxsgtfMPKosDjggyOlChJlqLxRMR4sIabJTBeDjKzYC/QoIJKuGnzPIGVcxC3nZwBDzYwdFoc",
+	"aEJMmsezB3hVanb/R1Htw2wjyfn73UOr0r8RaE+NQRc5/pgA2IOD6sGBVOFfYEiS/jk6U+w+IDV2c/Wn",
+	"s/aLtc+umnqzM7vv0JIIyvPq3K9AJNvDoRPycdeJYd39IYWXLHd+NLF/fh+QsWaW/rCxa+8POn4HG8Dn",
+	"sooaAOXfO1qHNUidXu8JTuR6IyiFy+8PVHYT+0Oke+XqTh/fFIg95Do78R4AsydVYAdRZ718v2/p5x3W",
+	"pj9F8kgKAn3cXsE7sb2Q3+OG7fSpvf2sncw3vCxyNMfLJWGIRiEm6AZLtMC5kV33qIt4M1wvDL3rAWa3",
+	"LRNo4jWKkHbdZXZHxopQCShN21Pgp9NnxJRJRJmxV1LOnMZLWO79ZQt8RarlzAhvh9o5wsbYvrAg3ge5",
+	"h1Cbvgay+wl16TJvOh3fGP+05tDHwpmFAPBD6s5bEVlB7fUpru3yKUfu7zVshebxyURZ/Prsu5tnp2Ss",
+	"nv3tO/byb395lv8VP315efr9fxz+pXHIbHD7ZMafGMPo4OwE5pTHxkvb7UjsG7bSL/jkcYWdZINrIpy7",
+	"rS7Dl4z+VhJkRyDtlFJ0SonwJsfINQ7uDkv1gHSBc1gijBi5cbMM0Qf2s42f04OoRMYunGeIqj9K7RYS",
+	"2jnLpKZ9kkqlrXkf2FrfDM0H1Wk2jZYJkUGzVarM+6modIMJZMGvIzGZ02tytljiiUqbWL3HoqKleDoF",
+	"Z7z29GGYAaDHIrA2yfdE0WsC5sQ2z7GNRgzWpMrToWB9mAlxgTRu5GVhgj3BswURIysYJsg1vyK5Z872",
+	"2syOSW4mN4PMrjZ99Kn3viRM+z3PA7myeVA7KAXbCWYTUugTAWFKbXz3bdYwsL7nLL6rJF7FmNOJZNbo",
+	"0yIuVCMqmSGH/yZ5JDwYu699fpoD6ScoYVBotabgh6Nf5IcHdax+kTx+jwGzDyDsLIjCOVa4/2N67b64",
+	"1whfqbAqN/jywoz/Ip49MvHM3mPWP9LZY+guYpzlod18lk5bwlRN9oaxSUBAXiSqoTFRN4QwpG64ZlNU",
+	"SQfWBBe1c23h0QBKdQyfp4iHdvz9VGFN01TXHp6sePuHtesULkA0XC6cIfMnTF+EBnLnNYTHTGC/mR1u",
+	"AUnKZgUxjKIWeKdR/wP7wF6GTAQLAuYwCPE9O5EZkqX+s6wij4BDGtzMTNSFflBwU3aSGyKIDskkueWm",
+	"C5MB9BYYtmVa7ksq0DUuSqL5FbiZLWJkVbqVDdlzYrY5DJU6cUgSlRTDSL5ZWBDM2SJKY+UzvMzSZmM2",
+	"YUkvXxF38/6GWnQbAowAE5q8XfBFGgUNrDbbvOLrsdIcMIlwITp14t3rgBPWnqzJ1BolAlctx7V/1GRp",
+	"qNmAntl+9WKVBgXXSsoLPLm65M2bOZsaoc7ReG0QtfNppVF/rCm6127GeHJlYj4JFgUlovrSoJlCE76k",
+	"RCbDKHsEaC3zCgI+fjIZOtkBETtLEiJ1B5IHeQjIcCPhdMmLfx3wjfZbf+slkubbMDJnRRB8/ADJqYK/",
+	"W5WyzhD0TxC6qFmBU1IzUHm0DjYmwI6jhIbmO98m/m9fQRZ27SRcLcQ6oXrhpbUmVI0EkCDXg2xAmA5p",
+	"/2UwOr48++l0kA1G58c/nP10epLeyoUTJhqnbIhzCU5iszqtUT2Q3xtXoXkDzdeHar1z44KsjEprfhfN",
+	"2XYxFTj1DCZvw3ib2udan/mhZ3C5H4Om297+4licRVnIs5lzLgnClo1pYDnDyqqWgJOhK7JyVi0zITo7",
+	"GTYxpW4+cVCzQEte9aUX/RpY11ThGzFSJrwQF+j49EdEbpeCyIA65mRCc7A7GKXbm5WcPG2UOkFM4pRm",
+	"9mfKK+V6wDUWVINYVqBDf6J5hiDdJLP046vM/Yz+VMu8yIz1TVPKDJnsjczKEl9lZn2YT0vIGXIQMyTl",
+	"K0OKwOR07olNoNxznZ7gbGYwtmkoBEBoqUXLHYRp6QYWNPJeZl9tpn+q0qncPuAPeitVIhVmyiQ743oa",
+	"21cAPdDkiUYqrKw0p7VSLRcRQdAUF5KgnDAa2ykyxIUZvcBLsySuUtj51NxQpr9cIe+SMzvGAR4sDLND",
+	"cs5vmEuu8eCKpZ4YskNyS6WSfxIZEkMDHfS//mxC22mOPpSHh8++Nf+LxNBD6M92iPvDV+jf0acPPt/k",
+	"w+AI6f9iqw+DDH0Y2P2ZP694iXAhCM5XNk7ewFTNBS9nc5/rBmjiDUJupQ+DO3TUXAsA9WFwNwheW+0l",
+	"peiqeQA/U5bzmxRRFWRSCmGyTPQYn8dE3Wuzm7dpLxYpKkl8XErK9IA5L1NsMcer/srTz4Rc5YY1dhRV",
+	"gGQOEFKSDAu2z6coxytAE3swAs+WMvTsOewV/fDD0evXyEhHQ+RTcsZkyoUhE/DGYb6sPhPixlg95RrC",
+	"Gn45XsV4+PS7o8NDDQ+sFBF6d//vT78cPv34y+GT7z/+/2e/HD75+uNXR78cPvnG/OkPrVkwG58Vvuo4",
+	"bbTPw33sU2/kH5y15RCN3oyQGxJulEqUkyllYNaOtzUqpRK4oPjghaByjNn6tB+/icygXQi+CmlCfhU9",
+	"jxSzssaOY84MK08ISdVv7sV4tgFUc0ECqcXOh5bwZIE0LpfFaohGRVHVYqlPKAiSRLlMWLQgShfAsHM4",
+	"MTX4rDJoInJNxKoKb6g/zwW+PemT5VhlzjpO6HeDFVpwqQwVW+gsCGlmivIevz1MJz5aEv6qw3rrB9VT",
+	"gbFCBcFSBTnB0mUFb2LJvQswonnhHWjxmqg5T2jqJ/Bf40BGqXBirokmIazi6rhUXDt9JrgogAtCTDh2",
+	"+VShnP3+8u3r0eXZ8SAbnJ/+dHb68+nJIBu8OD8d/fXXVz+OLi4i7I53mdL8pOLLgs7mIHBrc/bg2+++",
+	"XxTqO/zbLbt9DqBx07TKaxXmoTGWJHeICXIasJaUoFbXwQTpgAgjJLfwSHta1yeixscYTVzSyyR62n1m",
+	"CHCjR+Za3Ukg51yoJ0G8XEB3f8FP/jF68l+HT77/9cnHT0+zb5/f/aGfxdbCIDpOAhXqkkITpSPwHH2K",
+	"UO/X0bt3529B0Ts//dv7s/PTXw0Wti81cvtqiiZ23IXCacuhVGRp0jukBhZTFBcVAZ3MMWUJROpSzLsc",
+	"QzdzLglakMUYHFd4ZZcihq5JvcvNvEPujtxBW7bASr0kMHEqFWUTZVc2HnON8tL5AMHvrzGzICraGDoh",
+	"U6wTa/SAp9o2ac2U63PPvQ0ibVAB4FjGVFldvdS6MVz6WzAi/GjFWO+hbWKQ/V2TpymdBbF2xm+9FwsO",
+	"kDqfRx2lvYmc6BuLWb626UGRjykVUnkWDsgXMPAFVpEgUVd5aVTOKk0vXYo7yLaMu7VgaiKzihoHxDUK",
+	"HpmHhvb+bvyY1NzXw7Bie0N93eVpAHL3uVozEABt1bg8M/4D+KUSldyF2d2aU1QPiiAdMGw/woIgxsFV",
+	"aAuWwWNx1okwNqACpXNouBXJYqlWxgRkNgnTmkoCG16oeYhdVszNKQgJJdPPREI8hUjREGMQDnP/IY0x",
+	"eRgfSVW3Hetz8FJN+IJURei8sS0ZPUZTnqIo1njTDDO7gQ08leY4b813a8GMwxg7v1gI7RZYtoPdLZ0I",
+	"p8HKxpobnofXgLNPMF4szxyP3hyf/virlmNOLy6NPP32r6e/vjofvbkMQvYrJFxUDpf6bleNcHh5RZdL",
+	"koM0D7FdLcWFbD3IFvdbGhcv/nr27t3pST0Knwtrx9PruwKG4xWSfEE4I4gUkmiFsiAVib8BZQQgGETb",
+	"ORhdvD8+Pj09AR3DLjrIBi9HZz9aC3+369kfLhBP7ZmaaONwIYEtPvktISSadLXAMWxPjqaCkH+QTKur",
+	"RgWJbVmmzKOLcjNi5CoUD5o41O0g3Ki2CW278NZaU7tUQwEFIVluLXTWBVfiAZ66jHrGd4vK3kzb9v7/",
+	"9tTtrC3/OrWCPkH0ACaQosK4CkPjbDkySVT1XvQvPZ7lBvGmXQ+59VmEEK9DNQH546oaU1MBh58gDY/V",
+	"6hY1oeoqAjfOHTnOd8TkLsLmyij0KjZkgnjiEsU98D2kPrBeZs4dnjK4AAfcFNzTZSj34qFsAdAXZ6R1",
+	"RqIRq/7bk23K0JirufUnWV9Wtdf+LkzAjm2cmFX5rRQe5P5XU1u4lEHFMWki0qpyhxVPNnH8+MZ4EnYs",
+	"0hVqSK5AV1Vv0Q295zpdm719SfwpNGAcCPL1JMC++I46YPayg4tMXDPUE9Qetp4BEh6pifswFKfenb45",
+	"OXvzapANrB1NS1Mnp8c/nr2JIybqyybACGFSp2n2OIoiwwyf00+Q5ljZLfUrcxJEovWJ58qCQij2JME2",
+	"E+CFhIskISWLJRdYrBCWks6YYW7uKRmFYCkom9AlLhLnYS3Rc4Tl3mUWlWeszLPPDp89e3L47ZOnX18e",
+	"fn309fdHXx8Ov3/29L8GWR8E74imCoNSupDfjavKpfvo/Hin3ITq9y3Rm0Rc6958CHjIjiflqrLqMYnN",
+	"Nd+Tiz86PT9/e+6VOf2+Tv/j3dl5Ul3JBrI0qJjGFR0KogNHhQZ+rext4mIa5VA3aZvgw6zdlrIwzKaF",
+	"dpnn0/aufvIPvrsYltWbfzDVwmyet7ZVjQvSOCqINu2S5Xp53c4DonqshDUFdVfgX48D8pVkVvBLKlmi",
+	"BmIzrg6+AEpJQPJymSqY2cfl0/hzv4q3yaD6+s71thL7PcuXFafynhsXk+ffSTBV9UU/9yD+epqLp/82",
+	"m8wPn2PY+7tWuuZ+QQ08aQGQ+cOnPuI9DAnO8a56LTFU9DEsNYOlRz9f+MP4k5qbqf7bToFvwMMHOVF9",
+	"vwGqbKvwei2yGXXjs7jsg5B8QdRcq8mg74xXUeEAysK6dNtaDe2G4ppLlVrQlapqM9R8CUP/UdhEo5bK",
+	"krnitxJrDiMg8EwGOYT9fQqwtWQ+YMMR38eSbUcH3xtnxVm+jjnX4zeap45iFPP4owWsW2vQMkRvuHJk",
+	"r+6myes28kQdZisUv1j1Vg1kObZJDJH9sK4fVPF1FWnWGOCXDHrMJHbmZeC+hXr8eMjms6JhL7RI0ZNU",
+	"cl2Hgc0HuOwYNO+BllxF2rruP29XA75vXp2FaJBUt12pevPZcQG27HMPvJo5OjStxYWrsUQQ7uDM+FOq",
+	"QFe3hMGG59pgsBQNceGj15zm2lgYVynryI7Y7Ro7DEpchFlxFq4x+mSxr6TaUMCvHHNIMPEmmW5WOU3z",
+	"z+u+WWFRkl9zV93lFOyg1jT3B+JuX9javxJbw93RQXr7lOXktnEC4zs3u601XLFaZbFCN5hCii1nweKB",
+	"49/56dt4at2tXfnxNXsNa6PnNtvf7kJyNMVisziiCWbGNdDcixIlqdFefSxf39hvzGyUygTMoyoB/5Ky",
+	"RFvtgkvIBClKItGMXhNWj0lwsRSJ/jLbFC34SS+VrlzwRdb5Iuv8i8k6Id3aSO4x9ToT1YXb8GzS5hcN",
+	"e5xi7yMFCST2HCdhm3aGbvpE+rhtcNBZBUuXqx6T77hXVlURW39EpfcZtRPOi16Pqe55sNnhjVjdJsvU",
+	"w4BGXTy8XVfv5WI74qE/vdyOgMA5jLM4TxdvgREngk5VmrL4ElgwEAky4WxCC6gUUrK8Kl5jfs85Aduo",
+	"CRalqsohG6LXZxcXZ29eoQXBzFa9AZc01IsKDavwib5xYhMF37y9/PX89LV2T9W+JrdLCLV0nyOpdGmf",
+	"YIbwFu0OBtkgmDF5XRVYWhxaLsCqARZvRhbE1kyCcbmeK/kcqqXO3Rf5pncRnBdBAp6vZYTwDFOWmUTG",
+	"hYnMNT8YeVTObRHVa+KAmRbEYM2XmBalIOftfByGrZfubVSYF4mhhDESpMDKFD2KRTJVKUZ82qEXxTF3",
+	"mVOCzGphKu4fpf0MIoq96nR24j7pIsMtqu6mnQoT1Wr1HrO2UrZGR62c9EP00qenEhF6+AGaNoG3rlHZ",
+	"le3oypsdkvOWCKSCYKkF8fNQxupA0ypJymQDm2ImOlvWXIiNOKaqG/PsNJ1krDu4R/Ee3ELxx8IrFN+S",
+	"Uyi+jwZ2jVilZHRSJBqlPBxNR83t02/+8c1vk4LI/LfvB4E/4jRUpTpMNyA55GF6eCh55O1OwD12Tdyg",
+	"U+I+tRoTOdip/FcRNlbzrSI+vOFD00D/57gcUTJxY9gSXrON3LamcWQg1odgS6Ccm7hDYn+ZLlc0YiHj",
+	"CENSTKGW7rqAYUivopMromxCSALherljmxd5RVZBHSVb90kiTURJjkqWG8G7xqHa0vX+TzIvusBjUqQ5",
+	"WFujr7MpkkRltlCF3pRL5ojSXFMdwHrkpM3Ibfrhz8oCi7DYBqRpz3kR7QJkzdagWYNua13xQPQMZIIf",
+	"msj30oYVdSKeaV68SbBkfPafjAWo3fZTl3N8oCIMcSVTEjs3O1uzfVi+wzYVmKZwvLlhX7d6Ow5eu8V7",
+	"uAvcfZlvWi7LnKb9yK7LQDrjo95jACc6DNTP/EhM5WE5UUvo4TDGYO7z9kIMi3L0krr7RtHvPnBHkL+D",
+	"LS0V+e5s4d0hNV0G09gm6hf3dQoog3lBsMZs1dtSGkQKtplI44imtcbSYHjF1dsS1QJjvuFOYI1xIMXy",
+	"yhj1zCyxn+MUUuzqeoT/tJXV96fa92sI9QFV7TFjjVNaqTWlUyTDsML7aJISRyXa6cjGBct8Y+c3p6cn",
+	"F7+en16ev714dwo6g01iD6orEUGMFliCdt+s4+ybyvtqDi6VaGybNUYlQIyhJMxK+cCsUlJblXHlB4Wl",
+	"aFw5KC2paqOwwVUqagU0w1oRVYxxpSS1n36Q2ZS4H+Ow5Fh7iu+pPTw5Rqo+jdWT2bdtpW+o5N99e/gU",
+	"oCEVXkCdgPeXxyioALMfc3iq43oMhEtnFu+jlT3nfPVbMf3udoy/GQ+qXu0nQTf1ZjaB+c1brptonb72",
+	"VGh5bbnE1SVyPro8Z82kD8xc4gfUMXHZMHaypkRljUMJ1s5Wb5fpAFZdcFOUJGtZGFrxGoLsOvJWrXPH",
+	"qzjOut6XNzMV9m1FE6uSmtOmbSbmt52gtGW1msRdJWhm07m2vbQcnGky59K0v4/tTw6e0m/Ogzbp9nIN",
+	"BVqrEUaHDY+ROOtlswZ47QB0QWxFCPu0exURwWGlqA6lLaiWxrX0FSSZVs5A6epfSV3GKTTPGoYqSoaW",
+	"WBpGQljuqlRXRbMiTyNx1fyqwlr9k/vDc6Vkj74Fohb4VldSqMpDUebqQIUCMpXeEzrlIirBkK4QZRnf",
+	"peF7rVtw8E+0GLFeCldPcaqIaNaqgpHOwaHdIPoGGUe61BQREetet+caB0kAMKDHl43K6A2Ufh8XpY/P",
+	"bf6uucKc36AFmExi6moL90HJB/Qi2Zvfyh2FnisVhDXhvMj5DVsLfX3RFjZB+Q4HYl93vcJqnxtnswys",
+	"wdFkpngZB4piNP0K6wt4LPDtqLMNT7RxfFvbeC08LFDuLFCtPw2ryqthqgXiieASUB0OKNdv9beSr+/q",
+	"AKjwNxgZsoEQQ9oQ6G9u/vanq7jCRfWAKwCYW4JDg++qSTAdccO+1nVFh7YrONeyqYCqNNBHhvEW1fpr",
+	"CIwZ2LmXAtJ4amsDRjQO6xf7+tvDrWhDfUMfa9ds7jF5yyYHIwa3SUZKp/YJqd6kU1KydlNSxzdLOlGl",
+	"IG31C3qosFU2ymcMbnH5WRUAqq0HdnF/1JYI3veyR7bJ8VzQ8B4GE/2H/0tuzckLPJZDyk2CTzO3BL5G",
+	"b/TRWbDJo8FcqaU8OjjA11hhIYczqublWL8F22tzOOGLg/Lg6fNnT58/Ozz89+s/P9cg/QuX83A3fsHu",
+	"1JYtFv63588Ov/72e7OwvgZX2jVITHr99s3J6D8H2eDy/emF+dfPpydv3L8vf3h/bv/58vzM/ONidPn+",
+	"3P7zPXydKEOi0ZdNuWs9alu9OZjyxYIz9NJkBZeiCE41gd+mWBF9KQ3Z1wYpo6rY0ujd2aBZsUwGwdVH",
+	"g6fDQ2PuJwwv6eBo8PXwcGjrrc4BNw7wkh5cPz0wItIT4TqoJ6sMvCIK2EpYyQy8JFVAtaZDmgYAXdEW",
+	"Td9VdxS1Rnd9d2GxZ4eHba/Tjztoaxp/B8mUiwUWK7tayBz0WgrPpL7zU5YjeDkf9Tepkx98MlWa7zpB",
+	"YFqmpSpJQhuRUwsKI9yAJO7yGcGAF+7Omu5s8r0tAVg5nHm5rLh/FTQBUR7hlznRmcoQymquw4cAJNv8",
+	"nPm4Lx/GQ4iy2kFVCS5DGP1wefnu+eFTVDJcqjkX9B8kR2AcRhDRq0phY2/iW9dwfkXiaP7Une+ljXCw",
+	"SqLv7tu/6jfw/PDpehwDG/B50BL6+eHzjb+K8FHjSwD7NDbq9yjwgigwEf/yaUD1vvUbrQiib6ZT8RTT",
+	"UroCUZ0WfVyH5QcOTbqffLN4WlxhRDeEupx7dJAIR42ktX37y8NofRgjfwe7U0U/V4zDD4L5dUpcodDD",
+	"PQLdRqUfq4Pd13ld4zKhg2GNMQ0aB6n1vaSFIiJGdm14QkssFJ2AJ9wIgmBI0Z/8VhKxCmUjl7DvT93d",
+	"DKUOkj2wX5OBtQUTBpCZ6+apBGUT/dWsYZQAfL04UhVQ8sJWmUofyQ2hRB7U54jqakUwevoZ2JWroNhk",
+	"Wi4IDl7i4Vbv9+lu79deRJp5uVvsfFz9pKmm9TNx1Q8gSrTfzSMVKIKX9VkIaTZYpuogQs9IIuv32LeX",
+	"pLZxmFaf3rDhSrKXzFWSDFl6UEE3gShmN7vRhPoc7TThYfDusHkJL3COgm1a3KxdVCCpBKgYD9Lxgy91",
+	"wL8e8U1qqTOmiNBRdRdEaEHKxk1ESGoguBfacWCLg+qNfDa8TnKi11hcyRojAinSVSv9wEZs1Www7uuT",
+	"h9/dWCe87zluEh6okt6Qy/Kgx7qzfsa91cMO6uCKITqs4DJVAtjXqg2SUL1Uika16Blf3s7vz3ZEgpWd",
+	"MCwVD8TuZu3WxHtsFOZtPsje7ye2LoawaF7eOfwa5hN4V2sjWmptn/t0ZEm9zN/dZ6UPLQWO15GJe5Aa",
+	"tmNqnrpszwotTCIysyFVOaCmqX6XGiCjIJiak6+KQvIPxzmSut61fXXuY/fQorrSNWTtIxlZiJyZQ90L",
+	"u4qXfGzS0rsgaM5cNbhuWmp336Mw1RNNc9tnOomc59bconZuO53AK918ubpl27F5rXJ7mWgra9uGYEHa",
+	"FFpoArwJ+LLUugVW6VWjLgwqSO9xQmjLthQfrBEc7kMc1Bjw2J6V3lSEX9cVfjyyF+R3tu4VYY86cyoV",
+	"Fysb9x6YZTbUU4NHs3d7y55k/C7Vsg6Px3u3B5/sv+563LJckgmd0ok/XjoEq+flfrFFBAhTweSeECVL",
+	"TnQdXM3nR7kDHYihm6p/Ng01ye0CPqc3AG3dbTXYfYGkTTE+50UhGxKGWV//uSYAgO9nBFafGuOFwki2",
+	"Vb2TiJeui299FnQ5b5AkPcEVWaqqzXBgW4K8eZE7YduvaTJ6TFP9EGyJF39ur/YzqK+NRvr10GvXdtj8",
+	"fWwaiazi9inBIWIpB/vPGV44ZSIQdxoods/a7KbWrkerxkb08Nw/xA0tX67ejuyhiNZq88heXWaG7a6r",
+	"F37tbcUUP0OXO6i+7Y1dQrJZl6i19c4QjdJwSHXbcQBMTE9uJ2RpSiklEnGGXW6poI/Nlk4pN8N9uKT8",
+	"bvfviko5lRqg3uiRHHxy/7S+pZwUxJQ5Simy8GN0HREQkwIyOrZQ3Yu2BDvodeg+clJ1+B0lnKrnZJtz",
+	"zkQR23F1ZH9F1Cv3y3aEw3zeRTX80muxw4w8+AT/f5av1wQoM3GdXgUwiw1bz/k5RX2zQAsXbMjcMBoZ",
+	"1rmtym3htCMKuUSmNYEU1bBUdOC74NedINwr68Stlkhu2qt2tbN5uwa5u3bgH3yqmgp1O9vdOG1ppnnj",
+	"Nl4RFRSz/2zYXl3BIwd5n4cU9XPay1uKrvMAi1n765oRKxXqCzCLhQqDTS0MfJJBZ4/Wqx/pFXe8/oY6",
+	"8ajuOXoKWMyQ3fijufCDT1jM9H8ElVosArTTz5GYvbXDt+HI1ee7q1L7fYlwRQ4Sn/OO0vYkuIod79r3",
+	"3O8WS4LQWS15ua+0BcXGDdqU145SArYGgeJmqnEq7c4oizqhyuhHhFWZ/yuTD92iLp67g6zxBOkwHUty",
+	"9DAf5GuOpX3XcovwxkSCeVw7oGe5gfaqbE1rG2ETsVpClWl+RRiCvA7KNH1d4hllLjlsylsOpFMIL/Wn",
+	"27iT1kvR7kZaXtJ/8lKgV6eXiLB8ySlTCYGxFV0PPvn6cD1s66n2n2k7elWK97MJGXGrgA4O9PyhOJAv",
+	"MbRDQHRQvW8X8uTbqCcv+CVR2swSRfUn9cH3cpc4dv11m2UtmVmwRjGEsMINwr2DoI0oOruqXuqL2zMT",
+	"s2hLaMZweKt/aQaF30PItd5T3hZ4rf8bwd7WQWmbuN01QEJwPVQqgX0JihlMsup2vMGGv0T/hmSjcctt",
+	"l/woAoG7ESMz/h9jfo3igqF+GG5ijbXzKh82nFkjUVaVR4tKbGQwiykr6OqmiUgqkkTBDkwXVVNPmOXB",
+	"EttEI8Ml/G6ike/JP/P1Q7w5cwH9n10/2vrI4po7X+n+op5b3kk6SviL83GbaNh94+lazdXLTm6kr3C+",
+	"IStg5MYUGBUp1SEhVvXVRf/5NLiHzdqMHvWDixsBnvbDRTfKpkb4wvlwqdYcAYWgJFrxEvrlgIJTs47o",
+	"37TEUVVJbWby/j4tIlLXe/LQ8p1BEq2/oM2BwLZ4J2ZtX81d6wM1JwtJimvSBgs3deolBtkZ/2JGHD0E",
+	"LVYefK0WgxaOf0WCgsaJ3ogQo6VnkbZoKARJrpoEnDC0wFc2rc9VoX3vk/qDXHjF0SJeN5SboV6Ty+O3",
+	"mBCuJMiUCMImRA7RW40+N1QSl3ePnh8+Rw5+Xsjozrk3kQeh3WmroAw7wZqYjJYAinQoRKcRKEH8DpZY",
+	"tmes5FQuC7xC8EaDbDNbX86oNi7fJKCUa8nbO9xqr/sMNsr0wasK3i2RQnMyuUI3/ep5ZxDXw0vlMJoq",
+	"eAaXtc6Nek6t9QWdN2/wCmFpngNVQRhjBjV4GYdHpf8u8bVNCWI8qEPo+86fTbsLaWfov6Fq8X/rr6a4",
+	"kMTrprYO99pqEzYH5vOjfg9ciIssr0na2eJprEMQU4LWVF+MbdWyKmMJV+wq35rKJbgSDwzAra+Espxo",
+	"VQhae0IbIjqFCFMiqqqN1czmL0/cTA7bdT7m1HRcCgp9c1bhRc6J1GhVpWCa9kCVpCb4wmZkumVNxKyR",
+	"LKq80EQ6aHtTWTdYV3Bp4NaLsrg6D1FLboNbjVm2Q6xwmlAc3tFM7/ClLBRdFmQN/01hZLmccFeIupNg",
+	"NzRq/c7rHW9cQzRBgo6yLbmrYzKjDFi86XNDGZqWqhRkvSj73m36Yen9Rs4nX5HH7cSVxXdiqX4+cbVg",
+	"29fCCJTw0EEIQut9Ya6aUGif9AJ9PbLM353egWvwFeahwQ7/xLgiR8gqRkmx2hWBi5b9qrXG0Bcn22Nx",
+	"sqVQyJlbKJNKlBPVmaQGJ7HSQzA+bs0CGJwKb7QVeiu539YPJSbJX/JSTEgy8NGI5GfhFveDTUN7/Dlm",
+	"eUHEgS1ROFwtig6jXbSRvtGS5lNUO8TjwgUjqPe0BW+3hTbl0Chv4FqBTTTd+J4UrUwJ3iBlpyBW/rUi",
+	"hDU2mMZ5TYw6hhX2RJd6h5gd3ltJg+eH3z8EnTu2F7e5vBzhoGlS3MPQ60ZCQwi3aIZ4kVf22w+szpmf",
+	"9+fMWdR/WDRYbUpfDcSOY3eSHcUXN88jqHURGjY9/O+djLXRkFGeB12uIf/MG4cu44t112rl24SD184S",
+	"4pYrcgM6j0nIpktTBCduRGXLydMpJbmrJ2UnTOHMKM/jq95Gg2lMch8JO26/n6d03IOg+HHi4rejZKYt",
+	"7r2oDF1k6PR6H0TIzPJYQnLdQyPXD0KA1t48NI59YDkKyysQzBdcWNXbNE6qK/NegJJVW3OjyzdMkyY4",
+	"JpTzL8O+r2jKjT+ro0tgvdCtTyR21b0sDKrvq/a7VJq1cpIjurDNy7XBizvTfNyHVn/gRMGSKVpYKTE2",
+	"TTV7PgQrsklR5i6HoZp4gpl+lDox0za/bTR/SZy38UqhtWy+g0k0muA+QnL8Gv+8Bb8eSDo2V2XUGjCU",
+	"7cZbHCqGtub7pjXnzkDujIbVA2nplI0qT7geDwzOtfYNZe3YHcFFm9XJOf3sezQTVNuQVQMd3Qbe+5ka",
+	"DzEy/YYNn/dTZSEPett1v66oNV2jE5/74eM9F0r48up3tNxXbj8SINcW716QgmBJHli0IHFtQd8cv/HU",
+	"jG8SbDZVJzFG9DO2TUTDqIwbTCECVkst1HbgX1Jh+lmMvNfSVyKtRIBIv6vKLZRSrzTDlGW+K6nv42TD",
+	"AgKJpurbhxdxHAA0J5vNFcI3eJUiH3Ar92fp/vISt3iJcEf7YsCPgO02DKTOqWOaR3Qz21oPikYYTcxR",
+	"mzw0A90r7o+xpq9FN9fdRvzdg8c25a39fr80f3MEowbB+NVmhJ7uzY62oCzy/Fq6b/bk0A7iYHgpi5Ub",
+	"lg/RKRR81s8rIM8odfX8ag3B/J2a0c8tkDemU/qBy4MFWWtxCp1yeMxLFbqBixUq+Gxm4jHSvbReEfWa",
+	"bJfcXap5nF/Xq4Jkw5kW9r6qu7B7w+kgJwWZ4aq9crp8j6mb83p1Uo3epnrPZoc8Jwt+TRpn+6OslKQ8",
+	"3E/S/rW2XC+wBN3m30waRCFx3dxzjoupN1+3YIe1HlVMICkgaYzpBOD+JKRglXVpc3suWrrbZSWT2c7J",
+	"jEoFbgo3Bdn1wsIetKbLrCb0INLTBTE9sn52lxpsPLQLBr8Q+Hue295WkRIfyNZh/moIJJt+EQoOQ3Rh",
+	"U+FwuLwgywJPILNvhcgtlTCkGtDEuosE1m0oYVwQVU1wHwa2fui7g4zfmwRd7IjSDYr7Sf9fvyAqZ+xp",
+	"5T+29ehnuwaY/57pB+5gX32cGQa8G3oy9C6gg4qZtuoGenRwUPAJLuZcqqPvDr87HNx99FvzvUT9Fu8y",
+	"/7cgHSf4q8mMv/t49z8DALNC9x0CDgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -34,3 +34,9 @@ func NewRequestReviewID() string {
 func NewHistoryID() string {
 	return newResourceID("his")
 }
+func NewCommentID() string {
+	return newResourceID("cmt")
+}
+func NewBlackoutID() string {
+	return newResourceID("blk")
+}